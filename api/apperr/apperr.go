@@ -0,0 +1,213 @@
+// Package apperr define los errores de dominio de la API. Cada error lleva un
+// Code que los transportes traducen, con una sola tabla, a un estado HTTP y a
+// un código gRPC.
+package apperr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"prueba_tecnica/api/i18n"
+
+	"google.golang.org/grpc/codes"
+)
+
+// Code clasifica un error según lo que el cliente puede hacer con él.
+type Code string
+
+const (
+	InvalidArgument      Code = "invalid_argument"
+	NotFound             Code = "not_found"
+	Conflict             Code = "conflict"
+	FailedPrecondition   Code = "failed_precondition"
+	Unauthenticated      Code = "unauthenticated"
+	PermissionDenied     Code = "permission_denied"
+	UnsupportedMediaType Code = "unsupported_media_type"
+	Unavailable          Code = "unavailable"
+	Internal             Code = "internal"
+)
+
+type mapping struct {
+	http int
+	grpc codes.Code
+}
+
+// table es la única traducción de Code a HTTP y gRPC.
+var table = map[Code]mapping{
+	InvalidArgument:      {http.StatusBadRequest, codes.InvalidArgument},
+	NotFound:             {http.StatusNotFound, codes.NotFound},
+	Conflict:             {http.StatusConflict, codes.Aborted},
+	FailedPrecondition:   {http.StatusConflict, codes.FailedPrecondition},
+	Unauthenticated:      {http.StatusUnauthorized, codes.Unauthenticated},
+	PermissionDenied:     {http.StatusForbidden, codes.PermissionDenied},
+	UnsupportedMediaType: {http.StatusUnsupportedMediaType, codes.InvalidArgument},
+	Unavailable:          {http.StatusServiceUnavailable, codes.Unavailable},
+	Internal:             {http.StatusInternalServerError, codes.Internal},
+}
+
+func (c Code) lookup() mapping {
+	if m, ok := table[c]; ok {
+		return m
+	}
+	return table[Internal]
+}
+
+func (c Code) known() Code {
+	if _, ok := table[c]; ok {
+		return c
+	}
+	return Internal
+}
+
+// HTTPStatus devuelve el estado HTTP del código.
+func (c Code) HTTPStatus() int { return c.lookup().http }
+
+// GRPCCode devuelve el código gRPC del código.
+func (c Code) GRPCCode() codes.Code { return c.lookup().grpc }
+
+// Title devuelve el resumen legible del código en el idioma l, el title de
+// problem+json.
+func (c Code) Title(l i18n.Locale) string { return i18n.T(l, "title."+string(c.known())) }
+
+// FieldViolation describe un campo inválido de la petición. Description está
+// en el idioma por defecto; Localize la traduce.
+type FieldViolation struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+
+	key  string
+	args []any
+}
+
+// Violation crea el detalle de un campo con el mensaje key del catálogo.
+func Violation(field, key string, args ...any) FieldViolation {
+	return FieldViolation{Field: field, Description: i18n.T(i18n.Default, key, args...), key: key, args: args}
+}
+
+// Localize devuelve f con la descripción en el idioma l.
+func (f FieldViolation) Localize(l i18n.Locale) FieldViolation {
+	if f.key != "" {
+		f.Description = i18n.T(l, f.key, f.args...)
+	}
+	return f
+}
+
+// Error es un error de dominio. Key es la clave de su mensaje en el catálogo
+// de i18n y Message el mensaje en el idioma por defecto. Los errores que
+// devuelven WithCause y WithFields siguen siendo el error del que salen para
+// errors.Is.
+type Error struct {
+	Code    Code
+	Key     string
+	Message string
+	Fields  []FieldViolation
+	Cause   error
+
+	kind *Error
+}
+
+// New crea un error de dominio con el mensaje key del catálogo. Se usa para
+// declarar los errores de cada capa.
+func New(code Code, key string) *Error {
+	return &Error{Code: code, Key: key, Message: i18n.T(i18n.Default, key)}
+}
+
+func (e *Error) Error() string {
+	return e.Localize(i18n.Default)
+}
+
+// Localize devuelve el mensaje de e en el idioma l, seguido de la causa.
+func (e *Error) Localize(l i18n.Locale) string {
+	message := i18n.T(l, e.Key)
+	if e.Cause != nil {
+		return message + ": " + e.Cause.Error()
+	}
+	return message
+}
+
+// LocalizeMessage devuelve el mensaje de e en el idioma l, sin la causa.
+func (e *Error) LocalizeMessage(l i18n.Locale) string {
+	return i18n.T(l, e.Key)
+}
+
+// LocalizeFields devuelve el detalle de los campos en el idioma l.
+func (e *Error) LocalizeFields(l i18n.Locale) []FieldViolation {
+	if len(e.Fields) == 0 {
+		return nil
+	}
+	fields := make([]FieldViolation, len(e.Fields))
+	for i, field := range e.Fields {
+		fields[i] = field.Localize(l)
+	}
+	return fields
+}
+
+func (e *Error) Unwrap() error {
+	return e.Cause
+}
+
+// Is indica si target es el error del que se derivó e.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.root() == e.root()
+}
+
+func (e *Error) root() *Error {
+	if e.kind != nil {
+		return e.kind
+	}
+	return e
+}
+
+func (e *Error) derive() *Error {
+	derived := *e
+	derived.kind = e.root()
+	return &derived
+}
+
+// WithCause devuelve una copia de e que envuelve cause.
+func (e *Error) WithCause(cause error) *Error {
+	derived := e.derive()
+	derived.Cause = cause
+	return derived
+}
+
+// WithFields devuelve una copia de e con el detalle de los campos inválidos.
+func (e *Error) WithFields(fields ...FieldViolation) *Error {
+	derived := e.derive()
+	derived.Fields = append(append([]FieldViolation(nil), e.Fields...), fields...)
+	return derived
+}
+
+// From devuelve el error de dominio de err. Un plazo vencido es Unavailable;
+// cualquier otro error que no sea de dominio es Internal, sin exponer su texto.
+func From(err error) *Error {
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrTimeout.WithCause(err)
+	}
+	return ErrInternal.WithCause(err)
+}
+
+// ErrInternal y ErrTimeout son los errores con los que From reporta los
+// errores que no son de dominio.
+var ErrInternal = New(Internal, "error.internal")
+var ErrTimeout = New(Unavailable, "error.timeout")
+
+// Localize devuelve el texto de err en el idioma l. Los errores que no son de
+// dominio quedan como están.
+func Localize(err error, l i18n.Locale) string {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Localize(l)
+	}
+	return err.Error()
+}
+
+// CodeOf devuelve el código de err, Internal si no es un error de dominio.
+func CodeOf(err error) Code {
+	return From(err).Code
+}
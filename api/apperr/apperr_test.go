@@ -0,0 +1,88 @@
+package apperr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"prueba_tecnica/api/i18n"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCodeMapping(t *testing.T) {
+	testCases := []struct {
+		code         Code
+		expectedHTTP int
+		expectedGRPC codes.Code
+	}{
+		{code: InvalidArgument, expectedHTTP: http.StatusBadRequest, expectedGRPC: codes.InvalidArgument},
+		{code: NotFound, expectedHTTP: http.StatusNotFound, expectedGRPC: codes.NotFound},
+		{code: Conflict, expectedHTTP: http.StatusConflict, expectedGRPC: codes.Aborted},
+		{code: FailedPrecondition, expectedHTTP: http.StatusConflict, expectedGRPC: codes.FailedPrecondition},
+		{code: Unauthenticated, expectedHTTP: http.StatusUnauthorized, expectedGRPC: codes.Unauthenticated},
+		{code: PermissionDenied, expectedHTTP: http.StatusForbidden, expectedGRPC: codes.PermissionDenied},
+		{code: UnsupportedMediaType, expectedHTTP: http.StatusUnsupportedMediaType, expectedGRPC: codes.InvalidArgument},
+		{code: Unavailable, expectedHTTP: http.StatusServiceUnavailable, expectedGRPC: codes.Unavailable},
+		{code: Internal, expectedHTTP: http.StatusInternalServerError, expectedGRPC: codes.Internal},
+		{code: Code("desconocido"), expectedHTTP: http.StatusInternalServerError, expectedGRPC: codes.Internal},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.code), func(t *testing.T) {
+			assert.Equal(t, tc.expectedHTTP, tc.code.HTTPStatus())
+			assert.Equal(t, tc.expectedGRPC, tc.code.GRPCCode())
+			assert.NotEqual(t, "title."+string(tc.code), tc.code.Title(i18n.Spanish))
+			assert.NotEqual(t, tc.code.Title(i18n.Spanish), tc.code.Title(i18n.English))
+		})
+	}
+}
+
+func TestDerivedErrorsKeepTheirKind(t *testing.T) {
+	errNotFound := New(NotFound, "evento no encontrado")
+	errOther := New(NotFound, "otro")
+	cause := errors.New("sin conexión")
+
+	wrapped := errNotFound.WithCause(cause)
+	detailed := wrapped.WithFields(FieldViolation{Field: "id", Description: "no existe"})
+
+	assert.ErrorIs(t, wrapped, errNotFound)
+	assert.ErrorIs(t, detailed, errNotFound)
+	assert.ErrorIs(t, fmt.Errorf("capa: %w", detailed), errNotFound)
+	assert.ErrorIs(t, detailed, cause)
+	assert.NotErrorIs(t, detailed, errOther)
+	assert.Equal(t, "evento no encontrado: sin conexión", wrapped.Error())
+	assert.Len(t, detailed.Fields, 1)
+	assert.Empty(t, errNotFound.Fields, "el error original no cambia")
+}
+
+func TestFrom(t *testing.T) {
+	errNotFound := New(NotFound, "evento no encontrado")
+
+	assert.Same(t, errNotFound, From(fmt.Errorf("capa: %w", errNotFound)))
+
+	internal := From(errors.New("mongo: connection refused"))
+	assert.Equal(t, Internal, internal.Code)
+	assert.Equal(t, "error interno del servidor", internal.Message)
+	assert.ErrorIs(t, internal, ErrInternal)
+
+	timeout := From(fmt.Errorf("consulta: %w", context.DeadlineExceeded))
+	assert.Equal(t, Unavailable, timeout.Code)
+	assert.Equal(t, Unavailable, CodeOf(context.DeadlineExceeded))
+}
+
+func TestLocalize(t *testing.T) {
+	errNotFound := New(NotFound, "event.not_found")
+	detailed := errNotFound.WithCause(errors.New("id 1")).WithFields(Violation("status", "validation.oneof", "Revisado, Cerrado"))
+
+	assert.Equal(t, "evento con ese id no encontrado", errNotFound.Message)
+	assert.Equal(t, "evento con ese id no encontrado: id 1", detailed.Error())
+	assert.Equal(t, "no event found with that id: id 1", detailed.Localize(i18n.English))
+	assert.Equal(t, "no event found with that id", detailed.LocalizeMessage(i18n.English))
+	assert.Equal(t, "debe ser uno de: Revisado, Cerrado", detailed.Fields[0].Description)
+	assert.Equal(t, "must be one of: Revisado, Cerrado", detailed.LocalizeFields(i18n.English)[0].Description)
+	assert.Equal(t, "no event found with that id: id 1", Localize(fmt.Errorf("lote: %w", detailed), i18n.English))
+	assert.Equal(t, "sin conexión", Localize(errors.New("sin conexión"), i18n.English))
+}
@@ -0,0 +1,50 @@
+package apperr
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Invalid devuelve kind con el detalle de cada campo que rechazó el
+// validador. Si err no viene del validador, kind lo envuelve.
+func Invalid(kind *Error, err error) *Error {
+	var errs validator.ValidationErrors
+	if !errors.As(err, &errs) {
+		return kind.WithCause(err)
+	}
+	fields := make([]FieldViolation, 0, len(errs))
+	for _, fe := range errs {
+		fields = append(fields, describe(fe))
+	}
+	return kind.WithFields(fields...)
+}
+
+// fieldPath quita el nombre de la estructura: "Event.name" es "name".
+func fieldPath(fe validator.FieldError) string {
+	_, path, found := strings.Cut(fe.Namespace(), ".")
+	if !found {
+		return fe.Field()
+	}
+	return path
+}
+
+// describe traduce la regla que falló a un mensaje del catálogo.
+func describe(fe validator.FieldError) FieldViolation {
+	field := fieldPath(fe)
+	switch fe.Tag() {
+	case "required":
+		return Violation(field, "validation.required")
+	case "min":
+		return Violation(field, "validation.min", fe.Param())
+	case "oneof":
+		return Violation(field, "validation.oneof", strings.Join(strings.Fields(fe.Param()), ", "))
+	case "http_url":
+		return Violation(field, "validation.http_url")
+	case "status", "category", "event_type":
+		return Violation(field, "validation.taxonomy")
+	default:
+		return Violation(field, "validation.rule", fe.Tag())
+	}
+}
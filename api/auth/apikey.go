@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/logging"
+	"prueba_tecnica/api/repository"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// APIKeyPrefix identifica las API keys de este servicio, p. ej. en un escaneo
+// de secretos.
+const APIKeyPrefix = "evk_"
+
+// displayPrefixLength es cuántos caracteres de la clave se guardan en claro
+// para reconocerla en el listado.
+const displayPrefixLength = len(APIKeyPrefix) + 8
+
+// GenerateAPIKey crea una clave aleatoria de 32 bytes. Devuelve la clave en
+// claro, que solo se muestra una vez, y el prefijo y hash que se guardan.
+func GenerateAPIKey() (key, prefix, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", "", err
+	}
+	key = APIKeyPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return key, key[:displayPrefixLength], HashAPIKey(key), nil
+}
+
+// HashAPIKey devuelve el SHA-256 en hexadecimal de key. Las claves son
+// aleatorias, así que no hace falta un hash lento.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// APIKeyAuthenticator valida las API keys buscando su hash en el repositorio.
+type APIKeyAuthenticator struct {
+	keys   repository.APIKeyRepository
+	logger logrus.FieldLogger
+}
+
+func NewAPIKeyAuthenticator(keys repository.APIKeyRepository, logger logrus.FieldLogger) *APIKeyAuthenticator {
+	return &APIKeyAuthenticator{keys: keys, logger: logger}
+}
+
+func (a *APIKeyAuthenticator) Authenticate(ctx context.Context, credentials Credentials) (entities.Principal, error) {
+	if credentials.APIKey == "" {
+		return entities.Principal{}, ErrNoCredentials
+	}
+
+	key, err := a.keys.GetAPIKeyByHash(ctx, HashAPIKey(credentials.APIKey))
+	if err == repository.ErrAPIKeyNotFound {
+		return entities.Principal{}, fmt.Errorf("%w: api key desconocida", ErrInvalidCredentials)
+	}
+	if err != nil {
+		logging.For(ctx, a.logger, "auth", "Authenticate").WithError(err).Error("operación fallida")
+		return entities.Principal{}, err
+	}
+	if key.RevokedAt != nil {
+		return entities.Principal{}, fmt.Errorf("%w: api key %s revocada", ErrInvalidCredentials, key.Prefix)
+	}
+	return key.Principal(), nil
+}
+
+// EnsureAPIKey registra key con el rol admin si todavía no existe. Sirve para
+// tener una primera clave con la que crear las demás.
+func EnsureAPIKey(ctx context.Context, keys repository.APIKeyRepository, key string) error {
+	hash := HashAPIKey(key)
+	_, err := keys.GetAPIKeyByHash(ctx, hash)
+	if err != repository.ErrAPIKeyNotFound {
+		return err
+	}
+	prefix := key
+	if len(prefix) > displayPrefixLength {
+		prefix = prefix[:displayPrefixLength]
+	}
+	_, err = keys.CreateAPIKey(ctx, entities.APIKey{
+		Name:      "bootstrap",
+		Subject:   "bootstrap",
+		Roles:     []string{entities.RoleAdmin},
+		Prefix:    prefix,
+		Hash:      hash,
+		CreatedAt: time.Now(),
+	})
+	return err
+}
@@ -0,0 +1,110 @@
+// Package auth valida las credenciales de las peticiones HTTP y gRPC: JWT
+// firmados con un secreto HMAC o con las claves de un archivo JWKS, y API
+// keys guardadas por su hash. Los transportes guardan el principal en el
+// contexto con service.WithPrincipal.
+package auth
+
+import (
+	"context"
+	"fmt"
+	"prueba_tecnica/api/apperr"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/logging"
+	"prueba_tecnica/api/repository"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+var ErrNoCredentials = apperr.New(apperr.Unauthenticated, "auth.no_credentials")
+var ErrInvalidCredentials = apperr.New(apperr.Unauthenticated, "auth.invalid_credentials")
+
+// Credentials son las credenciales que trae una petición. Token es el JWT del
+// encabezado Authorization y APIKey la clave de X-API-Key.
+type Credentials struct {
+	Token  string
+	APIKey string
+}
+
+// Authenticator valida unas credenciales. Devuelve ErrNoCredentials si no
+// trae las que sabe validar y ErrInvalidCredentials si no son válidas;
+// cualquier otro error es una falla al validarlas.
+type Authenticator interface {
+	Authenticate(ctx context.Context, credentials Credentials) (entities.Principal, error)
+}
+
+// Chain prueba cada Authenticator en orden y usa el primero que reconoce las
+// credenciales.
+type Chain []Authenticator
+
+func (c Chain) Authenticate(ctx context.Context, credentials Credentials) (entities.Principal, error) {
+	for _, authenticator := range c {
+		principal, err := authenticator.Authenticate(ctx, credentials)
+		if err == ErrNoCredentials {
+			continue
+		}
+		return principal, err
+	}
+	return entities.Principal{}, ErrNoCredentials
+}
+
+// Config elige los métodos de autenticación. Sin ninguno configurado la API
+// queda abierta.
+type Config struct {
+	// JWTSecret valida los JWT firmados con HS256, HS384 o HS512.
+	JWTSecret string `yaml:"jwt_secret"`
+	// JWKSFile es la ruta de un JWKS con las claves RSA o EC públicas que
+	// validan los JWT firmados con RS*, PS* o ES*.
+	JWKSFile string `yaml:"jwks_file"`
+	// Issuer y Audience, si no están vacíos, se exigen en los claims iss y aud.
+	Issuer   string `yaml:"issuer"`
+	Audience string `yaml:"audience"`
+	// APIKeys acepta las API keys guardadas en el repositorio.
+	APIKeys bool `yaml:"api_keys"`
+	// BootstrapAPIKey se registra al arrancar con el rol admin, para poder
+	// crear las demás claves. Activa APIKeys.
+	BootstrapAPIKey string `yaml:"bootstrap_api_key"`
+}
+
+func (c Config) Enabled() bool {
+	return c.JWTSecret != "" || c.JWKSFile != "" || c.apiKeys()
+}
+
+func (c Config) apiKeys() bool {
+	return c.APIKeys || c.BootstrapAPIKey != ""
+}
+
+// New arma el Authenticator que indica config. Devuelve nil si config no
+// habilita ningún método.
+func New(config Config, keys repository.APIKeyRepository, logger logrus.FieldLogger) (Authenticator, error) {
+	var chain Chain
+	if config.JWTSecret != "" || config.JWKSFile != "" {
+		jwtAuth, err := NewJWTAuthenticator(config)
+		if err != nil {
+			logging.Layer(logger, "auth", "New").WithError(err).Error("operación fallida")
+			return nil, err
+		}
+		chain = append(chain, jwtAuth)
+	}
+	if config.apiKeys() {
+		chain = append(chain, NewAPIKeyAuthenticator(keys, logger))
+	}
+	if len(chain) == 0 {
+		return nil, nil
+	}
+	return chain, nil
+}
+
+// BearerToken extrae el token de un encabezado Authorization "Bearer <token>".
+// Un encabezado vacío devuelve un token vacío.
+func BearerToken(header string) (string, error) {
+	if header == "" {
+		return "", nil
+	}
+	scheme, token, ok := strings.Cut(strings.TrimSpace(header), " ")
+	token = strings.TrimSpace(token)
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", fmt.Errorf("%w: Authorization debe tener la forma Bearer <token>", ErrInvalidCredentials)
+	}
+	return token, nil
+}
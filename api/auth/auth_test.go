@@ -0,0 +1,247 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/repository"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+func sign(t *testing.T, method jwt.SigningMethod, key any, kid string, claims Claims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func validClaims() Claims {
+	return Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "ana",
+			Issuer:    "https://idp.example.com",
+			Audience:  jwt.ClaimStrings{"events-api"},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+		Roles: []string{"operator"},
+	}
+}
+
+func b64(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+
+// writeJWKS guarda un JWKS con una clave RSA "rsa-1" y una EC "ec-1".
+func writeJWKS(t *testing.T, rsaKey *rsa.PrivateKey, ecKey *ecdsa.PrivateKey) string {
+	t.Helper()
+	doc := map[string]any{"keys": []map[string]string{
+		{"kty": "RSA", "kid": "rsa-1", "use": "sig", "n": b64(rsaKey.N.Bytes()), "e": b64(big.NewInt(int64(rsaKey.E)).Bytes())},
+		{"kty": "EC", "kid": "ec-1", "crv": "P-256", "x": b64(ecKey.X.FillBytes(make([]byte, 32))), "y": b64(ecKey.Y.FillBytes(make([]byte, 32)))},
+		{"kty": "RSA", "kid": "enc-1", "use": "enc", "n": "", "e": ""},
+		{"kty": "oct", "kid": "sym-1", "k": "c2VjcmV0"},
+	}}
+	data, err := json.Marshal(doc)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherRSA, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	authenticator, err := NewJWTAuthenticator(Config{
+		JWTSecret: testSecret,
+		JWKSFile:  writeJWKS(t, rsaKey, ecKey),
+		Issuer:    "https://idp.example.com",
+		Audience:  "events-api",
+	})
+	require.NoError(t, err)
+
+	expired := validClaims()
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+	noExp := validClaims()
+	noExp.ExpiresAt = nil
+	noSub := validClaims()
+	noSub.Subject = ""
+	wrongIssuer := validClaims()
+	wrongIssuer.Issuer = "https://evil.example.com"
+	wrongAudience := validClaims()
+	wrongAudience.Audience = jwt.ClaimStrings{"other-api"}
+	withTenant := validClaims()
+	withTenant.Tenant = "acme"
+	badTenant := validClaims()
+	badTenant.Tenant = "../admin"
+
+	testCases := []struct {
+		name    string
+		token   string
+		tenant  string
+		invalid bool
+	}{
+		{name: "HMAC", token: sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", validClaims())},
+		{name: "With tenant", token: sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", withTenant), tenant: "acme"},
+		{name: "Invalid tenant", token: sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", badTenant), invalid: true},
+		{name: "RSA from JWKS", token: sign(t, jwt.SigningMethodRS256, rsaKey, "rsa-1", validClaims())},
+		{name: "RSA-PSS from JWKS", token: sign(t, jwt.SigningMethodPS256, rsaKey, "rsa-1", validClaims())},
+		{name: "EC from JWKS", token: sign(t, jwt.SigningMethodES256, ecKey, "ec-1", validClaims())},
+		{name: "Wrong HMAC secret", token: sign(t, jwt.SigningMethodHS256, []byte("another-secret-another-secret-xx"), "", validClaims()), invalid: true},
+		{name: "Signed by an unknown key", token: sign(t, jwt.SigningMethodRS256, otherRSA, "rsa-1", validClaims()), invalid: true},
+		{name: "Unknown kid", token: sign(t, jwt.SigningMethodRS256, rsaKey, "rsa-9", validClaims()), invalid: true},
+		{name: "Key of another type", token: sign(t, jwt.SigningMethodES256, ecKey, "rsa-1", validClaims()), invalid: true},
+		{name: "Expired", token: sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", expired), invalid: true},
+		{name: "Without exp", token: sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", noExp), invalid: true},
+		{name: "Without sub", token: sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", noSub), invalid: true},
+		{name: "Wrong issuer", token: sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", wrongIssuer), invalid: true},
+		{name: "Wrong audience", token: sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", wrongAudience), invalid: true},
+		{name: "Unsigned", token: sign(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, "", validClaims()), invalid: true},
+		{name: "Garbage", token: "not-a-jwt", invalid: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			principal, err := authenticator.Authenticate(context.Background(), Credentials{Token: tc.token})
+
+			if tc.invalid {
+				assert.ErrorIs(t, err, ErrInvalidCredentials)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, entities.Principal{Subject: "ana", Roles: []string{"operator"}, Method: entities.AuthMethodJWT, Tenant: tc.tenant}, principal)
+		})
+	}
+
+	t.Run("No token", func(t *testing.T) {
+		_, err := authenticator.Authenticate(context.Background(), Credentials{APIKey: "evk_x"})
+		assert.Equal(t, ErrNoCredentials, err)
+	})
+}
+
+func TestJWTAuthenticatorConfig(t *testing.T) {
+	_, err := NewJWTAuthenticator(Config{JWTSecret: "short"})
+	assert.Error(t, err)
+
+	_, err = NewJWTAuthenticator(Config{JWKSFile: filepath.Join(t.TempDir(), "missing.json")})
+	assert.Error(t, err)
+
+	_, err = ParseKeySet([]byte(`{"keys":[{"kty":"oct","k":"c2VjcmV0"}]}`))
+	assert.Error(t, err, "un JWKS sin claves de firma asimétricas no sirve")
+
+	_, err = ParseKeySet([]byte(`{"keys":[{"kty":"EC","crv":"P-256","x":"AAAA","y":"AAAA"}]}`))
+	assert.Error(t, err)
+
+	// Con el secreto HMAC solamente, un token RS256 se rechaza.
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	authenticator, err := NewJWTAuthenticator(Config{JWTSecret: testSecret})
+	require.NoError(t, err)
+	_, err = authenticator.Authenticate(context.Background(), Credentials{Token: sign(t, jwt.SigningMethodRS256, rsaKey, "", validClaims())})
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+}
+
+func TestAPIKeyAuthenticator(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemoryAPIKeyRepository()
+	authenticator := NewAPIKeyAuthenticator(repo, logrus.New())
+
+	key, prefix, hash, err := GenerateAPIKey()
+	require.NoError(t, err)
+	assert.Equal(t, key[:len(prefix)], prefix)
+	stored, err := repo.CreateAPIKey(ctx, entities.APIKey{Name: "ci", Subject: "svc-ci", Roles: []string{"viewer"}, Prefix: prefix, Hash: hash})
+	require.NoError(t, err)
+
+	principal, err := authenticator.Authenticate(ctx, Credentials{APIKey: key})
+	require.NoError(t, err)
+	assert.Equal(t, entities.Principal{Subject: "svc-ci", Roles: []string{"viewer"}, Method: entities.AuthMethodAPIKey}, principal)
+
+	_, err = authenticator.Authenticate(ctx, Credentials{APIKey: key + "x"})
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+	_, err = repo.RevokeAPIKey(ctx, stored.ID, time.Now())
+	require.NoError(t, err)
+	_, err = authenticator.Authenticate(ctx, Credentials{APIKey: key})
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+	_, err = authenticator.Authenticate(ctx, Credentials{Token: "a.b.c"})
+	assert.Equal(t, ErrNoCredentials, err)
+}
+
+func TestEnsureAPIKey(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemoryAPIKeyRepository()
+
+	require.NoError(t, EnsureAPIKey(ctx, repo, "evk_bootstrap-key"))
+	require.NoError(t, EnsureAPIKey(ctx, repo, "evk_bootstrap-key"))
+
+	keys, err := repo.ListAPIKeys(ctx)
+	require.NoError(t, err)
+	require.Len(t, keys, 1, "la clave inicial se registra una sola vez")
+	assert.Equal(t, []string{"admin"}, keys[0].Roles)
+	assert.Equal(t, HashAPIKey("evk_bootstrap-key"), keys[0].Hash)
+}
+
+func TestChain(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemoryAPIKeyRepository()
+	require.NoError(t, EnsureAPIKey(ctx, repo, "evk_bootstrap-key"))
+
+	authenticator, err := New(Config{JWTSecret: testSecret, APIKeys: true}, repo, logrus.New())
+	require.NoError(t, err)
+
+	principal, err := authenticator.Authenticate(ctx, Credentials{Token: sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", validClaims())})
+	require.NoError(t, err)
+	assert.Equal(t, entities.AuthMethodJWT, principal.Method)
+
+	principal, err = authenticator.Authenticate(ctx, Credentials{APIKey: "evk_bootstrap-key"})
+	require.NoError(t, err)
+	assert.Equal(t, entities.AuthMethodAPIKey, principal.Method)
+
+	_, err = authenticator.Authenticate(ctx, Credentials{})
+	assert.Equal(t, ErrNoCredentials, err)
+
+	disabled, err := New(Config{}, repo, logrus.New())
+	require.NoError(t, err)
+	assert.Nil(t, disabled)
+}
+
+func TestBearerToken(t *testing.T) {
+	token, err := BearerToken("Bearer abc.def.ghi")
+	require.NoError(t, err)
+	assert.Equal(t, "abc.def.ghi", token)
+
+	token, err = BearerToken("bearer  abc")
+	require.NoError(t, err)
+	assert.Equal(t, "abc", token)
+
+	token, err = BearerToken("")
+	require.NoError(t, err)
+	assert.Empty(t, token)
+
+	for _, header := range []string{"Basic dXNlcjpwYXNz", "Bearer", "Bearer "} {
+		_, err = BearerToken(header)
+		assert.ErrorIs(t, err, ErrInvalidCredentials, header)
+	}
+}
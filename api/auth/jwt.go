@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/tenant"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// minSecretLength es el largo mínimo del secreto HMAC, el de la salida de
+// SHA-256.
+const minSecretLength = 32
+
+// clockSkew es la diferencia de reloj que se tolera al validar exp y nbf.
+const clockSkew = 30 * time.Second
+
+// Claims son los claims que se leen del JWT; sub es el principal, roles sus
+// roles y tenant, si viene, el único tenant al que puede acceder.
+type Claims struct {
+	jwt.RegisteredClaims
+	Roles  []string `json:"roles,omitempty"`
+	Tenant string   `json:"tenant,omitempty"`
+}
+
+// JWTAuthenticator valida JWT firmados con un secreto HMAC o con una clave de
+// un JWKS. El tipo de clave lo decide el algoritmo, así un token HS256 nunca
+// se valida con una clave pública.
+type JWTAuthenticator struct {
+	secret []byte
+	keys   *KeySet
+	parser *jwt.Parser
+}
+
+func NewJWTAuthenticator(config Config) (*JWTAuthenticator, error) {
+	a := &JWTAuthenticator{}
+	var methods []string
+	if config.JWTSecret != "" {
+		if len(config.JWTSecret) < minSecretLength {
+			return nil, fmt.Errorf("el secreto JWT debe tener al menos %d bytes", minSecretLength)
+		}
+		a.secret = []byte(config.JWTSecret)
+		methods = append(methods, "HS256", "HS384", "HS512")
+	}
+	if config.JWKSFile != "" {
+		keys, err := LoadKeySet(config.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		a.keys = keys
+		methods = append(methods, "RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512")
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(methods),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(clockSkew),
+	}
+	if config.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(config.Issuer))
+	}
+	if config.Audience != "" {
+		opts = append(opts, jwt.WithAudience(config.Audience))
+	}
+	a.parser = jwt.NewParser(opts...)
+	return a, nil
+}
+
+func (a *JWTAuthenticator) Authenticate(ctx context.Context, credentials Credentials) (entities.Principal, error) {
+	if credentials.Token == "" {
+		return entities.Principal{}, ErrNoCredentials
+	}
+
+	var claims Claims
+	if _, err := a.parser.ParseWithClaims(credentials.Token, &claims, a.key); err != nil {
+		return entities.Principal{}, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+	if claims.Subject == "" {
+		return entities.Principal{}, fmt.Errorf("%w: el token no tiene sub", ErrInvalidCredentials)
+	}
+	if claims.Tenant != "" && !tenant.Valid(claims.Tenant) {
+		return entities.Principal{}, fmt.Errorf("%w: el tenant del token no es válido", ErrInvalidCredentials)
+	}
+	return entities.Principal{Subject: claims.Subject, Roles: claims.Roles, Method: entities.AuthMethodJWT, Tenant: claims.Tenant}, nil
+}
+
+func (a *JWTAuthenticator) key(token *jwt.Token) (any, error) {
+	if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+		if a.secret == nil {
+			return nil, errors.New("no hay secreto para tokens HMAC")
+		}
+		return a.secret, nil
+	}
+	if a.keys == nil {
+		return nil, errors.New("no hay JWKS para tokens firmados con clave pública")
+	}
+	kid, _ := token.Header["kid"].(string)
+	return a.keys.Key(kid)
+}
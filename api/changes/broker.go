@@ -0,0 +1,108 @@
+package changes
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/logging"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Broker publica los cambios a los suscriptores del mismo proceso; con varias
+// instancias cada una solo ve los cambios que guardó ella.
+//
+// Publish nunca se bloquea: si un suscriptor no consume a tiempo y se llena su
+// buffer, se cierra su canal para que vuelva a suscribirse y releer el estado.
+type Broker struct {
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+	buffer      int
+	closed      bool
+	logger      logrus.FieldLogger
+}
+
+type subscriber struct {
+	filter  entities.ChangeFilter
+	changes chan entities.EventChange
+	done    chan struct{}
+}
+
+// NewBroker crea un broker cuyos suscriptores pueden acumular hasta buffer
+// cambios sin leer.
+func NewBroker(buffer int, logger logrus.FieldLogger) *Broker {
+	return &Broker{
+		subscribers: make(map[*subscriber]struct{}),
+		buffer:      buffer,
+		logger:      logger,
+	}
+}
+
+func (b *Broker) Publish(change entities.EventChange) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for sub := range b.subscribers {
+		if !sub.filter.Matches(change) {
+			continue
+		}
+		select {
+		case sub.changes <- change:
+		default:
+			logging.Layer(b.logger, "changes", "Publish").Warn("suscriptor desconectado por no consumir los cambios")
+			b.remove(sub)
+		}
+	}
+}
+
+// Subscribe devuelve un canal con los cambios que cumplen filter. El canal se
+// cierra cuando termina ctx, cuando se cierra el broker o si el suscriptor se
+// queda atrás.
+func (b *Broker) Subscribe(ctx context.Context, filter entities.ChangeFilter) <-chan entities.EventChange {
+	sub := &subscriber{
+		filter:  filter,
+		changes: make(chan entities.EventChange, b.buffer),
+		done:    make(chan struct{}),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		close(sub.changes)
+		return sub.changes
+	}
+	b.subscribers[sub] = struct{}{}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			b.mu.Lock()
+			b.remove(sub)
+			b.mu.Unlock()
+		case <-sub.done:
+		}
+	}()
+	return sub.changes
+}
+
+// Close cierra todas las suscripciones, para que terminen los streams abiertos
+// antes de apagar el servidor.
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for sub := range b.subscribers {
+		b.remove(sub)
+	}
+}
+
+// remove se llama con mu tomado.
+func (b *Broker) remove(sub *subscriber) {
+	if _, ok := b.subscribers[sub]; !ok {
+		return
+	}
+	delete(b.subscribers, sub)
+	close(sub.changes)
+	close(sub.done)
+}
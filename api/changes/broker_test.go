@@ -0,0 +1,76 @@
+package changes
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBrokerFilters(t *testing.T) {
+	broker := NewBroker(4, logrus.New())
+	ctx := context.Background()
+	needs := true
+
+	all := broker.Subscribe(ctx, entities.ChangeFilter{})
+	pending := broker.Subscribe(ctx, entities.ChangeFilter{NeedsAction: &needs})
+
+	reviewed := entities.Event{ID: "1", Status: entities.StatusReviewed}
+	classified := reviewed
+	classified.NeedsAction = true
+	broker.Publish(entities.EventChange{Type: entities.ChangeCreated, Event: reviewed})
+	broker.Publish(entities.EventChange{Type: entities.ChangeClassified, Event: classified, Previous: &reviewed})
+	broker.Publish(entities.EventChange{Type: entities.ChangeUpdated, Event: reviewed, Previous: &classified})
+
+	assert.Equal(t, []string{entities.ChangeCreated, entities.ChangeClassified, entities.ChangeUpdated}, drain(all))
+	// Ve el evento cuando entra y cuando sale del filtro.
+	assert.Equal(t, []string{entities.ChangeClassified, entities.ChangeUpdated}, drain(pending))
+}
+
+func TestBrokerDropsSlowSubscribers(t *testing.T) {
+	broker := NewBroker(1, logrus.New())
+	changes := broker.Subscribe(context.Background(), entities.ChangeFilter{})
+
+	broker.Publish(entities.EventChange{Type: entities.ChangeCreated})
+	broker.Publish(entities.EventChange{Type: entities.ChangeUpdated})
+
+	change, ok := <-changes
+	require.True(t, ok)
+	assert.Equal(t, entities.ChangeCreated, change.Type)
+	_, ok = <-changes
+	assert.False(t, ok)
+}
+
+func TestBrokerClosesSubscriptions(t *testing.T) {
+	broker := NewBroker(1, logrus.New())
+	ctx, cancel := context.WithCancel(context.Background())
+	cancelled := broker.Subscribe(ctx, entities.ChangeFilter{})
+	open := broker.Subscribe(context.Background(), entities.ChangeFilter{})
+
+	cancel()
+	_, ok := <-cancelled
+	assert.False(t, ok)
+
+	broker.Close()
+	_, ok = <-open
+	assert.False(t, ok)
+	_, ok = <-broker.Subscribe(context.Background(), entities.ChangeFilter{})
+	assert.False(t, ok)
+	// Publicar después de cerrar no debe fallar.
+	broker.Publish(entities.EventChange{Type: entities.ChangeCreated})
+}
+
+func drain(changes <-chan entities.EventChange) []string {
+	var types []string
+	for {
+		select {
+		case change := <-changes:
+			types = append(types, change.Type)
+		default:
+			return types
+		}
+	}
+}
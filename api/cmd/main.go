@@ -2,16 +2,23 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"log"
 	"os"
+	"os/signal"
+	"prueba_tecnica/api/config"
+	"prueba_tecnica/api/logging"
 	"prueba_tecnica/api/server"
-	"time"
+	"prueba_tecnica/api/tracing"
+	"syscall"
 
 	_ "prueba_tecnica/api/docs" //
 
 	"github.com/sirupsen/logrus"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel"
 )
 
 // @title API de Gestión de Eventos
@@ -19,24 +26,62 @@ import (
 // @description API para la gestión y clasificación de eventos
 // @host localhost:8080
 // @BasePath /api/v1
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @securityDefinitions.apikey ApiKeyAuth
+// @in header
+// @name X-API-Key
 func main() {
+	cfg, err := config.Load(os.Args[0], os.Args[1:], os.LookupEnv)
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	logger := logrus.StandardLogger()
-	logger.SetFormatter(&logrus.JSONFormatter{})
-
-	dbUrl := os.Getenv("DB_URL")
-	if dbUrl == "" {
-		dbUrl = "mongodb://mongodb:27017"
+	if cfg.Log.Format == config.LogJSON {
+		logger.SetFormatter(&logrus.JSONFormatter{})
+	}
+	// Validate ya comprobó que el nivel es válido.
+	level, _ := logrus.ParseLevel(cfg.Log.Level)
+	logger.SetLevel(level)
+	if cfg.Log.Redact {
+		logger.AddHook(logging.NewRedactHook())
 	}
 
-	defer cancel()
-
-	client, err := mongo.Connect(ctx, options.Client().ApplyURI(dbUrl))
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.Tracing)
 	if err != nil {
 		log.Fatal(err)
 	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			logging.Layer(logger, "main", "main").WithError(err).Error("operación fallida")
+		}
+	}()
+
+	// Con store memory la API levanta sin MongoDB, útil para desarrollo local.
+	var client *mongo.Client
+	if cfg.Store == config.StoreMongo {
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.Mongo.ConnectTimeout)
+		defer cancel()
+		client, err = mongo.Connect(ctx, options.Client().
+			ApplyURI(cfg.Mongo.URL).
+			SetMonitor(tracing.MongoMonitor(otel.GetTracerProvider())))
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
 
-	srv := server.NewServer(client, logger)
-	srv.Run()
+	runCtx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	srv := server.NewServer(client, logger, cfg)
+	if err := srv.Run(runCtx); err != nil {
+		log.Fatal(err)
+	}
 }
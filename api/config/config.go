@@ -0,0 +1,191 @@
+// Package config arma la configuración del servicio. Los valores salen, de
+// menor a mayor prioridad, de los valores por defecto, de un archivo YAML, de
+// variables de entorno y de flags de la línea de comandos.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"prueba_tecnica/api/auth"
+	"prueba_tecnica/api/repository"
+	"prueba_tecnica/api/tracing"
+	"prueba_tecnica/api/webhooks"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Dónde se guardan los eventos.
+const (
+	StoreMongo  = "mongo"
+	StoreMemory = "memory"
+)
+
+// Cómo se separan los datos de cada tenant en MongoDB.
+const (
+	// TenancyShared guarda todos los tenants en la misma base y los separa
+	// por tenant_id.
+	TenancyShared = "shared"
+	// TenancyDatabase guarda cada tenant en su propia base.
+	TenancyDatabase = "database"
+)
+
+// Formatos del log.
+const (
+	LogJSON = "json"
+	LogText = "text"
+)
+
+type Config struct {
+	HTTP  HTTPConfig  `yaml:"http"`
+	GRPC  GRPCConfig  `yaml:"grpc"`
+	Store string      `yaml:"store"`
+	Mongo MongoConfig `yaml:"mongo"`
+	Log   LogConfig   `yaml:"log"`
+	Rules RulesConfig `yaml:"rules"`
+	// TaxonomyFile es la ruta de un archivo YAML/JSON con los estados,
+	// categorías y tipos de evento; vacío usa la taxonomía por defecto.
+	TaxonomyFile string          `yaml:"taxonomy_file"`
+	Purge        PurgeConfig     `yaml:"purge"`
+	Webhooks     webhooks.Config `yaml:"webhooks"`
+	Auth         auth.Config     `yaml:"auth"`
+	Tenancy      TenancyConfig   `yaml:"tenancy"`
+	Tracing      tracing.Config  `yaml:"tracing"`
+	// ShutdownTimeout es cuánto se espera a que terminen las peticiones en
+	// curso al detener el servidor.
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+}
+
+type HTTPConfig struct {
+	Addr string `yaml:"addr"`
+}
+
+type GRPCConfig struct {
+	Addr string `yaml:"addr"`
+}
+
+type MongoConfig struct {
+	URL        string `yaml:"url"`
+	Database   string `yaml:"database"`
+	Collection string `yaml:"collection"`
+	// ConnectTimeout limita la conexión inicial a MongoDB.
+	ConnectTimeout time.Duration `yaml:"connect_timeout"`
+}
+
+type LogConfig struct {
+	Format string `yaml:"format"`
+	// Level es el nivel mínimo que se escribe: debug, info, warn o error.
+	Level string `yaml:"level"`
+	// Redact oculta la descripción de los eventos, que puede traer datos
+	// personales. Solo conviene desactivarlo en desarrollo.
+	Redact bool `yaml:"redact"`
+}
+
+type RulesConfig struct {
+	// Source es la ruta de un archivo YAML/JSON con las reglas de
+	// clasificación, "mongo" para leerlas de la colección classification_rules
+	// o vacío para usar las reglas por defecto.
+	Source string `yaml:"source"`
+	// ReloadInterval es cada cuánto se recargan las reglas; 0 no las recarga.
+	ReloadInterval time.Duration `yaml:"reload_interval"`
+}
+
+type PurgeConfig struct {
+	// Retention es cuánto tiempo se conserva un evento eliminado antes de
+	// borrarlo definitivamente; 0 desactiva la purga.
+	Retention time.Duration `yaml:"retention"`
+	Interval  time.Duration `yaml:"interval"`
+}
+
+type TenancyConfig struct {
+	Mode string `yaml:"mode"`
+}
+
+// Default es la configuración con la que corre el servicio en docker-compose.
+func Default() Config {
+	return Config{
+		HTTP:  HTTPConfig{Addr: ":8080"},
+		GRPC:  GRPCConfig{Addr: ":50051"},
+		Store: StoreMongo,
+		Mongo: MongoConfig{
+			URL:            "mongodb://mongodb:27017",
+			Database:       repository.DefaultDatabase,
+			Collection:     repository.DefaultEventCollection,
+			ConnectTimeout: 10 * time.Second,
+		},
+		Log:   LogConfig{Format: LogJSON, Level: "info", Redact: true},
+		Rules: RulesConfig{ReloadInterval: 30 * time.Second},
+		// Los eventos eliminados se conservan 30 días por defecto.
+		Purge:           PurgeConfig{Retention: 30 * 24 * time.Hour, Interval: time.Hour},
+		Webhooks:        webhooks.DefaultConfig(),
+		Tenancy:         TenancyConfig{Mode: TenancyShared},
+		Tracing:         tracing.DefaultConfig(),
+		ShutdownTimeout: 10 * time.Second,
+	}
+}
+
+// Validate revisa la configuración completa y devuelve todos los problemas
+// juntos.
+func (c Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	check(validAddr(c.HTTP.Addr), "http.addr: %q no es una dirección host:puerto válida", c.HTTP.Addr)
+	check(validAddr(c.GRPC.Addr), "grpc.addr: %q no es una dirección host:puerto válida", c.GRPC.Addr)
+
+	check(c.Store == StoreMongo || c.Store == StoreMemory, "store: debe ser %s o %s", StoreMongo, StoreMemory)
+	if c.Store == StoreMongo {
+		if c.Mongo.URL == "" {
+			errs = append(errs, errors.New("mongo.url: es requerida con store mongo"))
+		} else if err := options.Client().ApplyURI(c.Mongo.URL).Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("mongo.url: %w", err))
+		}
+	}
+	check(c.Mongo.Database != "" && !strings.ContainsAny(c.Mongo.Database, `/\. "$`), "mongo.database: %q no es un nombre de base válido", c.Mongo.Database)
+	check(c.Mongo.Collection != "" && !strings.ContainsAny(c.Mongo.Collection, "$"), "mongo.collection: %q no es un nombre de colección válido", c.Mongo.Collection)
+	check(c.Mongo.ConnectTimeout > 0, "mongo.connect_timeout: debe ser mayor que 0")
+
+	check(c.Log.Format == LogJSON || c.Log.Format == LogText, "log.format: debe ser %s o %s", LogJSON, LogText)
+	_, err := logrus.ParseLevel(c.Log.Level)
+	check(err == nil, "log.level: %q no es un nivel válido", c.Log.Level)
+
+	check(c.Rules.ReloadInterval >= 0, "rules.reload_interval: no puede ser negativo")
+	check(c.Purge.Retention >= 0, "purge.retention: no puede ser negativo")
+	check(c.Purge.Interval >= 0, "purge.interval: no puede ser negativo")
+
+	check(c.Webhooks.MaxAttempts > 0, "webhooks.max_attempts: debe ser mayor que 0")
+	check(c.Webhooks.Backoff > 0, "webhooks.backoff: debe ser mayor que 0")
+	check(c.Webhooks.MaxBackoff >= c.Webhooks.Backoff, "webhooks.max_backoff: no puede ser menor que webhooks.backoff")
+	check(c.Webhooks.Timeout > 0, "webhooks.timeout: debe ser mayor que 0")
+	check(c.Webhooks.Workers > 0, "webhooks.workers: debe ser mayor que 0")
+
+	check(c.Tenancy.Mode == TenancyShared || c.Tenancy.Mode == TenancyDatabase, "tenancy.mode: debe ser %s o %s", TenancyShared, TenancyDatabase)
+
+	check(c.Tracing.Exporter == tracing.ExporterNone || c.Tracing.Exporter == tracing.ExporterStdout || c.Tracing.Exporter == tracing.ExporterOTLP,
+		"tracing.exporter: debe ser %s, %s o %s", tracing.ExporterNone, tracing.ExporterStdout, tracing.ExporterOTLP)
+	check(c.Tracing.SampleRatio >= 0 && c.Tracing.SampleRatio <= 1, "tracing.sample_ratio: debe estar entre 0 y 1")
+	check(c.Tracing.ServiceName != "", "tracing.service_name: es requerido")
+
+	check(c.ShutdownTimeout > 0, "shutdown_timeout: debe ser mayor que 0")
+
+	return errors.Join(errs...)
+}
+
+// validAddr acepta direcciones como ":8080" o "0.0.0.0:8080".
+func validAddr(addr string) bool {
+	_, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	n, err := strconv.Atoi(port)
+	return err == nil && n >= 0 && n <= 65535
+}
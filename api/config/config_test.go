@@ -0,0 +1,147 @@
+package config
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func environment(vars map[string]string) func(string) (string, bool) {
+	return func(name string) (string, bool) {
+		v, ok := vars[name]
+		return v, ok
+	}
+}
+
+func TestLoadDefaults(t *testing.T) {
+	cfg, err := Load("app", nil, environment(nil))
+
+	require.NoError(t, err)
+	assert.Equal(t, Default(), cfg)
+	assert.Equal(t, ":8080", cfg.HTTP.Addr)
+	assert.Equal(t, "events_db", cfg.Mongo.Database)
+	assert.Equal(t, "events", cfg.Mongo.Collection)
+	assert.Equal(t, 10*time.Second, cfg.Mongo.ConnectTimeout)
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+http:
+  addr: ":9000"
+grpc:
+  addr: ":9001"
+mongo:
+  url: mongodb://archivo:27017
+  database: archivo_db
+log:
+  format: text
+webhooks:
+  max_attempts: 3
+`), 0o644))
+
+	cfg, err := Load("app", []string{"-config", path, "-db-url", "mongodb://flag:27017", "-auth-api-keys"}, environment(map[string]string{
+		"DB_URL":               "mongodb://env:27017",
+		"GRPC_ADDR":            ":9101",
+		"DB_NAME":              "",
+		"LOG_FORMAT":           "json",
+		"LOG_LEVEL":            "debug",
+		"TENANT_MODE":          TenancyDatabase,
+		"TRACING_EXPORTER":     "otlp",
+		"TRACING_SAMPLE_RATIO": "0.25",
+	}))
+
+	require.NoError(t, err)
+	// Solo en el archivo.
+	assert.Equal(t, ":9000", cfg.HTTP.Addr)
+	assert.Equal(t, 3, cfg.Webhooks.MaxAttempts)
+	// Una variable vacía no pisa el archivo.
+	assert.Equal(t, "archivo_db", cfg.Mongo.Database)
+	// El entorno pisa el archivo.
+	assert.Equal(t, ":9101", cfg.GRPC.Addr)
+	assert.Equal(t, LogJSON, cfg.Log.Format)
+	assert.Equal(t, "debug", cfg.Log.Level)
+	assert.Equal(t, TenancyDatabase, cfg.Tenancy.Mode)
+	assert.Equal(t, "otlp", cfg.Tracing.Exporter)
+	assert.Equal(t, 0.25, cfg.Tracing.SampleRatio)
+	// Los flags pisan el entorno.
+	assert.Equal(t, "mongodb://flag:27017", cfg.Mongo.URL)
+	assert.True(t, cfg.Auth.APIKeys)
+	// Lo que nadie fija conserva el valor por defecto.
+	assert.Equal(t, "events", cfg.Mongo.Collection)
+}
+
+func TestLoadConfigFileFromEnv(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("store: memory\n"), 0o644))
+
+	cfg, err := Load("app", nil, environment(map[string]string{FileEnv: path, "GRPC_PORT": "50099"}))
+
+	require.NoError(t, err)
+	assert.Equal(t, StoreMemory, cfg.Store)
+	assert.Equal(t, ":50099", cfg.GRPC.Addr)
+}
+
+func TestLoadErrors(t *testing.T) {
+	dir := t.TempDir()
+	unknown := filepath.Join(dir, "unknown.yaml")
+	require.NoError(t, os.WriteFile(unknown, []byte("mongo:\n  uri: mongodb://localhost\n"), 0o644))
+
+	testCases := []struct {
+		name     string
+		args     []string
+		env      map[string]string
+		expected string
+	}{
+		{name: "Unknown key in file", args: []string{"-config", unknown}, expected: "field uri not found"},
+		{name: "Missing file", args: []string{"-config", filepath.Join(dir, "missing.yaml")}, expected: "no such file"},
+		{name: "Invalid duration in env", env: map[string]string{"PURGE_RETENTION": "un mes"}, expected: "PURGE_RETENTION"},
+		{name: "Invalid integer in flag", args: []string{"-webhook-max-attempts", "seis"}, expected: "-webhook-max-attempts"},
+		{name: "Unknown flag", args: []string{"-port", "80"}, expected: "flag provided but not defined"},
+		{name: "Positional argument", args: []string{"serve"}, expected: "argumentos no esperados"},
+		{name: "Invalid value", args: []string{"-store", "postgres"}, expected: "store: debe ser mongo o memory"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Load("app", tc.args, environment(tc.env))
+			assert.ErrorContains(t, err, tc.expected)
+		})
+	}
+}
+
+func TestLoadHelp(t *testing.T) {
+	_, err := Load("app", []string{"-h"}, environment(nil))
+	assert.ErrorIs(t, err, flag.ErrHelp)
+}
+
+func TestValidate(t *testing.T) {
+	cfg := Default()
+	cfg.HTTP.Addr = "8080"
+	cfg.Mongo.URL = "localhost:27017"
+	cfg.Mongo.Database = "events.db"
+	cfg.Mongo.ConnectTimeout = 0
+	cfg.Log.Format = "xml"
+	cfg.Log.Level = "verbose"
+	cfg.Webhooks.MaxBackoff = time.Second
+	cfg.Tenancy.Mode = "schema"
+	cfg.Tracing.Exporter = "jaeger"
+	cfg.Tracing.SampleRatio = 2
+
+	err := cfg.Validate()
+
+	require.Error(t, err)
+	for _, field := range []string{"http.addr", "mongo.url", "mongo.database", "mongo.connect_timeout", "log.format", "log.level", "webhooks.max_backoff", "tenancy.mode", "tracing.exporter", "tracing.sample_ratio"} {
+		assert.ErrorContains(t, err, field)
+	}
+
+	memory := Default()
+	memory.Store = StoreMemory
+	memory.Mongo.URL = ""
+	assert.NoError(t, memory.Validate())
+}
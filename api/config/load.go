@@ -0,0 +1,210 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FileEnv es la variable de entorno con la ruta del archivo de
+// configuración; el flag -config tiene prioridad sobre ella.
+const FileEnv = "CONFIG_FILE"
+
+// setting es un valor que se puede fijar con una variable de entorno, un flag
+// o ambos. set convierte el texto y lo guarda en la configuración; los flags
+// con isBool se pueden usar sin valor, como -auth-api-keys.
+type setting struct {
+	env    string
+	flag   string
+	usage  string
+	isBool bool
+	set    func(c *Config, v string) error
+}
+
+// settings se aplican en orden, así que si dos variables fijan el mismo valor
+// gana la última.
+var settings = []setting{
+	{env: "HTTP_ADDR", flag: "http-addr", usage: "dirección del servidor HTTP", set: str(func(c *Config) *string { return &c.HTTP.Addr })},
+	// GRPC_PORT es anterior a GRPC_ADDR y se mantiene por compatibilidad.
+	{env: "GRPC_PORT", set: func(c *Config, v string) error { c.GRPC.Addr = ":" + v; return nil }},
+	{env: "GRPC_ADDR", flag: "grpc-addr", usage: "dirección del servidor gRPC", set: str(func(c *Config) *string { return &c.GRPC.Addr })},
+	{env: "EVENT_STORE", flag: "store", usage: "dónde se guardan los eventos: mongo o memory", set: str(func(c *Config) *string { return &c.Store })},
+	{env: "DB_URL", flag: "db-url", usage: "URL de conexión a MongoDB", set: str(func(c *Config) *string { return &c.Mongo.URL })},
+	{env: "DB_NAME", flag: "db-name", usage: "base de datos de MongoDB", set: str(func(c *Config) *string { return &c.Mongo.Database })},
+	{env: "DB_COLLECTION", flag: "db-collection", usage: "colección de eventos", set: str(func(c *Config) *string { return &c.Mongo.Collection })},
+	{env: "DB_CONNECT_TIMEOUT", flag: "db-connect-timeout", usage: "tiempo máximo para conectar a MongoDB", set: duration(func(c *Config) *time.Duration { return &c.Mongo.ConnectTimeout })},
+	{env: "LOG_FORMAT", flag: "log-format", usage: "formato del log: json o text", set: str(func(c *Config) *string { return &c.Log.Format })},
+	{env: "LOG_LEVEL", flag: "log-level", usage: "nivel del log: debug, info, warn o error", set: str(func(c *Config) *string { return &c.Log.Level })},
+	{env: "LOG_REDACT", flag: "log-redact", usage: "oculta la descripción de los eventos en el log", isBool: true, set: boolean(func(c *Config) *bool { return &c.Log.Redact })},
+	{env: "CLASSIFICATION_RULES", flag: "rules", usage: "archivo de reglas de clasificación o mongo", set: str(func(c *Config) *string { return &c.Rules.Source })},
+	{env: "CLASSIFICATION_RULES_RELOAD", flag: "rules-reload", usage: "cada cuánto se recargan las reglas", set: duration(func(c *Config) *time.Duration { return &c.Rules.ReloadInterval })},
+	{env: "TAXONOMY_FILE", flag: "taxonomy", usage: "archivo con la taxonomía de estados, categorías y tipos", set: str(func(c *Config) *string { return &c.TaxonomyFile })},
+	{env: "PURGE_RETENTION", flag: "purge-retention", usage: "cuánto se conserva un evento eliminado; 0 desactiva la purga", set: duration(func(c *Config) *time.Duration { return &c.Purge.Retention })},
+	{env: "PURGE_INTERVAL", flag: "purge-interval", usage: "cada cuánto corre la purga", set: duration(func(c *Config) *time.Duration { return &c.Purge.Interval })},
+	{env: "WEBHOOK_MAX_ATTEMPTS", flag: "webhook-max-attempts", usage: "intentos de envío de un webhook", set: integer(func(c *Config) *int { return &c.Webhooks.MaxAttempts })},
+	{env: "WEBHOOK_BACKOFF", flag: "webhook-backoff", usage: "espera antes del primer reintento de un webhook", set: duration(func(c *Config) *time.Duration { return &c.Webhooks.Backoff })},
+	{env: "WEBHOOK_MAX_BACKOFF", flag: "webhook-max-backoff", usage: "espera máxima entre reintentos de un webhook", set: duration(func(c *Config) *time.Duration { return &c.Webhooks.MaxBackoff })},
+	{env: "WEBHOOK_TIMEOUT", flag: "webhook-timeout", usage: "tiempo máximo de cada envío de un webhook", set: duration(func(c *Config) *time.Duration { return &c.Webhooks.Timeout })},
+	// Los secretos no tienen flag para que no queden en la lista de procesos.
+	{env: "AUTH_JWT_SECRET", set: str(func(c *Config) *string { return &c.Auth.JWTSecret })},
+	{env: "AUTH_JWKS_FILE", flag: "auth-jwks-file", usage: "JWKS con las claves públicas de los JWT", set: str(func(c *Config) *string { return &c.Auth.JWKSFile })},
+	{env: "AUTH_JWT_ISSUER", flag: "auth-jwt-issuer", usage: "issuer exigido en los JWT", set: str(func(c *Config) *string { return &c.Auth.Issuer })},
+	{env: "AUTH_JWT_AUDIENCE", flag: "auth-jwt-audience", usage: "audience exigida en los JWT", set: str(func(c *Config) *string { return &c.Auth.Audience })},
+	{env: "AUTH_API_KEYS", flag: "auth-api-keys", usage: "acepta API keys", isBool: true, set: boolean(func(c *Config) *bool { return &c.Auth.APIKeys })},
+	{env: "AUTH_BOOTSTRAP_API_KEY", set: str(func(c *Config) *string { return &c.Auth.BootstrapAPIKey })},
+	{env: "SHUTDOWN_TIMEOUT", flag: "shutdown-timeout", usage: "espera máxima por las peticiones en curso al detener el servidor", set: duration(func(c *Config) *time.Duration { return &c.ShutdownTimeout })},
+	{env: "TRACING_EXPORTER", flag: "tracing-exporter", usage: "exportador de trazas: none, stdout u otlp", set: str(func(c *Config) *string { return &c.Tracing.Exporter })},
+	{env: "TRACING_ENDPOINT", flag: "tracing-endpoint", usage: "host:puerto del colector OTLP", set: str(func(c *Config) *string { return &c.Tracing.Endpoint })},
+	{env: "TRACING_INSECURE", flag: "tracing-insecure", usage: "conecta al colector OTLP sin TLS", isBool: true, set: boolean(func(c *Config) *bool { return &c.Tracing.Insecure })},
+	{env: "TRACING_SAMPLE_RATIO", flag: "tracing-sample-ratio", usage: "fracción de trazas que se guardan, entre 0 y 1", set: ratio(func(c *Config) *float64 { return &c.Tracing.SampleRatio })},
+	{env: "TENANT_MODE", flag: "tenant-mode", usage: "separación de tenants en MongoDB: shared o database", set: str(func(c *Config) *string { return &c.Tenancy.Mode })},
+}
+
+// Load arma la configuración a partir de los valores por defecto, el archivo
+// de -config o CONFIG_FILE, las variables de entorno que devuelve lookupEnv y
+// los flags de args, en ese orden de prioridad, y la valida. Con -h devuelve
+// flag.ErrHelp.
+func Load(name string, args []string, lookupEnv func(string) (string, bool)) (Config, error) {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	configFile := fs.String("config", "", "archivo YAML de configuración (también "+FileEnv+")")
+	type flagValue struct {
+		setting
+		value string
+	}
+	var flagged []flagValue
+	for _, s := range settings {
+		if s.flag == "" {
+			continue
+		}
+		record := func(v string) error {
+			flagged = append(flagged, flagValue{setting: s, value: v})
+			return nil
+		}
+		if s.isBool {
+			fs.BoolFunc(s.flag, s.usage+" ("+s.env+")", record)
+		} else {
+			fs.Func(s.flag, s.usage+" ("+s.env+")", record)
+		}
+	}
+	if err := fs.Parse(args); err != nil {
+		return Config{}, err
+	}
+	if fs.NArg() > 0 {
+		return Config{}, fmt.Errorf("argumentos no esperados: %v", fs.Args())
+	}
+
+	config := Default()
+
+	path := *configFile
+	if path == "" {
+		path, _ = env(lookupEnv, FileEnv)
+	}
+	if path != "" {
+		if err := config.readFile(path); err != nil {
+			return Config{}, err
+		}
+	}
+
+	for _, s := range settings {
+		v, ok := env(lookupEnv, s.env)
+		if !ok {
+			continue
+		}
+		if err := s.set(&config, v); err != nil {
+			return Config{}, fmt.Errorf("%s: %w", s.env, err)
+		}
+	}
+
+	for _, f := range flagged {
+		if err := f.set(&config, f.value); err != nil {
+			return Config{}, fmt.Errorf("-%s: %w", f.flag, err)
+		}
+	}
+
+	if err := config.Validate(); err != nil {
+		return Config{}, err
+	}
+	return config, nil
+}
+
+// readFile carga un archivo YAML sobre c. Las claves desconocidas son un
+// error para que una errata no pase desapercibida.
+func (c *Config) readFile(path string) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(b))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// env trata una variable vacía igual que una que no está definida.
+func env(lookupEnv func(string) (string, bool), name string) (string, bool) {
+	v, ok := lookupEnv(name)
+	return v, ok && v != ""
+}
+
+func str(field func(*Config) *string) func(*Config, string) error {
+	return func(c *Config, v string) error {
+		*field(c) = v
+		return nil
+	}
+}
+
+// duration acepta valores como "30s" o "720h".
+func duration(field func(*Config) *time.Duration) func(*Config, string) error {
+	return func(c *Config, v string) error {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return err
+		}
+		*field(c) = d
+		return nil
+	}
+}
+
+func integer(field func(*Config) *int) func(*Config, string) error {
+	return func(c *Config, v string) error {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return err
+		}
+		*field(c) = n
+		return nil
+	}
+}
+
+func ratio(field func(*Config) *float64) func(*Config, string) error {
+	return func(c *Config, v string) error {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return err
+		}
+		*field(c) = f
+		return nil
+	}
+}
+
+// boolean acepta "true", "1", "false", "0" y las demás formas de
+// strconv.ParseBool.
+func boolean(field func(*Config) *bool) func(*Config, string) error {
+	return func(c *Config, v string) error {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return err
+		}
+		*field(c) = b
+		return nil
+	}
+}
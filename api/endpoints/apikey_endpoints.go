@@ -0,0 +1,21 @@
+package endpoints
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/service"
+)
+
+type APIKeyEndpoints struct {
+	CreateAPIKey func(ctx context.Context, key entities.APIKey) (entities.APIKey, error)
+	ListAPIKeys  func(ctx context.Context) ([]entities.APIKey, error)
+	RevokeAPIKey func(ctx context.Context, id string) (entities.APIKey, error)
+}
+
+func NewAPIKeyEndpoints(s service.APIKeyService) APIKeyEndpoints {
+	return APIKeyEndpoints{
+		CreateAPIKey: s.CreateAPIKey,
+		ListAPIKeys:  s.ListAPIKeys,
+		RevokeAPIKey: s.RevokeAPIKey,
+	}
+}
@@ -0,0 +1,44 @@
+package endpoints
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateAPIKey(t *testing.T) {
+	mockService := new(MockAPIKeyService)
+	endpoints := NewAPIKeyEndpoints(mockService)
+	ctx := context.Background()
+	key := entities.APIKey{Name: "ci", Subject: "svc-ci"}
+	created := key
+	created.ID = "1"
+	created.Key = "evk_secret"
+	mockService.On("CreateAPIKey", ctx, key).Return(created, nil)
+
+	result, err := endpoints.CreateAPIKey(ctx, key)
+
+	assert.NoError(t, err)
+	assert.Equal(t, created, result)
+	mockService.AssertExpectations(t)
+}
+
+func TestListAndRevokeAPIKeys(t *testing.T) {
+	mockService := new(MockAPIKeyService)
+	endpoints := NewAPIKeyEndpoints(mockService)
+	ctx := context.Background()
+	keys := []entities.APIKey{{ID: "1", Name: "ci"}}
+	mockService.On("ListAPIKeys", ctx).Return(keys, nil)
+	mockService.On("RevokeAPIKey", ctx, "1").Return(keys[0], nil)
+
+	result, err := endpoints.ListAPIKeys(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, keys, result)
+
+	revoked, err := endpoints.RevokeAPIKey(ctx, "1")
+	assert.NoError(t, err)
+	assert.Equal(t, keys[0], revoked)
+	mockService.AssertExpectations(t)
+}
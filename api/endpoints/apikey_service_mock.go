@@ -0,0 +1,27 @@
+package endpoints
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockAPIKeyService struct {
+	mock.Mock
+}
+
+func (m *MockAPIKeyService) CreateAPIKey(ctx context.Context, key entities.APIKey) (entities.APIKey, error) {
+	args := m.Called(ctx, key)
+	return args.Get(0).(entities.APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyService) ListAPIKeys(ctx context.Context) ([]entities.APIKey, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]entities.APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyService) RevokeAPIKey(ctx context.Context, id string) (entities.APIKey, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(entities.APIKey), args.Error(1)
+}
@@ -4,25 +4,40 @@ import (
 	"context"
 	"prueba_tecnica/api/entities"
 	"prueba_tecnica/api/service"
+	"prueba_tecnica/api/taxonomy"
 )
 
 type EventEndpoints struct {
 	CreateEvent            func(ctx context.Context, event entities.Event) (entities.Event, error)
 	GetEventByID           func(ctx context.Context, id string) (entities.Event, error)
-	GetAllEvents           func(ctx context.Context) ([]entities.Event, error)
-	GetEventsByStatus      func(ctx context.Context, status string) ([]entities.Event, error)
-	GetEventsByCategory    func(ctx context.Context, category string) ([]entities.Event, error)
-	GetEventsNeedingAction func(ctx context.Context) ([]entities.Event, error)
+	ListEvents             func(ctx context.Context, query entities.EventQuery) (entities.EventPage, error)
+	SearchEvents           func(ctx context.Context, query entities.SearchQuery) (entities.SearchPage, error)
+	GetAllEvents           func(ctx context.Context, page entities.PageRequest) (entities.EventPage, error)
+	GetEventsByStatus      func(ctx context.Context, status entities.Status, page entities.PageRequest) (entities.EventPage, error)
+	GetEventsByCategory    func(ctx context.Context, category entities.Category, page entities.PageRequest) (entities.EventPage, error)
+	GetEventsNeedingAction func(ctx context.Context, page entities.PageRequest) (entities.EventPage, error)
 	UpdateEvent            func(ctx context.Context, event entities.Event) (entities.Event, error)
 	DeleteEvent            func(ctx context.Context, id string) error
 	ClassifyEvent          func(ctx context.Context, id string) (entities.Event, error)
-	ManualClassifyEvent    func(ctx context.Context, id string, category string) (entities.Event, error)
+	ManualClassifyEvent    func(ctx context.Context, id string, category entities.Category) (entities.Event, error)
+	DryRunClassification   func(ctx context.Context, event entities.Event) (entities.Classification, error)
+	TransitionEvent        func(ctx context.Context, id string, transition entities.StatusTransition) (entities.Event, error)
+	GetEventHistory        func(ctx context.Context, id string) ([]entities.AuditEntry, error)
+	RestoreEvent           func(ctx context.Context, id string) (entities.Event, error)
+	PatchEvent             func(ctx context.Context, id string, patch entities.EventPatch) (entities.Event, error)
+	CreateEvents           func(ctx context.Context, events []entities.Event) ([]entities.BulkResult, error)
+	UpdateEvents           func(ctx context.Context, events []entities.Event) ([]entities.BulkResult, error)
+	ClassifyEvents         func(ctx context.Context, ids []string) ([]entities.BulkResult, error)
+	WatchEvents            func(ctx context.Context, filter entities.ChangeFilter) (<-chan entities.EventChange, error)
+	GetTaxonomy            func(ctx context.Context) (taxonomy.Taxonomy, error)
 }
 
 func NewEventEndpoints(s service.EventService) EventEndpoints {
 	return EventEndpoints{
 		CreateEvent:            s.CreateEvent,
 		GetEventByID:           s.GetEventByID,
+		ListEvents:             s.ListEvents,
+		SearchEvents:           s.SearchEvents,
 		GetAllEvents:           s.GetAllEvents,
 		GetEventsByStatus:      s.GetEventsByStatus,
 		GetEventsByCategory:    s.GetEventsByCategory,
@@ -31,5 +46,15 @@ func NewEventEndpoints(s service.EventService) EventEndpoints {
 		DeleteEvent:            s.DeleteEvent,
 		ClassifyEvent:          s.ClassifyEvent,
 		ManualClassifyEvent:    s.ManualClassifyEvent,
+		DryRunClassification:   s.DryRunClassification,
+		TransitionEvent:        s.TransitionEvent,
+		GetEventHistory:        s.GetEventHistory,
+		RestoreEvent:           s.RestoreEvent,
+		PatchEvent:             s.PatchEvent,
+		CreateEvents:           s.CreateEvents,
+		UpdateEvents:           s.UpdateEvents,
+		ClassifyEvents:         s.ClassifyEvents,
+		WatchEvents:            s.WatchEvents,
+		GetTaxonomy:            s.GetTaxonomy,
 	}
 }
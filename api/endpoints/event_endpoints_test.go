@@ -37,6 +37,22 @@ func TestGetEventByID(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestSearchEvents(t *testing.T) {
+	mockService := new(MockEventService)
+	endpoints := NewEventEndpoints(mockService)
+	ctx := context.Background()
+
+	query := entities.SearchQuery{Text: "vpn", Status: "Revisado"}
+	page := entities.SearchPage{Hits: []entities.SearchHit{{Event: entities.Event{ID: "1", Name: "Caída de VPN"}, Score: 5}}}
+	mockService.On("SearchEvents", ctx, query).Return(page, nil)
+
+	result, err := endpoints.SearchEvents(ctx, query)
+
+	assert.NoError(t, err)
+	assert.Equal(t, page, result)
+	mockService.AssertExpectations(t)
+}
+
 func TestDeleteEvent(t *testing.T) {
 	mockService := new(MockEventService)
 	endpoints := NewEventEndpoints(mockService)
@@ -83,7 +99,7 @@ func TestManualClassifyEvent(t *testing.T) {
 	endpoints := NewEventEndpoints(mockService)
 	ctx := context.Background()
 	event := entities.Event{ID: "1", Name: "Manually Classified Event"}
-	mockService.On("ManualClassifyEvent", ctx, "1", "urgent").Return(event, nil)
+	mockService.On("ManualClassifyEvent", ctx, "1", entities.Category("urgent")).Return(event, nil)
 
 	result, err := endpoints.ManualClassifyEvent(ctx, "1", "urgent")
 
@@ -91,3 +107,121 @@ func TestManualClassifyEvent(t *testing.T) {
 	assert.Equal(t, event, result)
 	mockService.AssertExpectations(t)
 }
+
+func TestTransitionEvent(t *testing.T) {
+	mockService := new(MockEventService)
+	endpoints := NewEventEndpoints(mockService)
+	ctx := context.Background()
+	transition := entities.StatusTransition{To: entities.StatusInReview, Actor: "ana"}
+	event := entities.Event{ID: "1", Status: entities.StatusInReview}
+	mockService.On("TransitionEvent", ctx, "1", transition).Return(event, nil)
+
+	result, err := endpoints.TransitionEvent(ctx, "1", transition)
+
+	assert.NoError(t, err)
+	assert.Equal(t, event, result)
+	mockService.AssertExpectations(t)
+}
+
+func TestGetEventHistory(t *testing.T) {
+	mockService := new(MockEventService)
+	endpoints := NewEventEndpoints(mockService)
+	ctx := context.Background()
+	history := []entities.AuditEntry{{EventID: "1", Action: entities.AuditCreate}}
+	mockService.On("GetEventHistory", ctx, "1").Return(history, nil)
+
+	result, err := endpoints.GetEventHistory(ctx, "1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, history, result)
+	mockService.AssertExpectations(t)
+}
+
+func TestRestoreEvent(t *testing.T) {
+	mockService := new(MockEventService)
+	endpoints := NewEventEndpoints(mockService)
+	ctx := context.Background()
+	event := entities.Event{ID: "1", Name: "Restored Event"}
+	mockService.On("RestoreEvent", ctx, "1").Return(event, nil)
+
+	result, err := endpoints.RestoreEvent(ctx, "1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, event, result)
+	mockService.AssertExpectations(t)
+}
+
+func TestPatchEvent(t *testing.T) {
+	mockService := new(MockEventService)
+	endpoints := NewEventEndpoints(mockService)
+	ctx := context.Background()
+	name := "Patched Event"
+	patch := entities.EventPatch{Name: &name}
+	event := entities.Event{ID: "1", Name: name}
+	mockService.On("PatchEvent", ctx, "1", patch).Return(event, nil)
+
+	result, err := endpoints.PatchEvent(ctx, "1", patch)
+
+	assert.NoError(t, err)
+	assert.Equal(t, event, result)
+	mockService.AssertExpectations(t)
+}
+
+func TestCreateEvents(t *testing.T) {
+	mockService := new(MockEventService)
+	endpoints := NewEventEndpoints(mockService)
+	ctx := context.Background()
+	events := []entities.Event{{Name: "Test Event"}}
+	results := []entities.BulkResult{{Index: 0, ID: "1", Version: 1}}
+	mockService.On("CreateEvents", ctx, events).Return(results, nil)
+
+	result, err := endpoints.CreateEvents(ctx, events)
+
+	assert.NoError(t, err)
+	assert.Equal(t, results, result)
+	mockService.AssertExpectations(t)
+}
+
+func TestUpdateEvents(t *testing.T) {
+	mockService := new(MockEventService)
+	endpoints := NewEventEndpoints(mockService)
+	ctx := context.Background()
+	events := []entities.Event{{ID: "1", Name: "Updated Event"}}
+	results := []entities.BulkResult{{Index: 0, ID: "1", Version: 2}}
+	mockService.On("UpdateEvents", ctx, events).Return(results, nil)
+
+	result, err := endpoints.UpdateEvents(ctx, events)
+
+	assert.NoError(t, err)
+	assert.Equal(t, results, result)
+	mockService.AssertExpectations(t)
+}
+
+func TestClassifyEvents(t *testing.T) {
+	mockService := new(MockEventService)
+	endpoints := NewEventEndpoints(mockService)
+	ctx := context.Background()
+	results := []entities.BulkResult{{Index: 0, ID: "1", Version: 2}}
+	mockService.On("ClassifyEvents", ctx, []string{"1"}).Return(results, nil)
+
+	result, err := endpoints.ClassifyEvents(ctx, []string{"1"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, results, result)
+	mockService.AssertExpectations(t)
+}
+
+func TestWatchEvents(t *testing.T) {
+	mockService := new(MockEventService)
+	endpoints := NewEventEndpoints(mockService)
+	ctx := context.Background()
+	filter := entities.ChangeFilter{Status: "Revisado"}
+	var feed <-chan entities.EventChange = make(chan entities.EventChange)
+	mockService.On("WatchEvents", ctx, filter).Return(feed, nil)
+
+	result, err := endpoints.WatchEvents(ctx, filter)
+
+	assert.NoError(t, err)
+	assert.Equal(t, feed, result)
+	mockService.AssertExpectations(t)
+}
@@ -3,6 +3,7 @@ package endpoints
 import (
 	"context"
 	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/taxonomy"
 
 	"github.com/stretchr/testify/mock"
 )
@@ -21,24 +22,34 @@ func (m *MockEventService) GetEventByID(ctx context.Context, id string) (entitie
 	return args.Get(0).(entities.Event), args.Error(1)
 }
 
-func (m *MockEventService) GetAllEvents(ctx context.Context) ([]entities.Event, error) {
-	args := m.Called(ctx)
-	return args.Get(0).([]entities.Event), args.Error(1)
+func (m *MockEventService) ListEvents(ctx context.Context, query entities.EventQuery) (entities.EventPage, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).(entities.EventPage), args.Error(1)
 }
 
-func (m *MockEventService) GetEventsByStatus(ctx context.Context, status string) ([]entities.Event, error) {
-	args := m.Called(ctx, status)
-	return args.Get(0).([]entities.Event), args.Error(1)
+func (m *MockEventService) SearchEvents(ctx context.Context, query entities.SearchQuery) (entities.SearchPage, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).(entities.SearchPage), args.Error(1)
 }
 
-func (m *MockEventService) GetEventsByCategory(ctx context.Context, category string) ([]entities.Event, error) {
-	args := m.Called(ctx, category)
-	return args.Get(0).([]entities.Event), args.Error(1)
+func (m *MockEventService) GetAllEvents(ctx context.Context, page entities.PageRequest) (entities.EventPage, error) {
+	args := m.Called(ctx, page)
+	return args.Get(0).(entities.EventPage), args.Error(1)
 }
 
-func (m *MockEventService) GetEventsNeedingAction(ctx context.Context) ([]entities.Event, error) {
-	args := m.Called(ctx)
-	return args.Get(0).([]entities.Event), args.Error(1)
+func (m *MockEventService) GetEventsByStatus(ctx context.Context, status entities.Status, page entities.PageRequest) (entities.EventPage, error) {
+	args := m.Called(ctx, status, page)
+	return args.Get(0).(entities.EventPage), args.Error(1)
+}
+
+func (m *MockEventService) GetEventsByCategory(ctx context.Context, category entities.Category, page entities.PageRequest) (entities.EventPage, error) {
+	args := m.Called(ctx, category, page)
+	return args.Get(0).(entities.EventPage), args.Error(1)
+}
+
+func (m *MockEventService) GetEventsNeedingAction(ctx context.Context, page entities.PageRequest) (entities.EventPage, error) {
+	args := m.Called(ctx, page)
+	return args.Get(0).(entities.EventPage), args.Error(1)
 }
 
 func (m *MockEventService) UpdateEvent(ctx context.Context, event entities.Event) (entities.Event, error) {
@@ -56,7 +67,57 @@ func (m *MockEventService) ClassifyEvent(ctx context.Context, id string) (entiti
 	return args.Get(0).(entities.Event), args.Error(1)
 }
 
-func (m *MockEventService) ManualClassifyEvent(ctx context.Context, id string, category string) (entities.Event, error) {
+func (m *MockEventService) ManualClassifyEvent(ctx context.Context, id string, category entities.Category) (entities.Event, error) {
 	args := m.Called(ctx, id, category)
 	return args.Get(0).(entities.Event), args.Error(1)
 }
+
+func (m *MockEventService) DryRunClassification(ctx context.Context, event entities.Event) (entities.Classification, error) {
+	args := m.Called(ctx, event)
+	return args.Get(0).(entities.Classification), args.Error(1)
+}
+
+func (m *MockEventService) TransitionEvent(ctx context.Context, id string, transition entities.StatusTransition) (entities.Event, error) {
+	args := m.Called(ctx, id, transition)
+	return args.Get(0).(entities.Event), args.Error(1)
+}
+
+func (m *MockEventService) GetEventHistory(ctx context.Context, id string) ([]entities.AuditEntry, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).([]entities.AuditEntry), args.Error(1)
+}
+
+func (m *MockEventService) RestoreEvent(ctx context.Context, id string) (entities.Event, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(entities.Event), args.Error(1)
+}
+
+func (m *MockEventService) PatchEvent(ctx context.Context, id string, patch entities.EventPatch) (entities.Event, error) {
+	args := m.Called(ctx, id, patch)
+	return args.Get(0).(entities.Event), args.Error(1)
+}
+
+func (m *MockEventService) CreateEvents(ctx context.Context, events []entities.Event) ([]entities.BulkResult, error) {
+	args := m.Called(ctx, events)
+	return args.Get(0).([]entities.BulkResult), args.Error(1)
+}
+
+func (m *MockEventService) UpdateEvents(ctx context.Context, events []entities.Event) ([]entities.BulkResult, error) {
+	args := m.Called(ctx, events)
+	return args.Get(0).([]entities.BulkResult), args.Error(1)
+}
+
+func (m *MockEventService) ClassifyEvents(ctx context.Context, ids []string) ([]entities.BulkResult, error) {
+	args := m.Called(ctx, ids)
+	return args.Get(0).([]entities.BulkResult), args.Error(1)
+}
+
+func (m *MockEventService) WatchEvents(ctx context.Context, filter entities.ChangeFilter) (<-chan entities.EventChange, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(<-chan entities.EventChange), args.Error(1)
+}
+
+func (m *MockEventService) GetTaxonomy(ctx context.Context) (taxonomy.Taxonomy, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(taxonomy.Taxonomy), args.Error(1)
+}
@@ -0,0 +1,29 @@
+package endpoints
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/service"
+)
+
+type WebhookEndpoints struct {
+	CreateWebhook   func(ctx context.Context, webhook entities.Webhook) (entities.Webhook, error)
+	GetWebhook      func(ctx context.Context, id string) (entities.Webhook, error)
+	ListWebhooks    func(ctx context.Context) ([]entities.Webhook, error)
+	UpdateWebhook   func(ctx context.Context, webhook entities.Webhook) (entities.Webhook, error)
+	DeleteWebhook   func(ctx context.Context, id string) error
+	ListDeliveries  func(ctx context.Context, id string, limit int) ([]entities.WebhookDelivery, error)
+	ListDeadLetters func(ctx context.Context, id string, limit int) ([]entities.WebhookDelivery, error)
+}
+
+func NewWebhookEndpoints(s service.WebhookService) WebhookEndpoints {
+	return WebhookEndpoints{
+		CreateWebhook:   s.CreateWebhook,
+		GetWebhook:      s.GetWebhook,
+		ListWebhooks:    s.ListWebhooks,
+		UpdateWebhook:   s.UpdateWebhook,
+		DeleteWebhook:   s.DeleteWebhook,
+		ListDeliveries:  s.ListDeliveries,
+		ListDeadLetters: s.ListDeadLetters,
+	}
+}
@@ -0,0 +1,69 @@
+package endpoints
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateWebhook(t *testing.T) {
+	mockService := new(MockWebhookService)
+	endpoints := NewWebhookEndpoints(mockService)
+	ctx := context.Background()
+	webhook := entities.Webhook{URL: "https://example.com/hook", Triggers: []string{entities.TriggerNeedsAction}}
+	created := webhook
+	created.ID = "1"
+	mockService.On("CreateWebhook", ctx, webhook).Return(created, nil)
+
+	result, err := endpoints.CreateWebhook(ctx, webhook)
+
+	assert.NoError(t, err)
+	assert.Equal(t, created, result)
+	mockService.AssertExpectations(t)
+}
+
+func TestListWebhooks(t *testing.T) {
+	mockService := new(MockWebhookService)
+	endpoints := NewWebhookEndpoints(mockService)
+	ctx := context.Background()
+	webhooks := []entities.Webhook{{ID: "1"}}
+	mockService.On("ListWebhooks", ctx).Return(webhooks, nil)
+
+	result, err := endpoints.ListWebhooks(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, webhooks, result)
+	mockService.AssertExpectations(t)
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	mockService := new(MockWebhookService)
+	endpoints := NewWebhookEndpoints(mockService)
+	ctx := context.Background()
+	mockService.On("DeleteWebhook", ctx, "1").Return(nil)
+
+	err := endpoints.DeleteWebhook(ctx, "1")
+
+	assert.NoError(t, err)
+	mockService.AssertExpectations(t)
+}
+
+func TestListDeliveries(t *testing.T) {
+	mockService := new(MockWebhookService)
+	endpoints := NewWebhookEndpoints(mockService)
+	ctx := context.Background()
+	deliveries := []entities.WebhookDelivery{{ID: "d1", WebhookID: "1"}}
+	mockService.On("ListDeliveries", ctx, "1", 20).Return(deliveries, nil)
+	mockService.On("ListDeadLetters", ctx, "1", 20).Return(deliveries, nil)
+
+	result, err := endpoints.ListDeliveries(ctx, "1", 20)
+	assert.NoError(t, err)
+	assert.Equal(t, deliveries, result)
+
+	result, err = endpoints.ListDeadLetters(ctx, "1", 20)
+	assert.NoError(t, err)
+	assert.Equal(t, deliveries, result)
+	mockService.AssertExpectations(t)
+}
@@ -0,0 +1,47 @@
+package endpoints
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockWebhookService struct {
+	mock.Mock
+}
+
+func (m *MockWebhookService) CreateWebhook(ctx context.Context, webhook entities.Webhook) (entities.Webhook, error) {
+	args := m.Called(ctx, webhook)
+	return args.Get(0).(entities.Webhook), args.Error(1)
+}
+
+func (m *MockWebhookService) GetWebhook(ctx context.Context, id string) (entities.Webhook, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(entities.Webhook), args.Error(1)
+}
+
+func (m *MockWebhookService) ListWebhooks(ctx context.Context) ([]entities.Webhook, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]entities.Webhook), args.Error(1)
+}
+
+func (m *MockWebhookService) UpdateWebhook(ctx context.Context, webhook entities.Webhook) (entities.Webhook, error) {
+	args := m.Called(ctx, webhook)
+	return args.Get(0).(entities.Webhook), args.Error(1)
+}
+
+func (m *MockWebhookService) DeleteWebhook(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockWebhookService) ListDeliveries(ctx context.Context, id string, limit int) ([]entities.WebhookDelivery, error) {
+	args := m.Called(ctx, id, limit)
+	return args.Get(0).([]entities.WebhookDelivery), args.Error(1)
+}
+
+func (m *MockWebhookService) ListDeadLetters(ctx context.Context, id string, limit int) ([]entities.WebhookDelivery, error) {
+	args := m.Called(ctx, id, limit)
+	return args.Get(0).([]entities.WebhookDelivery), args.Error(1)
+}
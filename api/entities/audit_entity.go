@@ -0,0 +1,41 @@
+package entities
+
+import "time"
+
+// Acciones registradas en el historial de auditoría.
+const (
+	AuditCreate         = "create"
+	AuditUpdate         = "update"
+	AuditPatch          = "patch"
+	AuditClassify       = "classify"
+	AuditManualClassify = "manual_classify"
+	AuditTransition     = "transition"
+	AuditDelete         = "delete"
+	AuditRestore        = "restore"
+)
+
+// Transportes por los que puede llegar una operación.
+const (
+	TransportHTTP = "http"
+	TransportGRPC = "grpc"
+)
+
+// FieldChange es el cambio de un campo del evento. Old es nil al crear.
+type FieldChange struct {
+	Field string `json:"field" bson:"field"`
+	Old   any    `json:"old" bson:"old"`
+	New   any    `json:"new" bson:"new"`
+}
+
+// AuditEntry es una entrada del historial de un evento. Las entradas no se
+// modifican ni se eliminan, ni siquiera al purgar el evento.
+type AuditEntry struct {
+	ID        string        `json:"id,omitempty" bson:"_id,omitempty"`
+	EventID   string        `json:"event_id" bson:"event_id"`
+	TenantID  string        `json:"-" bson:"tenant_id,omitempty"`
+	Action    string        `json:"action" bson:"action"`
+	Actor     string        `json:"actor" bson:"actor"`
+	Transport string        `json:"transport,omitempty" bson:"transport,omitempty"`
+	Changes   []FieldChange `json:"changes" bson:"changes"`
+	At        time.Time     `json:"at" bson:"at"`
+}
@@ -0,0 +1,58 @@
+package entities
+
+import "time"
+
+// Métodos con los que se puede autenticar una petición.
+const (
+	AuthMethodJWT    = "jwt"
+	AuthMethodAPIKey = "api_key"
+)
+
+// Roles que se pueden asignar a un principal. Los permisos de cada rol están
+// en service.Authorize.
+const (
+	RoleViewer   = "viewer"
+	RoleOperator = "operator"
+	RoleTriager  = "triager"
+	RoleAdmin    = "admin"
+)
+
+// Principal es quien hizo una petición autenticada: el sub del JWT o el
+// dueño de la API key. Tenant, si no está vacío, es el único tenant al que
+// puede acceder.
+type Principal struct {
+	Subject string   `json:"subject"`
+	Roles   []string `json:"roles"`
+	Method  string   `json:"method"`
+	Tenant  string   `json:"tenant,omitempty"`
+}
+
+// HasRole indica si el principal tiene role.
+func (p Principal) HasRole(role string) bool {
+	for _, r := range p.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// APIKey es una clave de acceso. Solo se guarda el SHA-256 de la clave; Key
+// tiene la clave en claro y solo se devuelve al crearla.
+type APIKey struct {
+	ID        string     `json:"id,omitempty" bson:"_id,omitempty"`
+	Name      string     `json:"name" bson:"name" validate:"required"`
+	Subject   string     `json:"subject" bson:"subject" validate:"required"`
+	Roles     []string   `json:"roles" bson:"roles" validate:"required,min=1,dive,oneof=viewer operator triager admin"`
+	Tenant    string     `json:"tenant,omitempty" bson:"tenant,omitempty"`
+	Prefix    string     `json:"prefix" bson:"prefix"`
+	Hash      string     `json:"-" bson:"hash"`
+	Key       string     `json:"key,omitempty" bson:"-"`
+	CreatedAt time.Time  `json:"created_at" bson:"created_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty" bson:"revoked_at,omitempty"`
+}
+
+// Principal devuelve el principal que se autentica con la clave.
+func (k APIKey) Principal() Principal {
+	return Principal{Subject: k.Subject, Roles: k.Roles, Method: AuthMethodAPIKey, Tenant: k.Tenant}
+}
@@ -0,0 +1,33 @@
+package entities
+
+// BulkResult es el resultado de un elemento de una operación por lotes. Index
+// es la posición del elemento en el lote recibido; si falló, Error explica por
+// qué y el resto de campos pueden quedar vacíos.
+type BulkResult struct {
+	Index   int    `json:"index"`
+	ID      string `json:"id,omitempty"`
+	Version int64  `json:"version,omitempty"`
+	Error   string `json:"error,omitempty"`
+	// Err es el error original, para que los transportes elijan su código.
+	Err error `json:"-"`
+}
+
+// BulkResponse resume una operación por lotes.
+type BulkResponse struct {
+	Results   []BulkResult `json:"results"`
+	Succeeded int          `json:"succeeded"`
+	Failed    int          `json:"failed"`
+}
+
+// NewBulkResponse cuenta los resultados exitosos y fallidos.
+func NewBulkResponse(results []BulkResult) BulkResponse {
+	response := BulkResponse{Results: results}
+	for _, result := range results {
+		if result.Err != nil {
+			response.Failed++
+		} else {
+			response.Succeeded++
+		}
+	}
+	return response
+}
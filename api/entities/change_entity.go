@@ -0,0 +1,64 @@
+package entities
+
+import (
+	"prueba_tecnica/api/tenant"
+	"time"
+)
+
+// Tipos de cambio que se publican en el feed de eventos.
+const (
+	ChangeCreated    = "created"
+	ChangeUpdated    = "updated"
+	ChangeClassified = "classified"
+	ChangeDeleted    = "deleted"
+	ChangeRestored   = "restored"
+)
+
+// EventChange es un cambio ya guardado de un evento. Event es el estado
+// después del cambio; Previous, el de antes, solo se usa para los filtros.
+type EventChange struct {
+	Type     string    `json:"type"`
+	Event    Event     `json:"event"`
+	At       time.Time `json:"at"`
+	Previous *Event    `json:"-"`
+}
+
+// ChangeFilter usa los mismos filtros que los listados por estado, categoría,
+// tipo y necesidad de gestión. Los campos vacíos no filtran. Tenant lo fija el
+// servicio con el tenant de quien observa.
+type ChangeFilter struct {
+	Tenant      string   `json:"-"`
+	Status      Status   `json:"status,omitempty"`
+	Category    Category `json:"category,omitempty"`
+	Type        string   `json:"type,omitempty"`
+	NeedsAction *bool    `json:"needs_action,omitempty"`
+}
+
+// Matches indica si el cambio interesa a quien usa el filtro: el evento
+// coincide antes o después del cambio, para enterarse también de los que
+// salen del filtro.
+func (f ChangeFilter) Matches(change EventChange) bool {
+	if f.matches(change.Event) {
+		return true
+	}
+	return change.Previous != nil && f.matches(*change.Previous)
+}
+
+func (f ChangeFilter) matches(event Event) bool {
+	if f.Tenant != "" && tenant.Of(event.TenantID) != f.Tenant {
+		return false
+	}
+	if f.Status != "" && event.Status != f.Status {
+		return false
+	}
+	if f.Category != "" && event.Category != f.Category {
+		return false
+	}
+	if f.Type != "" && event.Type != f.Type {
+		return false
+	}
+	if f.NeedsAction != nil && event.NeedsAction != *f.NeedsAction {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,9 @@
+package entities
+
+// Classification es el resultado de evaluar las reglas de clasificación sobre
+// un evento. Rule es el nombre de la regla aplicada, vacío si ninguna coincidió.
+type Classification struct {
+	Rule        string   `json:"rule,omitempty"`
+	Category    Category `json:"category"`
+	NeedsAction bool     `json:"needs_action"`
+}
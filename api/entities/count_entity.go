@@ -0,0 +1,10 @@
+package entities
+
+// EventCount es la cantidad de eventos no eliminados con un mismo estado,
+// categoría y needs_action.
+type EventCount struct {
+	Status      Status   `json:"status" bson:"status"`
+	Category    Category `json:"category" bson:"category"`
+	NeedsAction bool     `json:"needs_action" bson:"needs_action"`
+	Count       int64    `json:"count" bson:"count"`
+}
@@ -3,12 +3,23 @@ package entities
 import "time"
 
 type Event struct {
-	ID          string    `json:"id,omitempty" bson:"_id,omitempty"`
+	ID string `json:"id,omitempty" bson:"_id,omitempty"`
+	// TenantID lo asigna el repositorio a partir del contexto; se ignora el
+	// que envía el cliente.
+	TenantID    string    `json:"tenant_id,omitempty" bson:"tenant_id,omitempty"`
 	Name        string    `json:"name" bson:"name" validate:"required"`
 	Type        string    `json:"type" bson:"type" validate:"required"`
 	Description string    `json:"description" bson:"description" validate:"required"`
 	Date        time.Time `json:"date" bson:"date"`
-	Status      string    `json:"status" bson:"status" validate:"required"`     // "Pendiente" o "Revisado"
-	Category    string    `json:"category,omitempty" bson:"category,omitempty"` // "Requiere gestión" o "Sin gestión"
+	Status      Status    `json:"status" bson:"status" validate:"required"`
+	Category    Category  `json:"category,omitempty" bson:"category,omitempty"`
 	NeedsAction bool      `json:"needs_action,omitempty" bson:"needs_action,omitempty"`
+	// StatusHistory solo cambia a través de las transiciones de estado.
+	StatusHistory []StatusTransition `json:"status_history,omitempty" bson:"status_history,omitempty"`
+	// DeletedAt marca un evento eliminado; los repositorios lo ocultan hasta
+	// que se restaura o se purga.
+	DeletedAt *time.Time `json:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	// Version aumenta en cada escritura; UpdateEvent falla si no coincide con
+	// la guardada. Los eventos anteriores a este campo tienen versión 0.
+	Version int64 `json:"version" bson:"version"`
 }
@@ -0,0 +1,14 @@
+package entities
+
+// PageRequest indica cuántos eventos devolver y desde dónde continuar.
+// Cursor es opaco para los clientes: se obtiene de EventPage.NextCursor.
+type PageRequest struct {
+	Limit  int    `json:"limit,omitempty"`
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// EventPage es una página de eventos; NextCursor queda vacío en la última página.
+type EventPage struct {
+	Events     []Event `json:"events"`
+	NextCursor string  `json:"next_cursor,omitempty"`
+}
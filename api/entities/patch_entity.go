@@ -0,0 +1,17 @@
+package entities
+
+import "time"
+
+// EventPatch es una actualización parcial: solo se modifican los campos que no
+// son nil. Category vacío quita la categoría. Version, si no es 0, es la
+// versión que se espera modificar.
+type EventPatch struct {
+	Name        *string    `json:"name,omitempty"`
+	Type        *string    `json:"type,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Date        *time.Time `json:"date,omitempty"`
+	Status      *Status    `json:"status,omitempty"`
+	Category    *Category  `json:"category,omitempty"`
+	NeedsAction *bool      `json:"needs_action,omitempty"`
+	Version     int64      `json:"-"`
+}
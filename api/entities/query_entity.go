@@ -0,0 +1,44 @@
+package entities
+
+import "time"
+
+// Campos por los que se puede ordenar un listado de eventos.
+const (
+	SortByDate   = "date"
+	SortByName   = "name"
+	SortByType   = "type"
+	SortByStatus = "status"
+)
+
+const (
+	SortAsc  = "asc"
+	SortDesc = "desc"
+)
+
+// EventQuery combina los filtros de un listado. Los campos vacíos no filtran.
+// From es inclusivo y To exclusivo; Name y Description buscan subcadenas sin
+// distinguir mayúsculas. Por defecto se ordena por fecha descendente y no se
+// incluyen los eventos eliminados.
+type EventQuery struct {
+	Status         Status      `json:"status,omitempty"`
+	Category       Category    `json:"category,omitempty"`
+	Type           string      `json:"type,omitempty"`
+	NeedsAction    *bool       `json:"needs_action,omitempty"`
+	From           time.Time   `json:"from,omitempty"`
+	To             time.Time   `json:"to,omitempty"`
+	Name           string      `json:"name,omitempty"`
+	Description    string      `json:"description,omitempty"`
+	SortBy         string      `json:"sort_by,omitempty"`
+	SortOrder      string      `json:"sort_order,omitempty"`
+	IncludeDeleted bool        `json:"include_deleted,omitempty"`
+	Page           PageRequest `json:"page"`
+}
+
+// Sort devuelve el campo y la dirección efectivos del ordenamiento.
+func (q EventQuery) Sort() (field string, desc bool) {
+	field = q.SortBy
+	if field == "" {
+		field = SortByDate
+	}
+	return field, q.SortOrder != SortAsc
+}
@@ -0,0 +1,45 @@
+package entities
+
+import "time"
+
+// Campos del evento en los que se busca texto.
+const (
+	SearchFieldName        = "name"
+	SearchFieldDescription = "description"
+)
+
+// SearchQuery busca eventos por texto en el nombre y la descripción. Text usa
+// la sintaxis de los índices de texto de MongoDB: las palabras sueltas
+// alcanzan con que aparezca una, las frases entre comillas deben aparecer
+// todas y las palabras con "-" delante excluyen el evento. Los demás campos
+// filtran como en EventQuery. Los resultados se ordenan por relevancia.
+type SearchQuery struct {
+	Text     string      `json:"q"`
+	Status   Status      `json:"status,omitempty"`
+	Category Category    `json:"category,omitempty"`
+	From     time.Time   `json:"from,omitempty"`
+	To       time.Time   `json:"to,omitempty"`
+	Page     PageRequest `json:"page"`
+}
+
+// Filters devuelve los filtros de q como un EventQuery.
+func (q SearchQuery) Filters() EventQuery {
+	return EventQuery{Status: q.Status, Category: q.Category, From: q.From, To: q.To}
+}
+
+// SearchHit es un evento encontrado. Score solo sirve para comparar eventos
+// de la misma búsqueda. Highlights tiene, por cada campo con coincidencias,
+// el texto con los términos encontrados entre <em> y </em>; la descripción
+// se recorta alrededor de la primera coincidencia.
+type SearchHit struct {
+	Event      Event             `json:"event"`
+	Score      float64           `json:"score"`
+	Highlights map[string]string `json:"highlights,omitempty"`
+}
+
+// SearchPage es una página de resultados; NextCursor queda vacío en la última
+// página.
+type SearchPage struct {
+	Hits       []SearchHit `json:"hits"`
+	NextCursor string      `json:"next_cursor,omitempty"`
+}
@@ -0,0 +1,34 @@
+package entities
+
+import "time"
+
+// Status es un estado del ciclo de vida de un evento. Los estados válidos y
+// sus transiciones los define la taxonomía (paquete taxonomy); estos son los
+// de la taxonomía por defecto.
+type Status string
+
+const (
+	StatusPending  Status = "Pendiente por revisar"
+	StatusInReview Status = "En revisión"
+	StatusReviewed Status = "Revisado"
+	StatusClosed   Status = "Cerrado"
+	StatusReopened Status = "Reabierto"
+)
+
+// Category es la categoría con la que se clasifica un evento. Las válidas las
+// define la taxonomía; estas son las de la taxonomía por defecto.
+type Category string
+
+const (
+	CategoryNeedsAction Category = "Requiere gestión"
+	CategoryNoAction    Category = "Sin gestión"
+)
+
+// StatusTransition registra un cambio de estado: quién lo hizo, cuándo y por qué.
+type StatusTransition struct {
+	From   Status    `json:"from" bson:"from"`
+	To     Status    `json:"to" bson:"to"`
+	Actor  string    `json:"actor" bson:"actor"`
+	Reason string    `json:"reason,omitempty" bson:"reason,omitempty"`
+	At     time.Time `json:"at" bson:"at"`
+}
@@ -0,0 +1,66 @@
+package entities
+
+import "time"
+
+// Disparadores a los que se puede suscribir un webhook.
+const (
+	TriggerNeedsAction  = "needs_action"
+	TriggerStatusChange = "status_change"
+	TriggerDelete       = "delete"
+)
+
+// Webhook es una suscripción a notificaciones de eventos. Secret firma cada
+// envío con HMAC-SHA256; solo se devuelve al crear el webhook. Un webhook solo
+// recibe los eventos de su TenantID.
+type Webhook struct {
+	ID        string    `json:"id,omitempty" bson:"_id,omitempty"`
+	TenantID  string    `json:"tenant_id,omitempty" bson:"tenant_id,omitempty"`
+	URL       string    `json:"url" bson:"url" validate:"required,http_url"`
+	Triggers  []string  `json:"triggers" bson:"triggers" validate:"required,min=1,dive,oneof=needs_action status_change delete"`
+	Secret    string    `json:"secret,omitempty" bson:"secret"`
+	Active    bool      `json:"active" bson:"active"`
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+}
+
+// Subscribed indica si el webhook está activo y escucha trigger.
+func (w Webhook) Subscribed(trigger string) bool {
+	if !w.Active {
+		return false
+	}
+	for _, t := range w.Triggers {
+		if t == trigger {
+			return true
+		}
+	}
+	return false
+}
+
+// Estados de un envío de webhook.
+const (
+	DeliveryDelivered = "delivered"
+	DeliveryRetrying  = "retrying"
+	DeliveryDead      = "dead"
+	DeliveryCancelled = "cancelled"
+)
+
+// WebhookDelivery es un envío de un webhook con el resultado de su último
+// intento. Los envíos que agotan los reintentos se copian a la cola de
+// mensajes muertos con Payload, para poder reenviarlos. ErrorKey es la clave
+// de Error en el catálogo de i18n cuando el fallo es conocido; los transportes
+// traducen Error con ella.
+type WebhookDelivery struct {
+	ID            string     `json:"id" bson:"_id"`
+	WebhookID     string     `json:"webhook_id" bson:"webhook_id"`
+	TenantID      string     `json:"-" bson:"tenant_id,omitempty"`
+	EventID       string     `json:"event_id" bson:"event_id"`
+	Trigger       string     `json:"trigger" bson:"trigger"`
+	Payload       string     `json:"payload" bson:"payload"`
+	Status        string     `json:"status" bson:"status"`
+	Attempts      int        `json:"attempts" bson:"attempts"`
+	StatusCode    int        `json:"status_code,omitempty" bson:"status_code,omitempty"`
+	Error         string     `json:"error,omitempty" bson:"error,omitempty"`
+	ErrorKey      string     `json:"-" bson:"error_key,omitempty"`
+	CreatedAt     time.Time  `json:"created_at" bson:"created_at"`
+	LastAttemptAt time.Time  `json:"last_attempt_at" bson:"last_attempt_at"`
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty" bson:"next_attempt_at,omitempty"`
+}
@@ -0,0 +1,118 @@
+// Package health reúne las comprobaciones que indican si el servicio puede
+// recibir tráfico. Las exponen /readyz en HTTP y grpc.health.v1 en gRPC.
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+// Estados de un Report.
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+// Check revisa una dependencia; un error indica que no está disponible.
+type Check func(ctx context.Context) error
+
+// Report es el resultado de Ready: el estado general y, por comprobación, "ok"
+// o "no disponible". Los errores solo van en Errors, que no se serializa,
+// porque las sondas son públicas.
+type Report struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+	Errors map[string]error  `json:"-"`
+}
+
+func (r Report) Up() bool {
+	return r.Status == StatusUp
+}
+
+type named struct {
+	name  string
+	check Check
+}
+
+// Checker corre las comprobaciones registradas. Después de Drain siempre
+// informa que no está listo, para que el tráfico nuevo vaya a otra instancia
+// mientras se atienden las peticiones en curso.
+type Checker struct {
+	timeout time.Duration
+	checks  []named
+
+	drainOnce sync.Once
+	draining  chan struct{}
+}
+
+// NewChecker limita cada comprobación a timeout.
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{
+		timeout:  timeout,
+		draining: make(chan struct{}),
+	}
+}
+
+// Add registra una comprobación. Se debe llamar antes de empezar a atender
+// peticiones.
+func (c *Checker) Add(name string, check Check) {
+	c.checks = append(c.checks, named{name: name, check: check})
+}
+
+// Ready corre todas las comprobaciones en paralelo.
+func (c *Checker) Ready(ctx context.Context) Report {
+	select {
+	case <-c.draining:
+		return Report{Status: StatusDown, Checks: map[string]string{"server": "deteniéndose"}}
+	default:
+	}
+
+	results := make([]error, len(c.checks))
+	var wg sync.WaitGroup
+	for i, n := range c.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, c.timeout)
+			defer cancel()
+			results[i] = n.check(checkCtx)
+		}()
+	}
+	wg.Wait()
+
+	report := Report{Status: StatusUp, Checks: make(map[string]string, len(c.checks))}
+	for i, n := range c.checks {
+		if results[i] != nil {
+			if report.Errors == nil {
+				report.Errors = map[string]error{}
+			}
+			report.Status = StatusDown
+			report.Checks[n.name] = "no disponible"
+			report.Errors[n.name] = results[i]
+			continue
+		}
+		report.Checks[n.name] = "ok"
+	}
+	return report
+}
+
+// Drain marca el servicio como no listo de forma definitiva.
+func (c *Checker) Drain() {
+	c.drainOnce.Do(func() { close(c.draining) })
+}
+
+// Draining se cierra cuando se llama a Drain.
+func (c *Checker) Draining() <-chan struct{} {
+	return c.draining
+}
+
+// Mongo comprueba que el primario de MongoDB responda.
+func Mongo(client *mongo.Client) Check {
+	return func(ctx context.Context) error {
+		return client.Ping(ctx, readpref.Primary())
+	}
+}
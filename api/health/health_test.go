@@ -0,0 +1,81 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReady(t *testing.T) {
+	ok := func(ctx context.Context) error { return nil }
+	down := func(ctx context.Context) error { return errors.New("sin conexión") }
+	slow := func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	testCases := []struct {
+		name     string
+		checks   map[string]Check
+		expected Report
+	}{
+		{
+			name:     "No checks",
+			expected: Report{Status: StatusUp, Checks: map[string]string{}},
+		},
+		{
+			name:     "All checks pass",
+			checks:   map[string]Check{"mongo": ok, "cache": ok},
+			expected: Report{Status: StatusUp, Checks: map[string]string{"mongo": "ok", "cache": "ok"}},
+		},
+		{
+			name:   "One check fails",
+			checks: map[string]Check{"mongo": down, "cache": ok},
+			expected: Report{
+				Status: StatusDown,
+				Checks: map[string]string{"mongo": "no disponible", "cache": "ok"},
+				Errors: map[string]error{"mongo": errors.New("sin conexión")},
+			},
+		},
+		{
+			name:   "Slow check times out",
+			checks: map[string]Check{"mongo": slow},
+			expected: Report{
+				Status: StatusDown,
+				Checks: map[string]string{"mongo": "no disponible"},
+				Errors: map[string]error{"mongo": context.DeadlineExceeded},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			checker := NewChecker(10 * time.Millisecond)
+			for name, check := range tc.checks {
+				checker.Add(name, check)
+			}
+			assert.Equal(t, tc.expected, checker.Ready(context.Background()))
+		})
+	}
+}
+
+func TestDrain(t *testing.T) {
+	checker := NewChecker(time.Second)
+	checker.Add("mongo", func(ctx context.Context) error { return nil })
+	assert.True(t, checker.Ready(context.Background()).Up())
+
+	checker.Drain()
+	checker.Drain()
+
+	report := checker.Ready(context.Background())
+	assert.False(t, report.Up())
+	assert.Equal(t, map[string]string{"server": "deteniéndose"}, report.Checks)
+	select {
+	case <-checker.Draining():
+	default:
+		t.Fatal("Draining debería estar cerrado")
+	}
+}
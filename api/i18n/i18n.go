@@ -0,0 +1,58 @@
+// Package i18n guarda los mensajes de la API en español e inglés. Cada
+// mensaje tiene una clave estable; el español es el idioma por defecto.
+package i18n
+
+import (
+	"fmt"
+
+	"golang.org/x/text/language"
+)
+
+// Locale es un idioma soportado por la API.
+type Locale string
+
+const (
+	Spanish Locale = "es"
+	English Locale = "en"
+
+	// Default es el idioma de los mensajes cuando el cliente no pide otro.
+	Default = Spanish
+)
+
+// catalog tiene los mensajes de cada idioma por clave.
+var catalog = map[Locale]map[string]string{
+	Spanish: spanish,
+	English: english,
+}
+
+// El primer idioma es el que elige el matcher cuando no hay coincidencia.
+var matcher = language.NewMatcher([]language.Tag{language.Spanish, language.English})
+
+// Parse elige el idioma de un encabezado Accept-Language, respetando las
+// calidades (q). Sin encabezado o sin un idioma soportado devuelve Default.
+func Parse(acceptLanguage string) Locale {
+	if acceptLanguage == "" {
+		return Default
+	}
+	tag, _ := language.MatchStrings(matcher, acceptLanguage)
+	if base, _ := tag.Base(); base.String() == string(English) {
+		return English
+	}
+	return Default
+}
+
+// T devuelve el mensaje key en el idioma l, formateado con args. Si l no lo
+// tiene usa el de Default y, si tampoco existe, devuelve la clave.
+func T(l Locale, key string, args ...any) string {
+	message, ok := catalog[l][key]
+	if !ok {
+		message, ok = catalog[Default][key]
+	}
+	if !ok {
+		return key
+	}
+	if len(args) > 0 {
+		return fmt.Sprintf(message, args...)
+	}
+	return message
+}
@@ -0,0 +1,43 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalogsHaveTheSameKeys(t *testing.T) {
+	for key := range spanish {
+		assert.Contains(t, english, key, "falta la traducción al inglés")
+	}
+	for key := range english {
+		assert.Contains(t, spanish, key, "falta el mensaje en español")
+	}
+}
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		acceptLanguage string
+		expected       Locale
+	}{
+		{acceptLanguage: "", expected: Spanish},
+		{acceptLanguage: "en", expected: English},
+		{acceptLanguage: "en-GB", expected: English},
+		{acceptLanguage: "es-MX,es;q=0.9,en;q=0.8", expected: Spanish},
+		{acceptLanguage: "fr-FR,en;q=0.5", expected: English},
+		{acceptLanguage: "de", expected: Spanish},
+		{acceptLanguage: "no es un encabezado;;", expected: Spanish},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.acceptLanguage, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Parse(tc.acceptLanguage))
+		})
+	}
+}
+
+func TestT(t *testing.T) {
+	assert.Equal(t, "must have at least 1 items", T(English, "validation.min", "1"))
+	assert.Equal(t, "evento con ese id no encontrado", T(Locale("fr"), "event.not_found"))
+	assert.Equal(t, "clave.desconocida", T(English, "clave.desconocida"))
+}
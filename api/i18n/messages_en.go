@@ -0,0 +1,86 @@
+package i18n
+
+var english = map[string]string{
+	"title.invalid_argument":       "Invalid request",
+	"title.not_found":              "Not found",
+	"title.conflict":               "Version conflict",
+	"title.failed_precondition":    "Operation not allowed in the current state",
+	"title.unauthenticated":        "Unauthenticated",
+	"title.permission_denied":      "Permission denied",
+	"title.unsupported_media_type": "Unsupported media type",
+	"title.unavailable":            "Service unavailable",
+	"title.internal":               "Internal error",
+
+	"error.internal": "internal server error",
+	"error.timeout":  "the operation took too long, try again",
+
+	"event.invalid":             "Invalid request structure, fill in all the fields",
+	"event.not_found":           "no event found with that id",
+	"event.id_required":         "Event id is required",
+	"event.invalid_type":        "the event type is not in the taxonomy",
+	"event.not_deleted":         "the event is not deleted",
+	"event.version_conflict":    "the event was modified by another operation, read it again and retry",
+	"event.created":             "Event created successfully",
+	"event.deleted":             "Event deleted successfully",
+	"event.classified":          "Event classified automatically by its type",
+	"event.classified_manually": "Event classified manually",
+
+	"status.invalid":             "the status is not in the taxonomy",
+	"status.invalid_initial":     "events cannot be created in that status",
+	"status.requires_transition": "status can only be changed with a transition",
+	"transition.not_allowed":     "status transition not allowed",
+	"transition.actor_required":  "the transition actor is required",
+
+	"category.invalid_type": "invalid category",
+	"category.invalid":      "the category is not in the taxonomy",
+	"category.not_reviewed": "Only reviewed events can be classified",
+
+	"page.invalid_limit":  "the page limit must be between 1 and 500",
+	"page.invalid_sort":   "invalid sort: sort must be date, name, type or status and order asc or desc",
+	"page.invalid_range":  "invalid date range: from must be before to",
+	"page.invalid_cursor": "invalid pagination cursor",
+
+	"search.invalid_text": "the search text is required and cannot be longer than 256 characters",
+
+	"history.disabled": "the audit history is not enabled",
+	"watch.disabled":   "the change feed is not enabled",
+	"watch.closed":     "change feed closed, subscribe again",
+	"batch.empty":      "the batch has no items",
+	"batch.too_large":  "the batch cannot have more than 1000 items",
+
+	"webhook.invalid":           "the webhook needs an http(s) url and at least one trigger: needs_action, status_change or delete",
+	"webhook.not_found":         "webhook not found",
+	"webhook.deleted":           "Webhook deleted successfully",
+	"webhook.unexpected_status": "the endpoint did not respond with a 2xx status",
+
+	"apikey.invalid":   "the api key needs name, subject and at least one role: viewer, operator, triager or admin",
+	"apikey.not_found": "api key not found",
+
+	"auth.no_credentials":      "authentication required: send Authorization: Bearer <token> or X-API-Key",
+	"auth.invalid_credentials": "invalid credentials",
+	"auth.forbidden":           "you are not allowed to perform this operation",
+	"tenant.invalid":           "the tenant must have 1 to 48 characters: lowercase letters, digits, '-' or '_', starting with a letter or digit",
+
+	"request.malformed":      "the request body is not valid",
+	"request.invalid_params": "invalid query parameters",
+	"request.if_match":       "If-Match must be the ETag of an event version",
+	"request.media_type":     "Content-Type must be application/merge-patch+json",
+	"request.update_mask":    "invalid update_mask",
+	"request.merge_patch":    "the patch must be a JSON object",
+	"request.batch":          "the batch must be a JSON array, or NDJSON with Content-Type application/x-ndjson",
+	"request.batch_trailing": "the batch has data after its last item",
+
+	"validation.required":     "is required",
+	"validation.min":          "must have at least %s items",
+	"validation.oneof":        "must be one of: %s",
+	"validation.http_url":     "must be an http(s) url",
+	"validation.rule":         "does not satisfy the %s rule",
+	"validation.not_empty":    "cannot be empty",
+	"validation.bool":         "must be true or false",
+	"validation.rfc3339":      "must be an RFC 3339 date",
+	"validation.taxonomy":     "is not in the taxonomy (GET /api/v1/taxonomy)",
+	"validation.string":       "must be a string",
+	"validation.not_nullable": "cannot be removed",
+	"validation.read_only":    "cannot be modified",
+	"validation.item":         "is not a valid batch item",
+}
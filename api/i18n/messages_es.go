@@ -0,0 +1,86 @@
+package i18n
+
+var spanish = map[string]string{
+	"title.invalid_argument":       "Petición inválida",
+	"title.not_found":              "No encontrado",
+	"title.conflict":               "Conflicto de versión",
+	"title.failed_precondition":    "Operación no permitida en el estado actual",
+	"title.unauthenticated":        "No autenticado",
+	"title.permission_denied":      "Permiso denegado",
+	"title.unsupported_media_type": "Tipo de contenido no soportado",
+	"title.unavailable":            "Servicio no disponible",
+	"title.internal":               "Error interno",
+
+	"error.internal": "error interno del servidor",
+	"error.timeout":  "la operación tardó demasiado, intente de nuevo",
+
+	"event.invalid":             "Error en la estructura del request llene todos los campos",
+	"event.not_found":           "evento con ese id no encontrado",
+	"event.id_required":         "Id del evento requerido",
+	"event.invalid_type":        "el tipo de evento no existe en la taxonomía",
+	"event.not_deleted":         "el evento no está eliminado",
+	"event.version_conflict":    "el evento fue modificado por otra operación, vuelva a leerlo e intente de nuevo",
+	"event.created":             "Evento creado correctamente",
+	"event.deleted":             "Evento eliminado correctamente",
+	"event.classified":          "Evento clasificado automáticamente según su tipo",
+	"event.classified_manually": "Evento clasificado manualmente",
+
+	"status.invalid":             "el estado no existe en la taxonomía",
+	"status.invalid_initial":     "no se puede crear un evento en ese estado",
+	"status.requires_transition": "el estado solo se puede cambiar con una transición",
+	"transition.not_allowed":     "transición de estado no permitida",
+	"transition.actor_required":  "el actor de la transición es requerido",
+
+	"category.invalid_type": "categoría inválida",
+	"category.invalid":      "la categoría no existe en la taxonomía",
+	"category.not_reviewed": "Solo se pueden clasificar eventos revisados",
+
+	"page.invalid_limit":  "el límite de la página debe estar entre 1 y 500",
+	"page.invalid_sort":   "ordenamiento inválido: sort debe ser date, name, type o status y order asc o desc",
+	"page.invalid_range":  "el rango de fechas es inválido: from debe ser anterior a to",
+	"page.invalid_cursor": "cursor de paginación inválido",
+
+	"search.invalid_text": "el texto de la búsqueda es requerido y no puede tener más de 256 caracteres",
+
+	"history.disabled": "el historial de auditoría no está habilitado",
+	"watch.disabled":   "el feed de cambios no está habilitado",
+	"watch.closed":     "el feed de cambios se cerró, vuelva a suscribirse",
+	"batch.empty":      "el lote no tiene elementos",
+	"batch.too_large":  "el lote no puede tener más de 1000 elementos",
+
+	"webhook.invalid":           "el webhook necesita una url http(s) y al menos un trigger: needs_action, status_change o delete",
+	"webhook.not_found":         "webhook no encontrado",
+	"webhook.deleted":           "Webhook eliminado correctamente",
+	"webhook.unexpected_status": "el destino no respondió con un estado 2xx",
+
+	"apikey.invalid":   "la api key necesita name, subject y al menos un rol: viewer, operator, triager o admin",
+	"apikey.not_found": "api key no encontrada",
+
+	"auth.no_credentials":      "se requiere autenticación: envíe Authorization: Bearer <token> o X-API-Key",
+	"auth.invalid_credentials": "credenciales inválidas",
+	"auth.forbidden":           "no tiene permiso para esta operación",
+	"tenant.invalid":           "el tenant debe tener de 1 a 48 caracteres: minúsculas, dígitos, '-' o '_', y empezar con letra o dígito",
+
+	"request.malformed":      "el cuerpo de la petición no es válido",
+	"request.invalid_params": "parámetros de consulta inválidos",
+	"request.if_match":       "If-Match debe ser el ETag de una versión del evento",
+	"request.media_type":     "Content-Type debe ser application/merge-patch+json",
+	"request.update_mask":    "update_mask inválido",
+	"request.merge_patch":    "el patch debe ser un objeto JSON",
+	"request.batch":          "el lote debe ser un arreglo JSON o NDJSON con Content-Type application/x-ndjson",
+	"request.batch_trailing": "el lote tiene datos después del último elemento",
+
+	"validation.required":     "es requerido",
+	"validation.min":          "debe tener al menos %s elementos",
+	"validation.oneof":        "debe ser uno de: %s",
+	"validation.http_url":     "debe ser una url http(s)",
+	"validation.rule":         "no cumple la regla %s",
+	"validation.not_empty":    "no puede estar vacío",
+	"validation.bool":         "debe ser true o false",
+	"validation.rfc3339":      "debe ser una fecha RFC 3339",
+	"validation.taxonomy":     "no existe en la taxonomía (GET /api/v1/taxonomy)",
+	"validation.string":       "debe ser un texto",
+	"validation.not_nullable": "no se puede eliminar",
+	"validation.read_only":    "no se puede modificar",
+	"validation.item":         "no es un elemento válido del lote",
+}
@@ -0,0 +1,122 @@
+// Package logging lleva en el contexto el logger de cada petición, con su
+// request ID, para que todas las capas escriban con los mismos campos.
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Campos que comparten todos los logs del servicio.
+const (
+	FieldLayer     = "layer"
+	FieldMethod    = "method"
+	FieldEventID   = "event_id"
+	FieldRequestID = "request_id"
+	FieldTraceID   = "trace_id"
+	FieldTenant    = "tenant"
+	FieldDuration  = "duration_ms"
+)
+
+// RequestIDHeader y RequestIDMetadataKey son el encabezado HTTP y la clave de
+// metadata gRPC del request ID. Si el cliente no envía uno se genera.
+const (
+	RequestIDHeader      = "X-Request-ID"
+	RequestIDMetadataKey = "x-request-id"
+)
+
+// maxRequestIDLength limita el request ID que llega del cliente, para que no
+// se pueda inflar cada línea del log.
+const maxRequestIDLength = 128
+
+type contextKey int
+
+const (
+	loggerKey contextKey = iota
+	requestIDKey
+)
+
+// WithLogger guarda en ctx el logger de la petición.
+func WithLogger(ctx context.Context, logger logrus.FieldLogger) context.Context {
+	return context.WithValue(ctx, loggerKey, logger)
+}
+
+// FromContext devuelve el logger guardado con WithLogger o fallback, para el
+// código que corre fuera de una petición.
+func FromContext(ctx context.Context, fallback logrus.FieldLogger) logrus.FieldLogger {
+	if logger, ok := ctx.Value(loggerKey).(logrus.FieldLogger); ok {
+		return logger
+	}
+	return fallback
+}
+
+// For es FromContext con la capa y el método de quien escribe.
+func For(ctx context.Context, fallback logrus.FieldLogger, layer, method string) logrus.FieldLogger {
+	return Layer(FromContext(ctx, fallback), layer, method)
+}
+
+// Layer agrega a logger la capa y el método de quien escribe, para el código
+// que no atiende una petición, como el arranque o las tareas periódicas.
+func Layer(logger logrus.FieldLogger, layer, method string) logrus.FieldLogger {
+	return logger.WithFields(logrus.Fields{FieldLayer: layer, FieldMethod: method})
+}
+
+// With agrega fields al logger de ctx.
+func With(ctx context.Context, fallback logrus.FieldLogger, fields logrus.Fields) context.Context {
+	return WithLogger(ctx, FromContext(ctx, fallback).WithFields(fields))
+}
+
+// WithRequestID guarda en ctx el request ID de la petición.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey, id)
+}
+
+// RequestIDFromContext devuelve el request ID guardado con WithRequestID o "".
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
+}
+
+// RequestID devuelve requested si es un request ID aceptable o uno nuevo.
+func RequestID(requested string) string {
+	if validRequestID(requested) {
+		return requested
+	}
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// validRequestID acepta ids cortos de caracteres ASCII visibles, como UUID o
+// los que generan los proxies.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < '!' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// Start arma el logger de una petición con su request ID y, si hay una span
+// activa, el trace ID, y los guarda en ctx.
+func Start(ctx context.Context, logger logrus.FieldLogger, requestID string, fields logrus.Fields) context.Context {
+	entry := logger.WithFields(fields).WithField(FieldRequestID, requestID)
+	if span := trace.SpanContextFromContext(ctx); span.IsValid() {
+		entry = entry.WithField(FieldTraceID, span.TraceID().String())
+	}
+	return WithLogger(WithRequestID(ctx, requestID), entry)
+}
+
+// Duration expresa elapsed en milisegundos para FieldDuration.
+func Duration(elapsed time.Duration) float64 {
+	return float64(elapsed.Microseconds()) / 1000
+}
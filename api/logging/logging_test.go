@@ -0,0 +1,81 @@
+package logging
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"prueba_tecnica/api/entities"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestRequestID(t *testing.T) {
+	testCases := []struct {
+		name      string
+		requested string
+		kept      bool
+	}{
+		{name: "UUID from client", requested: "3f1c2a9e-5b7d-4c1e-9a2f-8d6b0e4c7a11", kept: true},
+		{name: "Empty", requested: ""},
+		{name: "Spaces", requested: "abc def"},
+		{name: "Line break", requested: "abc\ninjected"},
+		{name: "Too long", requested: strings.Repeat("a", maxRequestIDLength+1)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			id := RequestID(tc.requested)
+			if tc.kept {
+				assert.Equal(t, tc.requested, id)
+				return
+			}
+			assert.NotEqual(t, tc.requested, id)
+			assert.Len(t, id, 32)
+		})
+	}
+}
+
+func TestStart(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+	span := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{1},
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), span)
+
+	ctx = Start(ctx, logger, "req-1", logrus.Fields{"transport": "http"})
+	For(ctx, logrus.New(), "event_service", "CreateEvent").Info("ok")
+
+	assert.Equal(t, "req-1", RequestIDFromContext(ctx))
+	entry := hook.LastEntry()
+	require.NotNil(t, entry)
+	assert.Equal(t, logrus.Fields{
+		FieldRequestID: "req-1",
+		FieldTraceID:   span.TraceID().String(),
+		FieldLayer:     "event_service",
+		FieldMethod:    "CreateEvent",
+		"transport":    "http",
+	}, entry.Data)
+}
+
+func TestFromContextFallback(t *testing.T) {
+	fallback := logrus.New()
+	assert.Same(t, fallback, FromContext(context.Background(), fallback))
+}
+
+func TestRedactHook(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+	logger.AddHook(NewRedactHook())
+
+	logger.WithFields(Event(entities.Event{ID: "1", Description: "Juan Pérez reporta acceso"})).Info("evento guardado")
+
+	entry := hook.LastEntry()
+	require.NotNil(t, entry)
+	assert.Equal(t, "[redactado, 25 caracteres]", entry.Data[FieldDescription])
+	assert.Equal(t, "1", entry.Data[FieldEventID])
+}
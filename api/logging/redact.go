@@ -0,0 +1,49 @@
+package logging
+
+import (
+	"fmt"
+
+	"prueba_tecnica/api/entities"
+
+	"github.com/sirupsen/logrus"
+)
+
+// FieldDescription es el campo con la descripción de un evento, que puede
+// traer datos personales y por eso se redacta.
+const FieldDescription = "description"
+
+// Event devuelve los campos con los que se identifica un evento en el log.
+// La descripción va en FieldDescription para que RedactHook la oculte.
+func Event(event entities.Event) logrus.Fields {
+	return logrus.Fields{
+		FieldEventID:     event.ID,
+		"type":           event.Type,
+		"status":         event.Status,
+		"category":       event.Category,
+		FieldDescription: event.Description,
+	}
+}
+
+// RedactHook reemplaza el texto de los campos indicados por su largo antes de
+// escribir cada línea.
+type RedactHook struct {
+	Fields []string
+}
+
+// NewRedactHook redacta FieldDescription.
+func NewRedactHook() *RedactHook {
+	return &RedactHook{Fields: []string{FieldDescription}}
+}
+
+func (h *RedactHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h *RedactHook) Fire(entry *logrus.Entry) error {
+	for _, field := range h.Fields {
+		if text, ok := entry.Data[field].(string); ok && text != "" {
+			entry.Data[field] = fmt.Sprintf("[redactado, %d caracteres]", len([]rune(text)))
+		}
+	}
+	return nil
+}
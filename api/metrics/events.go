@@ -0,0 +1,71 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"prueba_tecnica/api/logging"
+	"prueba_tecnica/api/repository"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+// countTimeout limita la consulta de CountEvents en cada scrape.
+const countTimeout = 5 * time.Second
+
+var (
+	eventsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "events"),
+		"Eventos no eliminados de todos los tenants, por estado y categoría.",
+		[]string{"status", "category"}, nil,
+	)
+	needsActionDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "events_needs_action"),
+		"Eventos no eliminados que requieren gestión.",
+		nil, nil,
+	)
+)
+
+// EventsCollector consulta los totales de eventos en cada scrape, así que
+// siempre reflejan lo guardado aunque haya varias instancias del servicio.
+type EventsCollector struct {
+	counter repository.EventCounter
+	logger  logrus.FieldLogger
+}
+
+func NewEventsCollector(counter repository.EventCounter, logger logrus.FieldLogger) *EventsCollector {
+	return &EventsCollector{counter: counter, logger: logger}
+}
+
+func (c *EventsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- eventsDesc
+	ch <- needsActionDesc
+}
+
+// Collect no publica nada si la consulta falla, para que el scrape no
+// informe ceros falsos.
+func (c *EventsCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), countTimeout)
+	defer cancel()
+
+	counts, err := c.counter.CountEvents(ctx)
+	if err != nil {
+		logging.Layer(c.logger, "metrics", "Collect").WithError(err).Error("operación fallida")
+		return
+	}
+
+	type group struct{ status, category string }
+	events := map[group]int64{}
+	var needsAction int64
+	for _, count := range counts {
+		events[group{string(count.Status), string(count.Category)}] += count.Count
+		if count.NeedsAction {
+			needsAction += count.Count
+		}
+	}
+	for g, n := range events {
+		ch <- prometheus.MustNewConstMetric(eventsDesc, prometheus.GaugeValue, float64(n), g.status, g.category)
+	}
+	ch <- prometheus.MustNewConstMetric(needsActionDesc, prometheus.GaugeValue, float64(needsAction))
+}
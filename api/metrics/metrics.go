@@ -0,0 +1,114 @@
+// Package metrics define las métricas de Prometheus del servicio: peticiones
+// HTTP y gRPC, operaciones del repositorio y el estado de los eventos.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"prueba_tecnica/api/apperr"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "eventsapi"
+
+// outcomeOK etiqueta las operaciones que no fallaron; las que fallan se
+// etiquetan con el código de apperr, como not_found o conflict.
+const outcomeOK = "ok"
+
+// Metrics agrupa los colectores en un registro propio, para no mezclarlos con
+// los del registro global de Prometheus.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpRequests *prometheus.CounterVec
+	httpDuration *prometheus.HistogramVec
+	grpcRequests *prometheus.CounterVec
+	grpcDuration *prometheus.HistogramVec
+	repoDuration *prometheus.HistogramVec
+}
+
+// New registra las métricas del servicio junto con las del runtime de Go y
+// del proceso.
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "requests_total",
+			Help:      "Peticiones HTTP atendidas, por método, ruta y estado.",
+		}, []string{"method", "route", "status"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "Duración de las peticiones HTTP, por método y ruta.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route"}),
+		grpcRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "grpc",
+			Name:      "requests_total",
+			Help:      "Llamadas gRPC atendidas, por método y código.",
+		}, []string{"method", "code"}),
+		grpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "grpc",
+			Name:      "request_duration_seconds",
+			Help:      "Duración de las llamadas gRPC, por método.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method"}),
+		repoDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "repository",
+			Name:      "operation_duration_seconds",
+			Help:      "Duración de las operaciones del repositorio de eventos, por operación y resultado.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"operation", "outcome"}),
+	}
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequests, m.httpDuration,
+		m.grpcRequests, m.grpcDuration,
+		m.repoDuration,
+	)
+	return m
+}
+
+// Register agrega un colector, como el de EventsCollector.
+func (m *Metrics) Register(c prometheus.Collector) error {
+	return m.registry.Register(c)
+}
+
+// Handler sirve las métricas en el formato de exposición de Prometheus.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// ObserveHTTP registra una petición HTTP. route es la plantilla de la ruta,
+// como /api/v1/events/:id, para no crear una serie por id.
+func (m *Metrics) ObserveHTTP(method, route string, status int, elapsed time.Duration) {
+	m.httpRequests.WithLabelValues(method, route, strconv.Itoa(status)).Inc()
+	m.httpDuration.WithLabelValues(method, route).Observe(elapsed.Seconds())
+}
+
+// ObserveGRPC registra una llamada gRPC con su código de respuesta.
+func (m *Metrics) ObserveGRPC(method, code string, elapsed time.Duration) {
+	m.grpcRequests.WithLabelValues(method, code).Inc()
+	m.grpcDuration.WithLabelValues(method).Observe(elapsed.Seconds())
+}
+
+// ObserveOperation implementa repository.OperationObserver.
+func (m *Metrics) ObserveOperation(operation string, err error, elapsed time.Duration) {
+	outcome := outcomeOK
+	if err != nil {
+		outcome = string(apperr.CodeOf(err))
+	}
+	m.repoDuration.WithLabelValues(operation, outcome).Observe(elapsed.Seconds())
+}
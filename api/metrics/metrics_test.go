@@ -0,0 +1,82 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/repository"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObserve(t *testing.T) {
+	m := New()
+
+	m.ObserveHTTP(http.MethodGet, "/api/v1/events/:id", http.StatusOK, time.Millisecond)
+	m.ObserveHTTP(http.MethodGet, "/api/v1/events/:id", http.StatusNotFound, time.Millisecond)
+	m.ObserveHTTP(http.MethodGet, "/api/v1/events/:id", http.StatusNotFound, time.Millisecond)
+	m.ObserveGRPC("/event.EventService/GetEventByID", "NotFound", time.Millisecond)
+	m.ObserveOperation("GetEventByID", nil, time.Millisecond)
+	m.ObserveOperation("GetEventByID", repository.ErrEventNotfound, time.Millisecond)
+	m.ObserveOperation("UpdateEvent", errors.New("sin conexión"), time.Millisecond)
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(m.httpRequests.WithLabelValues(http.MethodGet, "/api/v1/events/:id", "404")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.grpcRequests.WithLabelValues("/event.EventService/GetEventByID", "NotFound")))
+	assert.Equal(t, 1, testutil.CollectAndCount(m.httpDuration))
+	assert.Equal(t, 3, testutil.CollectAndCount(m.repoDuration))
+	for _, outcome := range []string{"ok", "not_found", "internal"} {
+		assert.Contains(t, scrape(t, m), `outcome="`+outcome+`"`)
+	}
+}
+
+func TestEventsCollector(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemoryEventRepository(logrus.New())
+	for _, event := range []entities.Event{
+		{Name: "a", Status: entities.StatusReviewed, Category: entities.CategoryNeedsAction, NeedsAction: true},
+		{Name: "b", Status: entities.StatusReviewed, Category: entities.CategoryNeedsAction, NeedsAction: true},
+		{Name: "c", Status: entities.StatusReviewed, Category: entities.CategoryNoAction},
+		{Name: "d", Status: entities.StatusPending},
+	} {
+		_, err := repo.CreateEvent(ctx, event)
+		require.NoError(t, err)
+	}
+
+	expected := `
+# HELP eventsapi_events Eventos no eliminados de todos los tenants, por estado y categoría.
+# TYPE eventsapi_events gauge
+eventsapi_events{category="",status="Pendiente por revisar"} 1
+eventsapi_events{category="Requiere gestión",status="Revisado"} 2
+eventsapi_events{category="Sin gestión",status="Revisado"} 1
+# HELP eventsapi_events_needs_action Eventos no eliminados que requieren gestión.
+# TYPE eventsapi_events_needs_action gauge
+eventsapi_events_needs_action 2
+`
+	collector := NewEventsCollector(repo, logrus.New())
+	assert.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected)))
+}
+
+type failingCounter struct{}
+
+func (failingCounter) CountEvents(ctx context.Context) ([]entities.EventCount, error) {
+	return nil, errors.New("sin conexión")
+}
+
+func TestEventsCollectorSkipsFailedCounts(t *testing.T) {
+	assert.Equal(t, 0, testutil.CollectAndCount(NewEventsCollector(failingCounter{}, logrus.New())))
+}
+
+func scrape(t *testing.T, m *Metrics) string {
+	w := httptest.NewRecorder()
+	m.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	return w.Body.String()
+}
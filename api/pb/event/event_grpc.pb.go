@@ -1,5 +1,8 @@
-
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.14.0
+// source: api/pb/proto/event.proto
 
 package event
 
@@ -12,28 +15,69 @@ import (
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.32.0 or later.
-const _ = grpc.SupportPackageIsVersion7
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	EventService_CreateEvent_FullMethodName            = "/event.EventService/CreateEvent"
+	EventService_CreateEvents_FullMethodName           = "/event.EventService/CreateEvents"
+	EventService_GetEventByID_FullMethodName           = "/event.EventService/GetEventByID"
+	EventService_ListEvents_FullMethodName             = "/event.EventService/ListEvents"
+	EventService_GetAllEvents_FullMethodName           = "/event.EventService/GetAllEvents"
+	EventService_GetEventsByStatus_FullMethodName      = "/event.EventService/GetEventsByStatus"
+	EventService_GetEventsByCategory_FullMethodName    = "/event.EventService/GetEventsByCategory"
+	EventService_GetEventsNeedingAction_FullMethodName = "/event.EventService/GetEventsNeedingAction"
+	EventService_SearchEvents_FullMethodName           = "/event.EventService/SearchEvents"
+	EventService_UpdateEvent_FullMethodName            = "/event.EventService/UpdateEvent"
+	EventService_PatchEvent_FullMethodName             = "/event.EventService/PatchEvent"
+	EventService_DeleteEvent_FullMethodName            = "/event.EventService/DeleteEvent"
+	EventService_RestoreEvent_FullMethodName           = "/event.EventService/RestoreEvent"
+	EventService_ClassifyEvent_FullMethodName          = "/event.EventService/ClassifyEvent"
+	EventService_ManualClassifyEvent_FullMethodName    = "/event.EventService/ManualClassifyEvent"
+	EventService_DryRunClassification_FullMethodName   = "/event.EventService/DryRunClassification"
+	EventService_TransitionEvent_FullMethodName        = "/event.EventService/TransitionEvent"
+	EventService_GetEventHistory_FullMethodName        = "/event.EventService/GetEventHistory"
+	EventService_WatchEvents_FullMethodName            = "/event.EventService/WatchEvents"
+	EventService_GetTaxonomy_FullMethodName            = "/event.EventService/GetTaxonomy"
+)
 
 // EventServiceClient is the client API for EventService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type EventServiceClient interface {
-	// Create operations
 	CreateEvent(ctx context.Context, in *Event, opts ...grpc.CallOption) (*EventResponse, error)
+	// Crea los eventos recibidos en el stream. Cada evento se valida por
+	// separado; la respuesta trae un resultado por evento en el orden recibido.
+	CreateEvents(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[Event, BulkResponse], error)
 	// Read operations
 	GetEventByID(ctx context.Context, in *EventID, opts ...grpc.CallOption) (*Event, error)
-	GetAllEvents(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*EventList, error)
+	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*EventList, error)
+	GetAllEvents(ctx context.Context, in *PageRequest, opts ...grpc.CallOption) (*EventList, error)
 	GetEventsByStatus(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*EventList, error)
 	GetEventsByCategory(ctx context.Context, in *CategoryRequest, opts ...grpc.CallOption) (*EventList, error)
-	GetEventsNeedingAction(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*EventList, error)
-	// Update operations
+	GetEventsNeedingAction(ctx context.Context, in *PageRequest, opts ...grpc.CallOption) (*EventList, error)
+	// Busca texto en el nombre y la descripción; ver SearchEventsRequest.
+	SearchEvents(ctx context.Context, in *SearchEventsRequest, opts ...grpc.CallOption) (*SearchResults, error)
 	UpdateEvent(ctx context.Context, in *Event, opts ...grpc.CallOption) (*Event, error)
-	// Delete operations
+	// Actualiza solo los campos de update_mask; ver PatchEventRequest.
+	PatchEvent(ctx context.Context, in *PatchEventRequest, opts ...grpc.CallOption) (*Event, error)
 	DeleteEvent(ctx context.Context, in *EventID, opts ...grpc.CallOption) (*DeleteResponse, error)
-	// Classify operations
+	// Quita la marca de eliminado de un evento que aún no se ha purgado.
+	RestoreEvent(ctx context.Context, in *EventID, opts ...grpc.CallOption) (*Event, error)
 	ClassifyEvent(ctx context.Context, in *EventID, opts ...grpc.CallOption) (*Event, error)
 	ManualClassifyEvent(ctx context.Context, in *ManualClassifyRequest, opts ...grpc.CallOption) (*Event, error)
+	// Evalúa las reglas de clasificación sobre un evento de ejemplo sin guardarlo.
+	DryRunClassification(ctx context.Context, in *Event, opts ...grpc.CallOption) (*ClassificationResult, error)
+	// Aplica una transición del ciclo de vida y la guarda en el historial.
+	TransitionEvent(ctx context.Context, in *TransitionRequest, opts ...grpc.CallOption) (*Event, error)
+	// Historial de auditoría de un evento, incluso si ya fue eliminado.
+	GetEventHistory(ctx context.Context, in *EventID, opts ...grpc.CallOption) (*EventHistory, error)
+	// Envía los cambios de los eventos que cumplen los filtros a medida que
+	// ocurren, hasta que el cliente cancela.
+	WatchEvents(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EventChange], error)
+	// Estados con sus transiciones, categorías y tipos de evento que acepta
+	// la API.
+	GetTaxonomy(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Taxonomy, error)
 }
 
 type eventServiceClient struct {
@@ -45,26 +89,52 @@ func NewEventServiceClient(cc grpc.ClientConnInterface) EventServiceClient {
 }
 
 func (c *eventServiceClient) CreateEvent(ctx context.Context, in *Event, opts ...grpc.CallOption) (*EventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(EventResponse)
-	err := c.cc.Invoke(ctx, "/event.EventService/CreateEvent", in, out, opts...)
+	err := c.cc.Invoke(ctx, EventService_CreateEvent_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
+func (c *eventServiceClient) CreateEvents(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[Event, BulkResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &EventService_ServiceDesc.Streams[0], EventService_CreateEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Event, BulkResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EventService_CreateEventsClient = grpc.ClientStreamingClient[Event, BulkResponse]
+
 func (c *eventServiceClient) GetEventByID(ctx context.Context, in *EventID, opts ...grpc.CallOption) (*Event, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Event)
-	err := c.cc.Invoke(ctx, "/event.EventService/GetEventByID", in, out, opts...)
+	err := c.cc.Invoke(ctx, EventService_GetEventByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*EventList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventList)
+	err := c.cc.Invoke(ctx, EventService_ListEvents_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *eventServiceClient) GetAllEvents(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*EventList, error) {
+func (c *eventServiceClient) GetAllEvents(ctx context.Context, in *PageRequest, opts ...grpc.CallOption) (*EventList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(EventList)
-	err := c.cc.Invoke(ctx, "/event.EventService/GetAllEvents", in, out, opts...)
+	err := c.cc.Invoke(ctx, EventService_GetAllEvents_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -72,8 +142,9 @@ func (c *eventServiceClient) GetAllEvents(ctx context.Context, in *Empty, opts .
 }
 
 func (c *eventServiceClient) GetEventsByStatus(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*EventList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(EventList)
-	err := c.cc.Invoke(ctx, "/event.EventService/GetEventsByStatus", in, out, opts...)
+	err := c.cc.Invoke(ctx, EventService_GetEventsByStatus_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -81,17 +152,29 @@ func (c *eventServiceClient) GetEventsByStatus(ctx context.Context, in *StatusRe
 }
 
 func (c *eventServiceClient) GetEventsByCategory(ctx context.Context, in *CategoryRequest, opts ...grpc.CallOption) (*EventList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(EventList)
-	err := c.cc.Invoke(ctx, "/event.EventService/GetEventsByCategory", in, out, opts...)
+	err := c.cc.Invoke(ctx, EventService_GetEventsByCategory_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *eventServiceClient) GetEventsNeedingAction(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*EventList, error) {
+func (c *eventServiceClient) GetEventsNeedingAction(ctx context.Context, in *PageRequest, opts ...grpc.CallOption) (*EventList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(EventList)
-	err := c.cc.Invoke(ctx, "/event.EventService/GetEventsNeedingAction", in, out, opts...)
+	err := c.cc.Invoke(ctx, EventService_GetEventsNeedingAction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) SearchEvents(ctx context.Context, in *SearchEventsRequest, opts ...grpc.CallOption) (*SearchResults, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchResults)
+	err := c.cc.Invoke(ctx, EventService_SearchEvents_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -99,8 +182,19 @@ func (c *eventServiceClient) GetEventsNeedingAction(ctx context.Context, in *Emp
 }
 
 func (c *eventServiceClient) UpdateEvent(ctx context.Context, in *Event, opts ...grpc.CallOption) (*Event, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Event)
+	err := c.cc.Invoke(ctx, EventService_UpdateEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) PatchEvent(ctx context.Context, in *PatchEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Event)
-	err := c.cc.Invoke(ctx, "/event.EventService/UpdateEvent", in, out, opts...)
+	err := c.cc.Invoke(ctx, EventService_PatchEvent_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -108,8 +202,19 @@ func (c *eventServiceClient) UpdateEvent(ctx context.Context, in *Event, opts ..
 }
 
 func (c *eventServiceClient) DeleteEvent(ctx context.Context, in *EventID, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteResponse)
-	err := c.cc.Invoke(ctx, "/event.EventService/DeleteEvent", in, out, opts...)
+	err := c.cc.Invoke(ctx, EventService_DeleteEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) RestoreEvent(ctx context.Context, in *EventID, opts ...grpc.CallOption) (*Event, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Event)
+	err := c.cc.Invoke(ctx, EventService_RestoreEvent_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -117,8 +222,9 @@ func (c *eventServiceClient) DeleteEvent(ctx context.Context, in *EventID, opts
 }
 
 func (c *eventServiceClient) ClassifyEvent(ctx context.Context, in *EventID, opts ...grpc.CallOption) (*Event, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Event)
-	err := c.cc.Invoke(ctx, "/event.EventService/ClassifyEvent", in, out, opts...)
+	err := c.cc.Invoke(ctx, EventService_ClassifyEvent_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -126,8 +232,68 @@ func (c *eventServiceClient) ClassifyEvent(ctx context.Context, in *EventID, opt
 }
 
 func (c *eventServiceClient) ManualClassifyEvent(ctx context.Context, in *ManualClassifyRequest, opts ...grpc.CallOption) (*Event, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Event)
+	err := c.cc.Invoke(ctx, EventService_ManualClassifyEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) DryRunClassification(ctx context.Context, in *Event, opts ...grpc.CallOption) (*ClassificationResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClassificationResult)
+	err := c.cc.Invoke(ctx, EventService_DryRunClassification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) TransitionEvent(ctx context.Context, in *TransitionRequest, opts ...grpc.CallOption) (*Event, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Event)
-	err := c.cc.Invoke(ctx, "/event.EventService/ManualClassifyEvent", in, out, opts...)
+	err := c.cc.Invoke(ctx, EventService_TransitionEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetEventHistory(ctx context.Context, in *EventID, opts ...grpc.CallOption) (*EventHistory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventHistory)
+	err := c.cc.Invoke(ctx, EventService_GetEventHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) WatchEvents(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EventChange], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &EventService_ServiceDesc.Streams[1], EventService_WatchEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchRequest, EventChange]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EventService_WatchEventsClient = grpc.ServerStreamingClient[EventChange]
+
+func (c *eventServiceClient) GetTaxonomy(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Taxonomy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Taxonomy)
+	err := c.cc.Invoke(ctx, EventService_GetTaxonomy_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -136,37 +302,64 @@ func (c *eventServiceClient) ManualClassifyEvent(ctx context.Context, in *Manual
 
 // EventServiceServer is the server API for EventService service.
 // All implementations must embed UnimplementedEventServiceServer
-// for forward compatibility
+// for forward compatibility.
 type EventServiceServer interface {
-	// Create operations
 	CreateEvent(context.Context, *Event) (*EventResponse, error)
+	// Crea los eventos recibidos en el stream. Cada evento se valida por
+	// separado; la respuesta trae un resultado por evento en el orden recibido.
+	CreateEvents(grpc.ClientStreamingServer[Event, BulkResponse]) error
 	// Read operations
 	GetEventByID(context.Context, *EventID) (*Event, error)
-	GetAllEvents(context.Context, *Empty) (*EventList, error)
+	ListEvents(context.Context, *ListEventsRequest) (*EventList, error)
+	GetAllEvents(context.Context, *PageRequest) (*EventList, error)
 	GetEventsByStatus(context.Context, *StatusRequest) (*EventList, error)
 	GetEventsByCategory(context.Context, *CategoryRequest) (*EventList, error)
-	GetEventsNeedingAction(context.Context, *Empty) (*EventList, error)
-	// Update operations
+	GetEventsNeedingAction(context.Context, *PageRequest) (*EventList, error)
+	// Busca texto en el nombre y la descripción; ver SearchEventsRequest.
+	SearchEvents(context.Context, *SearchEventsRequest) (*SearchResults, error)
 	UpdateEvent(context.Context, *Event) (*Event, error)
-	// Delete operations
+	// Actualiza solo los campos de update_mask; ver PatchEventRequest.
+	PatchEvent(context.Context, *PatchEventRequest) (*Event, error)
 	DeleteEvent(context.Context, *EventID) (*DeleteResponse, error)
-	// Classify operations
+	// Quita la marca de eliminado de un evento que aún no se ha purgado.
+	RestoreEvent(context.Context, *EventID) (*Event, error)
 	ClassifyEvent(context.Context, *EventID) (*Event, error)
 	ManualClassifyEvent(context.Context, *ManualClassifyRequest) (*Event, error)
+	// Evalúa las reglas de clasificación sobre un evento de ejemplo sin guardarlo.
+	DryRunClassification(context.Context, *Event) (*ClassificationResult, error)
+	// Aplica una transición del ciclo de vida y la guarda en el historial.
+	TransitionEvent(context.Context, *TransitionRequest) (*Event, error)
+	// Historial de auditoría de un evento, incluso si ya fue eliminado.
+	GetEventHistory(context.Context, *EventID) (*EventHistory, error)
+	// Envía los cambios de los eventos que cumplen los filtros a medida que
+	// ocurren, hasta que el cliente cancela.
+	WatchEvents(*WatchRequest, grpc.ServerStreamingServer[EventChange]) error
+	// Estados con sus transiciones, categorías y tipos de evento que acepta
+	// la API.
+	GetTaxonomy(context.Context, *Empty) (*Taxonomy, error)
 	mustEmbedUnimplementedEventServiceServer()
 }
 
-// UnimplementedEventServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedEventServiceServer struct {
-}
+// UnimplementedEventServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEventServiceServer struct{}
 
 func (UnimplementedEventServiceServer) CreateEvent(context.Context, *Event) (*EventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateEvent not implemented")
 }
+func (UnimplementedEventServiceServer) CreateEvents(grpc.ClientStreamingServer[Event, BulkResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method CreateEvents not implemented")
+}
 func (UnimplementedEventServiceServer) GetEventByID(context.Context, *EventID) (*Event, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEventByID not implemented")
 }
-func (UnimplementedEventServiceServer) GetAllEvents(context.Context, *Empty) (*EventList, error) {
+func (UnimplementedEventServiceServer) ListEvents(context.Context, *ListEventsRequest) (*EventList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
+}
+func (UnimplementedEventServiceServer) GetAllEvents(context.Context, *PageRequest) (*EventList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllEvents not implemented")
 }
 func (UnimplementedEventServiceServer) GetEventsByStatus(context.Context, *StatusRequest) (*EventList, error) {
@@ -175,22 +368,47 @@ func (UnimplementedEventServiceServer) GetEventsByStatus(context.Context, *Statu
 func (UnimplementedEventServiceServer) GetEventsByCategory(context.Context, *CategoryRequest) (*EventList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEventsByCategory not implemented")
 }
-func (UnimplementedEventServiceServer) GetEventsNeedingAction(context.Context, *Empty) (*EventList, error) {
+func (UnimplementedEventServiceServer) GetEventsNeedingAction(context.Context, *PageRequest) (*EventList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEventsNeedingAction not implemented")
 }
+func (UnimplementedEventServiceServer) SearchEvents(context.Context, *SearchEventsRequest) (*SearchResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchEvents not implemented")
+}
 func (UnimplementedEventServiceServer) UpdateEvent(context.Context, *Event) (*Event, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateEvent not implemented")
 }
+func (UnimplementedEventServiceServer) PatchEvent(context.Context, *PatchEventRequest) (*Event, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchEvent not implemented")
+}
 func (UnimplementedEventServiceServer) DeleteEvent(context.Context, *EventID) (*DeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteEvent not implemented")
 }
+func (UnimplementedEventServiceServer) RestoreEvent(context.Context, *EventID) (*Event, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreEvent not implemented")
+}
 func (UnimplementedEventServiceServer) ClassifyEvent(context.Context, *EventID) (*Event, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClassifyEvent not implemented")
 }
 func (UnimplementedEventServiceServer) ManualClassifyEvent(context.Context, *ManualClassifyRequest) (*Event, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ManualClassifyEvent not implemented")
 }
+func (UnimplementedEventServiceServer) DryRunClassification(context.Context, *Event) (*ClassificationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunClassification not implemented")
+}
+func (UnimplementedEventServiceServer) TransitionEvent(context.Context, *TransitionRequest) (*Event, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitionEvent not implemented")
+}
+func (UnimplementedEventServiceServer) GetEventHistory(context.Context, *EventID) (*EventHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventHistory not implemented")
+}
+func (UnimplementedEventServiceServer) WatchEvents(*WatchRequest, grpc.ServerStreamingServer[EventChange]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
+func (UnimplementedEventServiceServer) GetTaxonomy(context.Context, *Empty) (*Taxonomy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaxonomy not implemented")
+}
 func (UnimplementedEventServiceServer) mustEmbedUnimplementedEventServiceServer() {}
+func (UnimplementedEventServiceServer) testEmbeddedByValue()                      {}
 
 // UnsafeEventServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to EventServiceServer will
@@ -200,6 +418,13 @@ type UnsafeEventServiceServer interface {
 }
 
 func RegisterEventServiceServer(s grpc.ServiceRegistrar, srv EventServiceServer) {
+	// If the following call pancis, it indicates UnimplementedEventServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
 	s.RegisterService(&EventService_ServiceDesc, srv)
 }
 
@@ -213,7 +438,7 @@ func _EventService_CreateEvent_Handler(srv interface{}, ctx context.Context, dec
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/event.EventService/CreateEvent",
+		FullMethod: EventService_CreateEvent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(EventServiceServer).CreateEvent(ctx, req.(*Event))
@@ -221,6 +446,13 @@ func _EventService_CreateEvent_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_CreateEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(EventServiceServer).CreateEvents(&grpc.GenericServerStream[Event, BulkResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EventService_CreateEventsServer = grpc.ClientStreamingServer[Event, BulkResponse]
+
 func _EventService_GetEventByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EventID)
 	if err := dec(in); err != nil {
@@ -231,7 +463,7 @@ func _EventService_GetEventByID_Handler(srv interface{}, ctx context.Context, de
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/event.EventService/GetEventByID",
+		FullMethod: EventService_GetEventByID_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(EventServiceServer).GetEventByID(ctx, req.(*EventID))
@@ -239,8 +471,26 @@ func _EventService_GetEventByID_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ListEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListEvents(ctx, req.(*ListEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EventService_GetAllEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+	in := new(PageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -249,10 +499,10 @@ func _EventService_GetAllEvents_Handler(srv interface{}, ctx context.Context, de
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/event.EventService/GetAllEvents",
+		FullMethod: EventService_GetAllEvents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServiceServer).GetAllEvents(ctx, req.(*Empty))
+		return srv.(EventServiceServer).GetAllEvents(ctx, req.(*PageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -267,7 +517,7 @@ func _EventService_GetEventsByStatus_Handler(srv interface{}, ctx context.Contex
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/event.EventService/GetEventsByStatus",
+		FullMethod: EventService_GetEventsByStatus_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(EventServiceServer).GetEventsByStatus(ctx, req.(*StatusRequest))
@@ -285,7 +535,7 @@ func _EventService_GetEventsByCategory_Handler(srv interface{}, ctx context.Cont
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/event.EventService/GetEventsByCategory",
+		FullMethod: EventService_GetEventsByCategory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(EventServiceServer).GetEventsByCategory(ctx, req.(*CategoryRequest))
@@ -294,7 +544,7 @@ func _EventService_GetEventsByCategory_Handler(srv interface{}, ctx context.Cont
 }
 
 func _EventService_GetEventsNeedingAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+	in := new(PageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -303,10 +553,28 @@ func _EventService_GetEventsNeedingAction_Handler(srv interface{}, ctx context.C
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/event.EventService/GetEventsNeedingAction",
+		FullMethod: EventService_GetEventsNeedingAction_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServiceServer).GetEventsNeedingAction(ctx, req.(*Empty))
+		return srv.(EventServiceServer).GetEventsNeedingAction(ctx, req.(*PageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_SearchEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SearchEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_SearchEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SearchEvents(ctx, req.(*SearchEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -321,7 +589,7 @@ func _EventService_UpdateEvent_Handler(srv interface{}, ctx context.Context, dec
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/event.EventService/UpdateEvent",
+		FullMethod: EventService_UpdateEvent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(EventServiceServer).UpdateEvent(ctx, req.(*Event))
@@ -329,6 +597,24 @@ func _EventService_UpdateEvent_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_PatchEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).PatchEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_PatchEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).PatchEvent(ctx, req.(*PatchEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EventService_DeleteEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EventID)
 	if err := dec(in); err != nil {
@@ -339,7 +625,7 @@ func _EventService_DeleteEvent_Handler(srv interface{}, ctx context.Context, dec
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/event.EventService/DeleteEvent",
+		FullMethod: EventService_DeleteEvent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(EventServiceServer).DeleteEvent(ctx, req.(*EventID))
@@ -347,6 +633,24 @@ func _EventService_DeleteEvent_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_RestoreEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).RestoreEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_RestoreEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).RestoreEvent(ctx, req.(*EventID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EventService_ClassifyEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EventID)
 	if err := dec(in); err != nil {
@@ -357,7 +661,7 @@ func _EventService_ClassifyEvent_Handler(srv interface{}, ctx context.Context, d
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/event.EventService/ClassifyEvent",
+		FullMethod: EventService_ClassifyEvent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(EventServiceServer).ClassifyEvent(ctx, req.(*EventID))
@@ -375,7 +679,7 @@ func _EventService_ManualClassifyEvent_Handler(srv interface{}, ctx context.Cont
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/event.EventService/ManualClassifyEvent",
+		FullMethod: EventService_ManualClassifyEvent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(EventServiceServer).ManualClassifyEvent(ctx, req.(*ManualClassifyRequest))
@@ -383,6 +687,89 @@ func _EventService_ManualClassifyEvent_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_DryRunClassification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Event)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).DryRunClassification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_DryRunClassification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).DryRunClassification(ctx, req.(*Event))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_TransitionEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).TransitionEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_TransitionEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).TransitionEvent(ctx, req.(*TransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetEventHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetEventHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetEventHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetEventHistory(ctx, req.(*EventID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventServiceServer).WatchEvents(m, &grpc.GenericServerStream[WatchRequest, EventChange]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EventService_WatchEventsServer = grpc.ServerStreamingServer[EventChange]
+
+func _EventService_GetTaxonomy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetTaxonomy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetTaxonomy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetTaxonomy(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EventService_ServiceDesc is the grpc.ServiceDesc for EventService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -398,6 +785,10 @@ var EventService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEventByID",
 			Handler:    _EventService_GetEventByID_Handler,
 		},
+		{
+			MethodName: "ListEvents",
+			Handler:    _EventService_ListEvents_Handler,
+		},
 		{
 			MethodName: "GetAllEvents",
 			Handler:    _EventService_GetAllEvents_Handler,
@@ -414,14 +805,26 @@ var EventService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEventsNeedingAction",
 			Handler:    _EventService_GetEventsNeedingAction_Handler,
 		},
+		{
+			MethodName: "SearchEvents",
+			Handler:    _EventService_SearchEvents_Handler,
+		},
 		{
 			MethodName: "UpdateEvent",
 			Handler:    _EventService_UpdateEvent_Handler,
 		},
+		{
+			MethodName: "PatchEvent",
+			Handler:    _EventService_PatchEvent_Handler,
+		},
 		{
 			MethodName: "DeleteEvent",
 			Handler:    _EventService_DeleteEvent_Handler,
 		},
+		{
+			MethodName: "RestoreEvent",
+			Handler:    _EventService_RestoreEvent_Handler,
+		},
 		{
 			MethodName: "ClassifyEvent",
 			Handler:    _EventService_ClassifyEvent_Handler,
@@ -430,7 +833,34 @@ var EventService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ManualClassifyEvent",
 			Handler:    _EventService_ManualClassifyEvent_Handler,
 		},
+		{
+			MethodName: "DryRunClassification",
+			Handler:    _EventService_DryRunClassification_Handler,
+		},
+		{
+			MethodName: "TransitionEvent",
+			Handler:    _EventService_TransitionEvent_Handler,
+		},
+		{
+			MethodName: "GetEventHistory",
+			Handler:    _EventService_GetEventHistory_Handler,
+		},
+		{
+			MethodName: "GetTaxonomy",
+			Handler:    _EventService_GetTaxonomy_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "CreateEvents",
+			Handler:       _EventService_CreateEvents_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _EventService_WatchEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/pb/proto/event.proto",
 }
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/logging"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// APIKeyRepository guarda las API keys por el hash de la clave; la clave en
+// claro nunca llega al repositorio.
+type APIKeyRepository interface {
+	CreateAPIKey(ctx context.Context, key entities.APIKey) (entities.APIKey, error)
+	// GetAPIKeyByHash devuelve la clave con ese hash, aunque esté revocada.
+	GetAPIKeyByHash(ctx context.Context, hash string) (entities.APIKey, error)
+	ListAPIKeys(ctx context.Context) ([]entities.APIKey, error)
+	// RevokeAPIKey marca la clave como revocada en at. Revocar una clave ya
+	// revocada no cambia la fecha.
+	RevokeAPIKey(ctx context.Context, id string, at time.Time) (entities.APIKey, error)
+}
+
+type MongoAPIKeyRepository struct {
+	db       *mongo.Client
+	database string
+	logger   logrus.FieldLogger
+}
+
+// NewMongoAPIKeyRepository solo usa WithDatabase de opts: sus colecciones
+// están siempre en la base compartida, también con WithDatabasePerTenant.
+func NewMongoAPIKeyRepository(db *mongo.Client, logger logrus.FieldLogger, opts ...MongoOption) *MongoAPIKeyRepository {
+	return &MongoAPIKeyRepository{
+		db:       db,
+		database: newMongoOptions(opts).database,
+		logger:   logger,
+	}
+}
+
+func (r *MongoAPIKeyRepository) collection() *mongo.Collection {
+	return r.db.Database(r.database).Collection("api_keys")
+}
+
+func (r *MongoAPIKeyRepository) CreateAPIKey(ctx context.Context, key entities.APIKey) (entities.APIKey, error) {
+	key.ID = ""
+	result, err := r.collection().InsertOne(ctx, key)
+	if err != nil {
+		logging.For(ctx, r.logger, "apikey_repository", "CreateAPIKey").WithError(err).Error("operación fallida")
+		return entities.APIKey{}, err
+	}
+	key.ID = result.InsertedID.(primitive.ObjectID).Hex()
+	return key, nil
+}
+
+func (r *MongoAPIKeyRepository) GetAPIKeyByHash(ctx context.Context, hash string) (entities.APIKey, error) {
+	var key entities.APIKey
+	err := r.collection().FindOne(ctx, bson.D{{Key: "hash", Value: hash}}).Decode(&key)
+	if err == mongo.ErrNoDocuments {
+		return entities.APIKey{}, ErrAPIKeyNotFound
+	}
+	if err != nil {
+		logging.For(ctx, r.logger, "apikey_repository", "GetAPIKeyByHash").WithError(err).Error("operación fallida")
+		return entities.APIKey{}, err
+	}
+	return key, nil
+}
+
+// ListAPIKeys devuelve las claves de la más antigua a la más reciente.
+func (r *MongoAPIKeyRepository) ListAPIKeys(ctx context.Context) ([]entities.APIKey, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}})
+	cursor, err := r.collection().Find(ctx, bson.D{}, opts)
+	if err != nil {
+		logging.For(ctx, r.logger, "apikey_repository", "ListAPIKeys").WithError(err).Error("operación fallida")
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	keys := []entities.APIKey{}
+	if err := cursor.All(ctx, &keys); err != nil {
+		logging.For(ctx, r.logger, "apikey_repository", "ListAPIKeys").WithError(err).Error("operación fallida")
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (r *MongoAPIKeyRepository) RevokeAPIKey(ctx context.Context, id string, at time.Time) (entities.APIKey, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return entities.APIKey{}, ErrAPIKeyNotFound
+	}
+
+	// El pipeline conserva la fecha de la primera revocación.
+	update := mongo.Pipeline{{{Key: "$set", Value: bson.M{
+		"revoked_at": bson.M{"$ifNull": bson.A{"$revoked_at", at}},
+	}}}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	var revoked entities.APIKey
+	err = r.collection().FindOneAndUpdate(ctx, bson.D{{Key: "_id", Value: oid}}, update, opts).Decode(&revoked)
+	if err == mongo.ErrNoDocuments {
+		return entities.APIKey{}, ErrAPIKeyNotFound
+	}
+	if err != nil {
+		logging.For(ctx, r.logger, "apikey_repository", "RevokeAPIKey").WithError(err).Error("operación fallida")
+		return entities.APIKey{}, err
+	}
+	return revoked, nil
+}
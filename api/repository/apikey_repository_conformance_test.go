@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runAPIKeyRepositoryConformance ejecuta el mismo contrato contra cualquier
+// implementación de APIKeyRepository. newRepo debe devolver un repositorio vacío.
+func runAPIKeyRepositoryConformance(t *testing.T, newRepo func(t *testing.T) APIKeyRepository) {
+	ctx := context.Background()
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("API key lifecycle", func(t *testing.T) {
+		repo := newRepo(t)
+
+		created, err := repo.CreateAPIKey(ctx, entities.APIKey{
+			Name:      "ci",
+			Subject:   "svc-ci",
+			Roles:     []string{"operator"},
+			Prefix:    "evk_abcd",
+			Hash:      "hash-1",
+			Key:       "evk_abcd-plain",
+			CreatedAt: base,
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, created.ID)
+		second, err := repo.CreateAPIKey(ctx, entities.APIKey{Name: "other", Subject: "svc-other", Hash: "hash-2", CreatedAt: base})
+		require.NoError(t, err)
+
+		found, err := repo.GetAPIKeyByHash(ctx, "hash-1")
+		require.NoError(t, err)
+		assert.Equal(t, created.ID, found.ID)
+		assert.Equal(t, "svc-ci", found.Subject)
+		assert.Equal(t, []string{"operator"}, found.Roles)
+		assert.Empty(t, found.Key, "la clave en claro no se guarda")
+		assert.Nil(t, found.RevokedAt)
+
+		_, err = repo.GetAPIKeyByHash(ctx, "missing")
+		assert.Equal(t, ErrAPIKeyNotFound, err)
+
+		all, err := repo.ListAPIKeys(ctx)
+		require.NoError(t, err)
+		require.Len(t, all, 2)
+		assert.Equal(t, created.ID, all[0].ID)
+		assert.Equal(t, second.ID, all[1].ID)
+
+		revokedAt := base.Add(time.Hour)
+		revoked, err := repo.RevokeAPIKey(ctx, created.ID, revokedAt)
+		require.NoError(t, err)
+		require.NotNil(t, revoked.RevokedAt)
+		assert.True(t, revokedAt.Equal(*revoked.RevokedAt))
+
+		again, err := repo.RevokeAPIKey(ctx, created.ID, revokedAt.Add(time.Hour))
+		require.NoError(t, err)
+		assert.True(t, revokedAt.Equal(*again.RevokedAt), "revocar de nuevo conserva la fecha")
+
+		found, err = repo.GetAPIKeyByHash(ctx, "hash-1")
+		require.NoError(t, err)
+		assert.NotNil(t, found.RevokedAt)
+
+		_, err = repo.RevokeAPIKey(ctx, "not-an-id", revokedAt)
+		assert.Equal(t, ErrAPIKeyNotFound, err)
+		_, err = repo.RevokeAPIKey(ctx, "65a000000000000000000000", revokedAt)
+		assert.Equal(t, ErrAPIKeyNotFound, err)
+	})
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/logging"
+	"prueba_tecnica/api/tenant"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AuditRepository guarda el historial de cambios de los eventos. Solo permite
+// agregar entradas y leerlas, siempre del tenant de ctx.
+type AuditRepository interface {
+	AppendEntry(ctx context.Context, entry entities.AuditEntry) (entities.AuditEntry, error)
+	ListEntries(ctx context.Context, eventID string) ([]entities.AuditEntry, error)
+}
+
+type MongoAuditRepository struct {
+	db         *mongo.Client
+	database   string
+	collection string
+	tenancy    tenancy
+	logger     logrus.FieldLogger
+}
+
+func NewMongoAuditRepository(db *mongo.Client, logger logrus.FieldLogger, opts ...MongoOption) *MongoAuditRepository {
+	o := newMongoOptions(opts)
+	return &MongoAuditRepository{
+		db:         db,
+		database:   o.database,
+		collection: "event_history",
+		tenancy:    o.tenancy,
+		logger:     logger,
+	}
+}
+
+func (r *MongoAuditRepository) coll(ctx context.Context) *mongo.Collection {
+	return r.db.Database(r.tenancy.databaseFor(ctx, r.database)).Collection(r.collection)
+}
+
+func (r *MongoAuditRepository) AppendEntry(ctx context.Context, entry entities.AuditEntry) (entities.AuditEntry, error) {
+	entry.ID = ""
+	entry.TenantID = tenant.FromContext(ctx)
+	result, err := r.coll(ctx).InsertOne(ctx, entry)
+	if err != nil {
+		logging.For(ctx, r.logger, "audit_repository", "AppendEntry").WithError(err).Error("operación fallida")
+		return entities.AuditEntry{}, err
+	}
+	entry.ID = result.InsertedID.(primitive.ObjectID).Hex()
+	return entry, nil
+}
+
+// ListEntries devuelve el historial de eventID del más antiguo al más reciente.
+func (r *MongoAuditRepository) ListEntries(ctx context.Context, eventID string) ([]entities.AuditEntry, error) {
+	filter := bson.D{{Key: "event_id", Value: eventID}, tenantFilter(ctx)}
+	opts := options.Find().SetSort(bson.D{{Key: "at", Value: 1}, {Key: "_id", Value: 1}})
+
+	cursor, err := r.coll(ctx).Find(ctx, filter, opts)
+	if err != nil {
+		logging.For(ctx, r.logger, "audit_repository", "ListEntries").WithError(err).Error("operación fallida")
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	entries := []entities.AuditEntry{}
+	if err := cursor.All(ctx, &entries); err != nil {
+		logging.For(ctx, r.logger, "audit_repository", "ListEntries").WithError(err).Error("operación fallida")
+		return nil, err
+	}
+	return entries, nil
+}
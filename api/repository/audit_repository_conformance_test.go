@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/tenant"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runAuditRepositoryConformance ejecuta el mismo contrato contra cualquier
+// implementación de AuditRepository. newRepo debe devolver un repositorio vacío.
+func runAuditRepositoryConformance(t *testing.T, newRepo func(t *testing.T) AuditRepository) {
+	ctx := context.Background()
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Entries are listed per event in order", func(t *testing.T) {
+		repo := newRepo(t)
+
+		first, err := repo.AppendEntry(ctx, entities.AuditEntry{
+			EventID:   "a",
+			Action:    entities.AuditCreate,
+			Actor:     "ana",
+			Transport: entities.TransportHTTP,
+			Changes:   []entities.FieldChange{{Field: "name", New: "VPN"}},
+			At:        base,
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, first.ID)
+
+		_, err = repo.AppendEntry(ctx, entities.AuditEntry{EventID: "b", Action: entities.AuditCreate, At: base})
+		require.NoError(t, err)
+		_, err = repo.AppendEntry(ctx, entities.AuditEntry{
+			EventID: "a",
+			Action:  entities.AuditUpdate,
+			Actor:   "luis",
+			Changes: []entities.FieldChange{{Field: "name", Old: "VPN", New: "VPN caída"}},
+			At:      base.Add(time.Minute),
+		})
+		require.NoError(t, err)
+
+		entries, err := repo.ListEntries(ctx, "a")
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		assert.Equal(t, entities.AuditCreate, entries[0].Action)
+		assert.Equal(t, "ana", entries[0].Actor)
+		assert.Equal(t, entities.TransportHTTP, entries[0].Transport)
+		assert.Equal(t, []entities.FieldChange{{Field: "name", New: "VPN"}}, entries[0].Changes)
+		assert.True(t, base.Equal(entries[0].At))
+		assert.Equal(t, entities.AuditUpdate, entries[1].Action)
+		assert.Equal(t, "VPN caída", entries[1].Changes[0].New)
+	})
+
+	t.Run("Unknown event has an empty history", func(t *testing.T) {
+		repo := newRepo(t)
+
+		entries, err := repo.ListEntries(ctx, "missing")
+		require.NoError(t, err)
+		assert.NotNil(t, entries)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("Entries are scoped to the tenant", func(t *testing.T) {
+		repo := newRepo(t)
+		acme := tenant.WithID(ctx, "acme")
+
+		_, err := repo.AppendEntry(acme, entities.AuditEntry{EventID: "a", Action: entities.AuditCreate, At: base})
+		require.NoError(t, err)
+
+		entries, err := repo.ListEntries(acme, "a")
+		require.NoError(t, err)
+		assert.Len(t, entries, 1)
+		entries, err = repo.ListEntries(ctx, "a")
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"prueba_tecnica/api/entities"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	DefaultPageLimit = 50
+	MaxPageLimit     = 500
+)
+
+// pageCursor es la posición del último evento entregado dentro del orden
+// (campo, _id) del listado. Guarda el orden para rechazar cursores de otra consulta.
+type pageCursor struct {
+	SortBy string    `json:"s"`
+	Desc   bool      `json:"o"`
+	Date   time.Time `json:"d,omitempty"`
+	Value  string    `json:"v,omitempty"`
+	ID     string    `json:"id"`
+}
+
+func encodeCursor(event entities.Event, query entities.EventQuery) string {
+	field, desc := query.Sort()
+	c := pageCursor{SortBy: field, Desc: desc, ID: event.ID}
+	if field == entities.SortByDate {
+		c.Date = event.Date
+	} else {
+		c.Value = sortValue(event, field)
+	}
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(query entities.EventQuery) (pageCursor, error) {
+	var c pageCursor
+	b, err := base64.RawURLEncoding.DecodeString(query.Page.Cursor)
+	if err != nil {
+		return c, ErrInvalidCursor
+	}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return c, ErrInvalidCursor
+	}
+	if _, err := primitive.ObjectIDFromHex(c.ID); err != nil {
+		return c, ErrInvalidCursor
+	}
+	if field, desc := query.Sort(); c.SortBy != field || c.Desc != desc {
+		return c, ErrInvalidCursor
+	}
+	return c, nil
+}
+
+// sortValue devuelve el valor de texto de event por el que se ordena field.
+func sortValue(event entities.Event, field string) string {
+	switch field {
+	case entities.SortByName:
+		return event.Name
+	case entities.SortByType:
+		return event.Type
+	case entities.SortByStatus:
+		return string(event.Status)
+	}
+	return ""
+}
+
+func pageLimit(page entities.PageRequest) int {
+	if page.Limit <= 0 {
+		return DefaultPageLimit
+	}
+	if page.Limit > MaxPageLimit {
+		return MaxPageLimit
+	}
+	return page.Limit
+}
+
+// newEventPage recorta events (que trae hasta limit+1 elementos) y calcula
+// el cursor de la siguiente página.
+func newEventPage(events []entities.Event, query entities.EventQuery) entities.EventPage {
+	limit := pageLimit(query.Page)
+	page := entities.EventPage{Events: events}
+	if page.Events == nil {
+		page.Events = []entities.Event{}
+	}
+	if len(page.Events) > limit {
+		page.Events = page.Events[:limit]
+		page.NextCursor = encodeCursor(page.Events[limit-1], query)
+	}
+	return page
+}
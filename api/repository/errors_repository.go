@@ -1,6 +1,25 @@
 package repository
 
-import "errors"
+import (
+	"fmt"
+	"prueba_tecnica/api/apperr"
+)
 
-var ErrEventNotfound = errors.New("Error evento no encontrado")
-var ErrNotasks = errors.New("No sé elimino ningun evento, ese evento no se encuentre en la base de datos")
+var ErrEventNotfound = apperr.New(apperr.NotFound, "event.not_found")
+
+// Deprecated: DeleteEvent devuelve ErrEventNotfound; ErrNotasks es el mismo error.
+var ErrNotasks = ErrEventNotfound
+var ErrInvalidCursor = apperr.New(apperr.InvalidArgument, "page.invalid_cursor")
+var ErrNotDeleted = apperr.New(apperr.FailedPrecondition, "event.not_deleted")
+var ErrVersionConflict = apperr.New(apperr.Conflict, "event.version_conflict")
+
+// BulkError reporta los elementos de un lote que no se pudieron guardar. La
+// clave es la posición del elemento en el lote; el resto sí se guardó.
+type BulkError map[int]error
+
+func (e BulkError) Error() string {
+	return fmt.Sprintf("%d elementos del lote no se pudieron guardar", len(e))
+}
+
+var ErrWebhookNotFound = apperr.New(apperr.NotFound, "webhook.not_found")
+var ErrAPIKeyNotFound = apperr.New(apperr.NotFound, "apikey.not_found")
@@ -2,7 +2,11 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/logging"
+	"prueba_tecnica/api/tenant"
+	"regexp"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -12,170 +16,704 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// EventRepository guarda los eventos de cada tenant por separado: todas las
+// operaciones, salvo PurgeDeleted, solo ven los eventos del tenant de ctx.
 type EventRepository interface {
 	CreateEvent(ctx context.Context, event entities.Event) (entities.Event, error)
+	// CreateEvents guarda un lote de eventos en una sola operación y los
+	// devuelve en el mismo orden. Si solo fallan algunos devuelve un BulkError
+	// con sus posiciones; los demás quedan guardados.
+	CreateEvents(ctx context.Context, events []entities.Event) ([]entities.Event, error)
 	GetEventByID(ctx context.Context, id string) (entities.Event, error)
-	GetAllEvents(ctx context.Context) ([]entities.Event, error)
-	GetEventsByStatus(ctx context.Context, status string) ([]entities.Event, error)
-	GetEventsByCategory(ctx context.Context, category string) ([]entities.Event, error)
-	GetEventsNeedingAction(ctx context.Context) ([]entities.Event, error)
+	ListEvents(ctx context.Context, query entities.EventQuery) (entities.EventPage, error)
+	// SearchEvents busca el texto de query en el nombre y la descripción de
+	// los eventos no eliminados y los devuelve ordenados por relevancia, con
+	// los resaltados de cada uno. Los repositorios sin índice de texto pueden
+	// delegar en ScanSearch.
+	SearchEvents(ctx context.Context, query entities.SearchQuery) (entities.SearchPage, error)
+	// UpdateEvent guarda event solo si su Version coincide con la guardada y
+	// devuelve el evento con la versión incrementada. Si no coincide devuelve
+	// ErrVersionConflict.
 	UpdateEvent(ctx context.Context, event entities.Event) (entities.Event, error)
-	DeleteEvent(ctx context.Context, id string) error
+	// UpdateEvents aplica UpdateEvent a un lote en una sola operación. Los
+	// fallos por elemento se devuelven en un BulkError.
+	UpdateEvents(ctx context.Context, events []entities.Event) ([]entities.Event, error)
+	// DeleteEvent marca el evento como eliminado en deletedAt. Los eventos
+	// eliminados no se ven en GetEventByID, UpdateEvent ni ListEvents salvo con
+	// IncludeDeleted.
+	DeleteEvent(ctx context.Context, id string, deletedAt time.Time) error
+	// RestoreEvent quita la marca de eliminado y devuelve el evento como estaba
+	// antes de restaurarlo, con su DeletedAt. Devuelve ErrNotDeleted si el
+	// evento existe pero no está eliminado.
+	RestoreEvent(ctx context.Context, id string) (entities.Event, error)
+	// PurgeDeleted borra definitivamente los eventos eliminados antes de
+	// before, de todos los tenants.
+	PurgeDeleted(ctx context.Context, before time.Time) (int64, error)
+}
+
+// EventCounter lo implementan los repositorios que pueden contar sus eventos
+// sin recorrerlos uno por uno, para las métricas.
+type EventCounter interface {
+	// CountEvents cuenta los eventos no eliminados de todos los tenants,
+	// agrupados por estado, categoría y needs_action.
+	CountEvents(ctx context.Context) ([]entities.EventCount, error)
+}
+
+// notDeleted filtra los eventos que no están eliminados.
+var notDeleted = bson.E{Key: "deleted_at", Value: nil}
+
+// versionFilter filtra por versión; la versión 0 incluye los documentos
+// guardados antes de que existiera el campo.
+func versionFilter(version int64) bson.E {
+	if version == 0 {
+		return bson.E{Key: "version", Value: bson.M{"$in": bson.A{0, nil}}}
+	}
+	return bson.E{Key: "version", Value: version}
 }
 
 type MongoEventRepository struct {
-	db     *mongo.Client
-	logger logrus.FieldLogger
+	db         *mongo.Client
+	database   string
+	collection string
+	tenancy    tenancy
+	logger     logrus.FieldLogger
 }
 
-func NewMongoEventRepository(db *mongo.Client, logger logrus.FieldLogger) *MongoEventRepository {
+func NewMongoEventRepository(db *mongo.Client, logger logrus.FieldLogger, opts ...MongoOption) *MongoEventRepository {
+	o := newMongoOptions(opts)
 	return &MongoEventRepository{
-		db:     db,
-		logger: logger,
+		db:         db,
+		database:   o.database,
+		collection: o.eventCollection,
+		tenancy:    o.tenancy,
+		logger:     logger,
 	}
 
 }
 
+// coll devuelve la colección de eventos del tenant de ctx.
+func (r *MongoEventRepository) coll(ctx context.Context) *mongo.Collection {
+	return r.db.Database(r.tenancy.databaseFor(ctx, r.database)).Collection(r.collection)
+}
+
 func (r *MongoEventRepository) CreateEvent(ctx context.Context, event entities.Event) (entities.Event, error) {
-	coll := r.db.Database("events_db").Collection("events")
+	if err := r.tenancy.register(ctx, r.db, r.database); err != nil {
+		logging.For(ctx, r.logger, "event_repository", "CreateEvent").WithError(err).Error("operación fallida")
+		return event, err
+	}
+
+	coll := r.coll(ctx)
+	event.TenantID = tenant.FromContext(ctx)
 	event.Date = time.Now()
+	event.Version = 1
 	result, err := coll.InsertOne(ctx, event)
 
 	if err != nil {
-		r.logger.Errorln("Layer:event_repository ", "Method:CreateEvent ", "Error:", err)
+		logging.For(ctx, r.logger, "event_repository", "CreateEvent").WithError(err).Error("operación fallida")
 		return event, err
 	}
 
 	event.ID = result.InsertedID.(primitive.ObjectID).Hex()
-	r.logger.Infoln("Layer:event_repository", "Method:CreateEvent", "event:", event)
+	logging.For(ctx, r.logger, "event_repository", "CreateEvent").WithFields(logging.Event(event)).Debug("evento guardado")
 	return event, err
 }
 
+func (r *MongoEventRepository) CreateEvents(ctx context.Context, events []entities.Event) ([]entities.Event, error) {
+	if len(events) == 0 {
+		return nil, nil
+	}
+	if err := r.tenancy.register(ctx, r.db, r.database); err != nil {
+		logging.For(ctx, r.logger, "event_repository", "CreateEvents").WithError(err).Error("operación fallida")
+		return nil, err
+	}
+
+	now := time.Now()
+	tenantID := tenant.FromContext(ctx)
+	created := make([]entities.Event, len(events))
+	docs := make([]interface{}, len(events))
+	for i, event := range events {
+		oid := primitive.NewObjectID()
+		event.ID = ""
+		event.TenantID = tenantID
+		event.Date = now
+		event.Version = 1
+		doc, err := withObjectID(event, oid)
+		if err != nil {
+			logging.For(ctx, r.logger, "event_repository", "CreateEvents").WithError(err).Error("operación fallida")
+			return nil, err
+		}
+		event.ID = oid.Hex()
+		created[i] = event
+		docs[i] = doc
+	}
+
+	_, err := r.coll(ctx).InsertMany(ctx, docs, options.InsertMany().SetOrdered(false))
+	if err != nil {
+		failed, ok := writeErrors(err, nil)
+		if !ok {
+			logging.For(ctx, r.logger, "event_repository", "CreateEvents").WithError(err).Error("operación fallida")
+			return nil, err
+		}
+		logging.For(ctx, r.logger, "event_repository", "CreateEvents").WithError(failed).Error("operación fallida")
+		return created, failed
+	}
+
+	logging.For(ctx, r.logger, "event_repository", "CreateEvents").WithField("count", len(created)).Debug("eventos guardados")
+	return created, nil
+}
+
+// withObjectID convierte el evento en un documento con el _id indicado. El
+// campo ID del evento es texto, así que no sirve para guardar un ObjectID.
+func withObjectID(event entities.Event, oid primitive.ObjectID) (bson.D, error) {
+	raw, err := bson.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+	var doc bson.D
+	if err := bson.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+	return append(bson.D{{Key: "_id", Value: oid}}, doc...), nil
+}
+
+// writeErrors traduce los errores por documento de una operación por lotes a
+// un BulkError. positions convierte el índice de la operación en la posición
+// del lote; nil indica que coinciden. Devuelve false si el error no es por
+// documento y afecta a todo el lote.
+func writeErrors(err error, positions []int) (BulkError, bool) {
+	var writeErrs mongo.WriteErrors
+	var bulkErr mongo.BulkWriteException
+	switch {
+	case errors.As(err, &bulkErr):
+		if bulkErr.WriteConcernError != nil {
+			return nil, false
+		}
+		for _, e := range bulkErr.WriteErrors {
+			writeErrs = append(writeErrs, e.WriteError)
+		}
+	case !errors.As(err, &writeErrs):
+		return nil, false
+	}
+
+	failed := BulkError{}
+	for _, e := range writeErrs {
+		pos := e.Index
+		if positions != nil {
+			pos = positions[e.Index]
+		}
+		failed[pos] = e
+	}
+	return failed, true
+}
+
 func (r *MongoEventRepository) GetEventByID(ctx context.Context, id string) (entities.Event, error) {
 	var event entities.Event
 	idd, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		r.logger.Errorln("Layer:event_repository ", "Method:GetEventByID ", "Error:", err)
+		logging.For(ctx, r.logger, "event_repository", "GetEventByID").WithError(err).Error("operación fallida")
 		return event, ErrEventNotfound
 	}
 
-	filter := bson.D{{"_id", idd}}
+	filter := bson.D{{Key: "_id", Value: idd}, tenantFilter(ctx), notDeleted}
 	opts := options.FindOne()
-	coll := r.db.Database("events_db").Collection("events")
+	coll := r.coll(ctx)
 
 	err = coll.FindOne(ctx, filter, opts).Decode(&event)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			r.logger.Errorln("Layer:event_repository ", "Method:GetEventByID ", "Error:", ErrEventNotfound)
+			logging.For(ctx, r.logger, "event_repository", "GetEventByID").WithError(ErrEventNotfound).Error("operación fallida")
 			return event, ErrEventNotfound
 		}
-		r.logger.Errorln("Layer:event_repository ", "Method:GetEventByID ", "Error:", err)
+		logging.For(ctx, r.logger, "event_repository", "GetEventByID").WithError(err).Error("operación fallida")
 		return event, err
 	}
-	r.logger.Infoln("Layer:event_repository", "Method:GetEventByID", "event:", event)
+	logging.For(ctx, r.logger, "event_repository", "GetEventByID").WithFields(logging.Event(event)).Debug("evento encontrado")
 	return event, nil
 
 }
 
-func (r *MongoEventRepository) GetAllEvents(ctx context.Context) ([]entities.Event, error) {
-	return r.findEvents(ctx, bson.M{})
-}
-
-func (r *MongoEventRepository) GetEventsByStatus(ctx context.Context, status string) ([]entities.Event, error) {
-	r.logger.Infoln("Layer:event_repository", "Method:GetEventsByStatus", "status:", status)
-	return r.findEvents(ctx, bson.M{"status": status})
-}
-
-func (r *MongoEventRepository) GetEventsByCategory(ctx context.Context, category string) ([]entities.Event, error) {
-	return r.findEvents(ctx, bson.M{
-		"category": category,
-		"status":   "Revisado",
-	})
-}
-
-func (r *MongoEventRepository) GetEventsNeedingAction(ctx context.Context) ([]entities.Event, error) {
-	return r.findEvents(ctx, bson.M{
-		"needs_action": true,
-		"status":       "Revisado",
-	})
-}
-
 func (r *MongoEventRepository) UpdateEvent(ctx context.Context, event entities.Event) (entities.Event, error) {
 	ide := string(event.ID)
 	idd, err := primitive.ObjectIDFromHex(ide)
 	if err != nil {
-		r.logger.Errorln("Layer:event_repository", "Method:UpdateEvent ", "Error:", err)
+		logging.For(ctx, r.logger, "event_repository", "UpdateEvent").WithError(err).Error("operación fallida")
 		return entities.Event{}, err
 	}
 
-	coll := r.db.Database("events_db").Collection("events")
+	coll := r.coll(ctx)
 
-	filter := bson.D{{"_id", idd}}
-	update := bson.M{
+	filter := bson.D{{Key: "_id", Value: idd}, tenantFilter(ctx), notDeleted, versionFilter(event.Version)}
+	res, err := coll.UpdateOne(ctx, filter, eventUpdate(event))
+	if err != nil {
+		logging.For(ctx, r.logger, "event_repository", "UpdateEvent").WithError(err).Error("operación fallida")
+		return entities.Event{}, err
+	}
+
+	if res.MatchedCount == 0 {
+		if _, err := r.GetEventByID(ctx, event.ID); err == nil {
+			logging.For(ctx, r.logger, "event_repository", "UpdateEvent").WithError(ErrVersionConflict).Error("operación fallida")
+			return entities.Event{}, ErrVersionConflict
+		}
+		logging.For(ctx, r.logger, "event_repository", "UpdateEvent").WithError(ErrEventNotfound).Error("operación fallida")
+		return entities.Event{}, ErrEventNotfound
+	}
+
+	event.TenantID = tenant.FromContext(ctx)
+	event.Version++
+	logging.For(ctx, r.logger, "event_repository", "UpdateEvent").WithFields(logging.Event(event)).Debug("evento actualizado")
+	return event, err
+}
+
+// eventUpdate es la actualización que guarda los campos modificables del
+// evento e incrementa su versión.
+func eventUpdate(event entities.Event) bson.M {
+	return bson.M{
+		"$inc": bson.M{"version": 1},
 		"$set": bson.M{
-			"name":        event.Name,
-			"type":        event.Type,
-			"description": event.Description,
-			"date":        event.Date,
-			"status":      event.Status,
+			"name":           event.Name,
+			"type":           event.Type,
+			"description":    event.Description,
+			"date":           event.Date,
+			"status":         event.Status,
+			"category":       event.Category,
+			"needs_action":   event.NeedsAction,
+			"status_history": event.StatusHistory,
 		},
 	}
+}
 
-	_, err = coll.UpdateOne(ctx, filter, update)
+func (r *MongoEventRepository) UpdateEvents(ctx context.Context, events []entities.Event) ([]entities.Event, error) {
+	if len(events) == 0 {
+		return nil, nil
+	}
+
+	failed := BulkError{}
+	models := make([]mongo.WriteModel, 0, len(events))
+	positions := make([]int, 0, len(events))
+	oids := make([]primitive.ObjectID, 0, len(events))
+	for i, event := range events {
+		oid, err := primitive.ObjectIDFromHex(event.ID)
+		if err != nil {
+			failed[i] = err
+			continue
+		}
+		filter := bson.D{{Key: "_id", Value: oid}, tenantFilter(ctx), notDeleted, versionFilter(event.Version)}
+		models = append(models, mongo.NewUpdateOneModel().SetFilter(filter).SetUpdate(eventUpdate(event)))
+		positions = append(positions, i)
+		oids = append(oids, oid)
+	}
+
+	if len(models) > 0 {
+		// written cuenta los modelos sin error de escritura; los ids inválidos
+		// nunca llegaron a models.
+		written := len(models)
+		res, err := r.coll(ctx).BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+		if err != nil {
+			writeFailed, ok := writeErrors(err, positions)
+			if !ok {
+				logging.For(ctx, r.logger, "event_repository", "UpdateEvents").WithError(err).Error("operación fallida")
+				return nil, err
+			}
+			for pos, e := range writeFailed {
+				failed[pos] = e
+			}
+			written -= len(writeFailed)
+		}
+		if res == nil || int(res.MatchedCount) < written {
+			if err := r.unmatched(ctx, events, oids, positions, failed); err != nil {
+				logging.For(ctx, r.logger, "event_repository", "UpdateEvents").WithError(err).Error("operación fallida")
+				return nil, err
+			}
+		}
+	}
+
+	tenantID := tenant.FromContext(ctx)
+	updated := make([]entities.Event, len(events))
+	for i, event := range events {
+		if _, ok := failed[i]; !ok {
+			event.TenantID = tenantID
+			event.Version++
+		}
+		updated[i] = event
+	}
+
+	if len(failed) > 0 {
+		logging.For(ctx, r.logger, "event_repository", "UpdateEvents").WithError(failed).Error("operación fallida")
+		return updated, failed
+	}
+	logging.For(ctx, r.logger, "event_repository", "UpdateEvents").WithField("count", len(updated)).Debug("eventos actualizados")
+	return updated, nil
+}
+
+// unmatched completa failed con los elementos del lote que BulkWrite no llegó
+// a actualizar. BulkWrite solo informa el total de coincidencias, así que se
+// vuelven a leer los eventos: los que faltan no existen y los que no quedaron
+// en la versión siguiente a la esperada tenían otra versión.
+func (r *MongoEventRepository) unmatched(ctx context.Context, events []entities.Event, oids []primitive.ObjectID, positions []int, failed BulkError) error {
+	filter := bson.D{{Key: "_id", Value: bson.M{"$in": oids}}, tenantFilter(ctx), notDeleted}
+	cursor, err := r.coll(ctx).Find(ctx, filter, options.Find().SetProjection(bson.M{"version": 1}))
 	if err != nil {
-		r.logger.Errorln("Layer:event_repository ", "Method:UpdateEvent ", "Error:", err)
-		return entities.Event{}, err
+		return err
+	}
+	var stored []entities.Event
+	if err := cursor.All(ctx, &stored); err != nil {
+		return err
 	}
 
-	r.logger.Infoln("Layer:event_repository ", "Method:UpdateEvent ", "Evento Actualizado:", event)
-	return event, err
+	versions := make(map[string]int64, len(stored))
+	for _, event := range stored {
+		versions[event.ID] = event.Version
+	}
+	for _, pos := range positions {
+		if _, ok := failed[pos]; ok {
+			continue
+		}
+		version, ok := versions[events[pos].ID]
+		switch {
+		case !ok:
+			failed[pos] = ErrEventNotfound
+		case version != events[pos].Version+1:
+			failed[pos] = ErrVersionConflict
+		}
+	}
+	return nil
 }
 
-func (r *MongoEventRepository) DeleteEvent(ctx context.Context, id string) error {
-	coll := r.db.Database("events_db").Collection("events")
+func (r *MongoEventRepository) DeleteEvent(ctx context.Context, id string, deletedAt time.Time) error {
+	coll := r.coll(ctx)
 	idd, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		r.logger.Errorln("Layer:event_repository ", "Method: DeleteEvent ", "Error:", err)
+		logging.For(ctx, r.logger, "event_repository", "DeleteEvent").WithError(err).Error("operación fallida")
 		return err
 	}
 
-	filter := bson.D{{"_id", idd}}
-	res, err := coll.DeleteOne(ctx, filter)
+	filter := bson.D{{Key: "_id", Value: idd}, tenantFilter(ctx), notDeleted}
+	update := bson.M{"$set": bson.M{"deleted_at": deletedAt}, "$inc": bson.M{"version": 1}}
+	res, err := coll.UpdateOne(ctx, filter, update)
 	if err != nil {
-		r.logger.Errorln("Layer:event_repository ", "Method: DeleteEvent ", "Error:", err)
+		logging.For(ctx, r.logger, "event_repository", "DeleteEvent").WithError(err).Error("operación fallida")
+		return err
+	}
 
+	if res.MatchedCount == 0 {
+		logging.For(ctx, r.logger, "event_repository", "DeleteEvent").WithField(logging.FieldEventID, id).Warn("no se eliminó ningún evento")
 		return ErrEventNotfound
 	}
+	logging.For(ctx, r.logger, "event_repository", "DeleteEvent").WithField(logging.FieldEventID, id).Debug("evento eliminado")
+	return nil
+}
+
+func (r *MongoEventRepository) RestoreEvent(ctx context.Context, id string) (entities.Event, error) {
+	idd, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		logging.For(ctx, r.logger, "event_repository", "RestoreEvent").WithError(err).Error("operación fallida")
+		return entities.Event{}, ErrEventNotfound
+	}
 
-	if res.DeletedCount == 0 {
-		r.logger.Errorln("Layer:event_repository ", "Method: DeleteEvent ", "Error: No tasks were deleted")
-		return ErrNotasks
+	filter := bson.D{{Key: "_id", Value: idd}, tenantFilter(ctx), {Key: "deleted_at", Value: bson.M{"$ne": nil}}}
+	update := bson.M{"$unset": bson.M{"deleted_at": ""}, "$inc": bson.M{"version": 1}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.Before)
+
+	var event entities.Event
+	err = r.coll(ctx).FindOneAndUpdate(ctx, filter, update, opts).Decode(&event)
+	if err == mongo.ErrNoDocuments {
+		if _, err := r.GetEventByID(ctx, id); err == nil {
+			logging.For(ctx, r.logger, "event_repository", "RestoreEvent").WithError(ErrNotDeleted).Error("operación fallida")
+			return entities.Event{}, ErrNotDeleted
+		}
+		logging.For(ctx, r.logger, "event_repository", "RestoreEvent").WithError(ErrEventNotfound).Error("operación fallida")
+		return entities.Event{}, ErrEventNotfound
 	}
-	r.logger.Infoln("Layer:event_repository ", "Method: DeleteEvent ", "Event:", idd)
-	return err
+	if err != nil {
+		logging.For(ctx, r.logger, "event_repository", "RestoreEvent").WithError(err).Error("operación fallida")
+		return entities.Event{}, err
+	}
+	logging.For(ctx, r.logger, "event_repository", "RestoreEvent").WithField(logging.FieldEventID, id).Debug("evento restaurado")
+	return event, nil
 }
 
-func (r *MongoEventRepository) findEvents(ctx context.Context, filter bson.M) ([]entities.Event, error) {
-	coll := r.db.Database("events_db").Collection("events")
-	cursor, err := coll.Find(ctx, filter, options.Find().SetSort(bson.M{"date": -1}))
+func (r *MongoEventRepository) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	databases, err := r.tenancy.databases(ctx, r.db, r.database)
 	if err != nil {
+		logging.For(ctx, r.logger, "event_repository", "PurgeDeleted").WithError(err).Error("operación fallida")
+		return 0, err
+	}
+
+	var purged int64
+	filter := bson.D{{Key: "deleted_at", Value: bson.M{"$lt": before}}}
+	for _, database := range databases {
+		res, err := r.db.Database(database).Collection(r.collection).DeleteMany(ctx, filter)
+		if err != nil {
+			logging.For(ctx, r.logger, "event_repository", "PurgeDeleted").WithError(err).Error("operación fallida")
+			return purged, err
+		}
+		purged += res.DeletedCount
+	}
+	logging.For(ctx, r.logger, "event_repository", "PurgeDeleted").WithField("count", purged).Info("eventos purgados")
+	return purged, nil
+}
+
+func (r *MongoEventRepository) CountEvents(ctx context.Context) ([]entities.EventCount, error) {
+	databases, err := r.tenancy.databases(ctx, r.db, r.database)
+	if err != nil {
+		logging.For(ctx, r.logger, "event_repository", "CountEvents").WithError(err).Error("operación fallida")
 		return nil, err
 	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{notDeleted}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{
+				{Key: "status", Value: "$status"},
+				{Key: "category", Value: "$category"},
+				{Key: "needs_action", Value: "$needs_action"},
+			}},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+	}
+
+	// Con una base por tenant se suman los grupos iguales de todas las bases.
+	totals := map[entities.EventCount]int64{}
+	for _, database := range databases {
+		cursor, err := r.db.Database(database).Collection(r.collection).Aggregate(ctx, pipeline)
+		if err != nil {
+			logging.For(ctx, r.logger, "event_repository", "CountEvents").WithError(err).Error("operación fallida")
+			return nil, err
+		}
+		var groups []struct {
+			ID    entities.EventCount `bson:"_id"`
+			Count int64               `bson:"count"`
+		}
+		if err := cursor.All(ctx, &groups); err != nil {
+			logging.For(ctx, r.logger, "event_repository", "CountEvents").WithError(err).Error("operación fallida")
+			return nil, err
+		}
+		for _, g := range groups {
+			totals[g.ID] += g.Count
+		}
+	}
+	return eventCounts(totals), nil
+}
+
+// ListEvents devuelve una página de eventos que cumplen query, ordenados por
+// (campo, _id). Se pide un documento extra para saber si hay página siguiente.
+func (r *MongoEventRepository) ListEvents(ctx context.Context, query entities.EventQuery) (entities.EventPage, error) {
+	limit := pageLimit(query.Page)
+	field, desc := query.Sort()
+	dir := 1
+	cmp := "$gt"
+	if desc {
+		dir = -1
+		cmp = "$lt"
+	}
+
+	filter := append(queryFilter(query), tenantFilter(ctx))
+	if query.Page.Cursor != "" {
+		after, err := decodeCursor(query)
+		if err != nil {
+			logging.For(ctx, r.logger, "event_repository", "ListEvents").WithError(err).Error("operación fallida")
+			return entities.EventPage{}, err
+		}
+		afterID, _ := primitive.ObjectIDFromHex(after.ID)
+		var value interface{} = after.Value
+		if field == entities.SortByDate {
+			value = after.Date
+		}
+		filter = append(filter, bson.E{Key: "$or", Value: bson.A{
+			bson.M{field: bson.M{cmp: value}},
+			bson.M{field: value, "_id": bson.M{cmp: afterID}},
+		}})
+	}
+
+	coll := r.coll(ctx)
+	opts := options.Find().
+		SetSort(bson.D{{Key: field, Value: dir}, {Key: "_id", Value: dir}}).
+		SetLimit(int64(limit + 1))
+	cursor, err := coll.Find(ctx, filter, opts)
+	if err != nil {
+		logging.For(ctx, r.logger, "event_repository", "ListEvents").WithError(err).Error("operación fallida")
+		return entities.EventPage{}, err
+	}
 	defer cursor.Close(ctx)
 
-	var events []entities.Event
+	events := make([]entities.Event, 0, limit+1)
 	for cursor.Next(ctx) {
 		var event entities.Event
 		if err := cursor.Decode(&event); err != nil {
-			r.logger.Errorln("Layer:event_repository ", "Method:findEvents ", "Error:", err)
-			return nil, err
+			logging.For(ctx, r.logger, "event_repository", "ListEvents").WithError(err).Error("operación fallida")
+			return entities.EventPage{}, err
 		}
 		events = append(events, event)
 	}
 	if err := cursor.Err(); err != nil {
-		r.logger.Errorln("Layer:event_repository ", "Method:findEvents ", "Error:", err)
+		logging.For(ctx, r.logger, "event_repository", "ListEvents").WithError(err).Error("operación fallida")
+		return entities.EventPage{}, err
+	}
+	page := newEventPage(events, query)
+	logging.For(ctx, r.logger, "event_repository", "ListEvents").WithField("count", len(page.Events)).Debug("eventos encontrados")
+	return page, nil
+}
+
+// textIndexName es el índice de texto que usa SearchEvents.
+const textIndexName = "events_text"
+
+// indexNotFound es el código con el que MongoDB rechaza un $text sin índice.
+const indexNotFound = 27
+
+// EnsureIndexes crea el índice de texto de SearchEvents en las bases de todos
+// los tenants. Con una base por tenant, las bases que se crean después lo
+// reciben en su primera búsqueda.
+func (r *MongoEventRepository) EnsureIndexes(ctx context.Context) error {
+	databases, err := r.tenancy.databases(ctx, r.db, r.database)
+	if err != nil {
+		logging.For(ctx, r.logger, "event_repository", "EnsureIndexes").WithError(err).Error("operación fallida")
+		return err
+	}
+	for _, database := range databases {
+		if err := r.ensureTextIndex(ctx, r.db.Database(database).Collection(r.collection)); err != nil {
+			logging.For(ctx, r.logger, "event_repository", "EnsureIndexes").WithError(err).Error("operación fallida")
+			return err
+		}
+	}
+	return nil
+}
+
+// ensureTextIndex crea el índice de texto sobre el nombre y la descripción.
+// Las palabras se reducen con las reglas del español, sin tildes ni
+// mayúsculas. Crear un índice que ya existe no hace nada.
+func (r *MongoEventRepository) ensureTextIndex(ctx context.Context, coll *mongo.Collection) error {
+	weights := bson.D{}
+	keys := bson.D{}
+	for _, field := range []string{entities.SearchFieldName, entities.SearchFieldDescription} {
+		keys = append(keys, bson.E{Key: field, Value: "text"})
+		weights = append(weights, bson.E{Key: field, Value: searchWeights[field]})
+	}
+	_, err := coll.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: keys,
+		Options: options.Index().
+			SetName(textIndexName).
+			SetWeights(weights).
+			SetDefaultLanguage("spanish"),
+	})
+	return err
+}
+
+// SearchEvents ordena por el puntaje del índice de texto y luego por _id, y
+// pagina con un cursor sobre ese orden. El puntaje no se puede filtrar en un
+// find, así que la consulta es una agregación.
+func (r *MongoEventRepository) SearchEvents(ctx context.Context, query entities.SearchQuery) (entities.SearchPage, error) {
+	hits, err := r.search(ctx, query)
+	var serverErr mongo.ServerError
+	if errors.As(err, &serverErr) && serverErr.HasErrorCode(indexNotFound) {
+		if err = r.ensureTextIndex(ctx, r.coll(ctx)); err == nil {
+			hits, err = r.search(ctx, query)
+		}
+	}
+	if err != nil {
+		logging.For(ctx, r.logger, "event_repository", "SearchEvents").WithError(err).Error("operación fallida")
+		return entities.SearchPage{}, err
+	}
+	page := newSearchPage(hits, query)
+	logging.For(ctx, r.logger, "event_repository", "SearchEvents").WithField("count", len(page.Hits)).Debug("eventos encontrados")
+	return page, nil
+}
+
+// search pide hasta limit+1 resultados después del cursor de query.
+func (r *MongoEventRepository) search(ctx context.Context, query entities.SearchQuery) ([]entities.SearchHit, error) {
+	// $text tiene que ir en la primera etapa de la agregación.
+	match := append(bson.D{{Key: "$text", Value: bson.M{"$search": query.Text}}}, queryFilter(query.Filters())...)
+	match = append(match, tenantFilter(ctx))
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$addFields", Value: bson.M{"_score": bson.M{"$meta": "textScore"}}}},
+	}
+	if query.Page.Cursor != "" {
+		after, err := decodeSearchCursor(query)
+		if err != nil {
+			return nil, err
+		}
+		afterID, _ := primitive.ObjectIDFromHex(after.ID)
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.M{"$or": bson.A{
+			bson.M{"_score": bson.M{"$lt": after.Score}},
+			bson.M{"_score": after.Score, "_id": bson.M{"$lt": afterID}},
+		}}}})
+	}
+	pipeline = append(pipeline,
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "_score", Value: -1}, {Key: "_id", Value: -1}}}},
+		bson.D{{Key: "$limit", Value: pageLimit(query.Page) + 1}},
+	)
+
+	cursor, err := r.coll(ctx).Aggregate(ctx, pipeline)
+	if err != nil {
 		return nil, err
 	}
-	r.logger.Infoln("Layer:event_repository", "Method:findEvents", "eventos econtrados correctamente")
-	return events, nil
+	var docs []struct {
+		entities.Event `bson:",inline"`
+		Score          float64 `bson:"_score"`
+	}
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	hits := make([]entities.SearchHit, len(docs))
+	for i, doc := range docs {
+		hits[i] = entities.SearchHit{Event: doc.Event, Score: doc.Score}
+	}
+	return hits, nil
+}
+
+// queryFilter traduce los filtros de query a un filtro de MongoDB.
+func queryFilter(query entities.EventQuery) bson.D {
+	filter := bson.D{}
+	if !query.IncludeDeleted {
+		filter = append(filter, notDeleted)
+	}
+	if query.Status != "" {
+		filter = append(filter, bson.E{Key: "status", Value: query.Status})
+	}
+	if query.Category != "" {
+		filter = append(filter, bson.E{Key: "category", Value: query.Category})
+	}
+	if query.Type != "" {
+		filter = append(filter, bson.E{Key: "type", Value: query.Type})
+	}
+	if query.NeedsAction != nil {
+		if *query.NeedsAction {
+			filter = append(filter, bson.E{Key: "needs_action", Value: true})
+		} else {
+			// needs_action se omite al guardar cuando es false.
+			filter = append(filter, bson.E{Key: "needs_action", Value: bson.M{"$ne": true}})
+		}
+	}
+	if !query.From.IsZero() || !query.To.IsZero() {
+		date := bson.M{}
+		if !query.From.IsZero() {
+			date["$gte"] = query.From
+		}
+		if !query.To.IsZero() {
+			date["$lt"] = query.To
+		}
+		filter = append(filter, bson.E{Key: "date", Value: date})
+	}
+	if query.Name != "" {
+		filter = append(filter, bson.E{Key: "name", Value: containsRegex(query.Name)})
+	}
+	if query.Description != "" {
+		filter = append(filter, bson.E{Key: "description", Value: containsRegex(query.Description)})
+	}
+	return filter
+}
+
+func containsRegex(text string) primitive.Regex {
+	return primitive.Regex{Pattern: regexp.QuoteMeta(text), Options: "i"}
+}
+
+// eventCounts arma el resultado de CountEvents a partir de los totales por
+// grupo, cuyas claves tienen Count en cero.
+func eventCounts(totals map[entities.EventCount]int64) []entities.EventCount {
+	counts := make([]entities.EventCount, 0, len(totals))
+	for group, n := range totals {
+		group.Count = n
+		counts = append(counts, group)
+	}
+	return counts
 }
@@ -0,0 +1,663 @@
+package repository
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/tenant"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// runEventRepositoryConformance ejecuta el mismo contrato contra cualquier
+// implementación de EventRepository. newRepo debe devolver un repositorio vacío.
+func runEventRepositoryConformance(t *testing.T, newRepo func(t *testing.T) EventRepository) {
+	ctx := context.Background()
+
+	seed := func(t *testing.T, repo EventRepository, event entities.Event, date time.Time) entities.Event {
+		created, err := repo.CreateEvent(ctx, event)
+		require.NoError(t, err)
+		created.Date = date
+		updated, err := repo.UpdateEvent(ctx, created)
+		require.NoError(t, err)
+		return updated
+	}
+
+	ids := func(events []entities.Event) []string {
+		out := make([]string, 0, len(events))
+		for _, e := range events {
+			out = append(out, e.ID)
+		}
+		return out
+	}
+
+	needingAction := func(page entities.PageRequest) entities.EventQuery {
+		needs := true
+		return entities.EventQuery{NeedsAction: &needs, Status: "Revisado", Page: page}
+	}
+
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("CreateEvent assigns an id and date", func(t *testing.T) {
+		repo := newRepo(t)
+
+		created, err := repo.CreateEvent(ctx, entities.Event{
+			Name:        "Caída de VPN",
+			Type:        "Incidente",
+			Description: "La VPN no responde",
+			Status:      "Pendiente por revisar",
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, created.ID)
+		assert.False(t, created.Date.IsZero())
+
+		found, err := repo.GetEventByID(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, created.ID, found.ID)
+		assert.Equal(t, "Caída de VPN", found.Name)
+		assert.Equal(t, entities.StatusPending, found.Status)
+	})
+
+	t.Run("GetEventByID returns ErrEventNotfound", func(t *testing.T) {
+		repo := newRepo(t)
+
+		_, err := repo.GetEventByID(ctx, primitive.NewObjectID().Hex())
+		assert.Equal(t, ErrEventNotfound, err)
+
+		_, err = repo.GetEventByID(ctx, "not-an-id")
+		assert.Equal(t, ErrEventNotfound, err)
+	})
+
+	t.Run("UpdateEvent persists classification fields", func(t *testing.T) {
+		repo := newRepo(t)
+		created := seed(t, repo, entities.Event{Name: "a", Type: "Incidente", Description: "d", Status: "Revisado"}, base)
+
+		created.Category = "Requiere gestión"
+		created.NeedsAction = true
+		_, err := repo.UpdateEvent(ctx, created)
+		require.NoError(t, err)
+
+		found, err := repo.GetEventByID(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, entities.CategoryNeedsAction, found.Category)
+		assert.True(t, found.NeedsAction)
+		assert.True(t, base.Equal(found.Date))
+	})
+
+	t.Run("UpdateEvent persists the status history", func(t *testing.T) {
+		repo := newRepo(t)
+		created := seed(t, repo, entities.Event{Name: "a", Type: "Incidente", Description: "d", Status: entities.StatusPending}, base)
+
+		created.Status = entities.StatusInReview
+		created.StatusHistory = []entities.StatusTransition{
+			{From: entities.StatusPending, To: entities.StatusInReview, Actor: "ana", Reason: "triage", At: base.Add(time.Hour)},
+		}
+		_, err := repo.UpdateEvent(ctx, created)
+		require.NoError(t, err)
+
+		found, err := repo.GetEventByID(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, entities.StatusInReview, found.Status)
+		require.Len(t, found.StatusHistory, 1)
+		assert.Equal(t, "ana", found.StatusHistory[0].Actor)
+		assert.Equal(t, "triage", found.StatusHistory[0].Reason)
+		assert.True(t, base.Add(time.Hour).Equal(found.StatusHistory[0].At))
+	})
+
+	t.Run("UpdateEvent checks and increments the version", func(t *testing.T) {
+		repo := newRepo(t)
+		created, err := repo.CreateEvent(ctx, entities.Event{Name: "a", Type: "Incidente", Description: "d", Status: "Revisado"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), created.Version)
+
+		stale := created
+		created.Name = "b"
+		updated, err := repo.UpdateEvent(ctx, created)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), updated.Version)
+
+		stale.Name = "c"
+		_, err = repo.UpdateEvent(ctx, stale)
+		assert.Equal(t, ErrVersionConflict, err)
+
+		found, err := repo.GetEventByID(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "b", found.Name)
+		assert.Equal(t, int64(2), found.Version)
+
+		require.NoError(t, repo.DeleteEvent(ctx, created.ID, base))
+		restored, err := repo.RestoreEvent(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), restored.Version)
+		found, err = repo.GetEventByID(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), found.Version)
+	})
+
+	t.Run("UpdateEvent on a missing event", func(t *testing.T) {
+		repo := newRepo(t)
+
+		_, err := repo.UpdateEvent(ctx, entities.Event{ID: primitive.NewObjectID().Hex(), Name: "x"})
+		assert.Equal(t, ErrEventNotfound, err)
+
+		_, err = repo.UpdateEvent(ctx, entities.Event{ID: "not-an-id", Name: "x"})
+		assert.Error(t, err)
+	})
+
+	t.Run("CreateEvents saves the batch in order", func(t *testing.T) {
+		repo := newRepo(t)
+
+		created, err := repo.CreateEvents(ctx, []entities.Event{
+			{Name: "a", Type: "Incidente", Description: "d", Status: "Pendiente por revisar"},
+			{Name: "b", Type: "Reunión", Description: "d", Status: "Revisado"},
+		})
+		require.NoError(t, err)
+		require.Len(t, created, 2)
+		assert.NotEqual(t, created[0].ID, created[1].ID)
+
+		for i, name := range []string{"a", "b"} {
+			assert.Equal(t, int64(1), created[i].Version)
+			assert.False(t, created[i].Date.IsZero())
+			found, err := repo.GetEventByID(ctx, created[i].ID)
+			require.NoError(t, err)
+			assert.Equal(t, name, found.Name)
+		}
+	})
+
+	t.Run("UpdateEvents reports failures per item", func(t *testing.T) {
+		repo := newRepo(t)
+		created, err := repo.CreateEvents(ctx, []entities.Event{
+			{Name: "a", Type: "Incidente", Description: "d", Status: "Revisado"},
+			{Name: "b", Type: "Incidente", Description: "d", Status: "Revisado"},
+		})
+		require.NoError(t, err)
+
+		ok := created[0]
+		ok.Category = "Requiere gestión"
+		stale := created[1]
+		stale.Version = 5
+		missing := entities.Event{ID: primitive.NewObjectID().Hex(), Name: "x", Version: 1}
+
+		updated, err := repo.UpdateEvents(ctx, []entities.Event{ok, stale, missing, {ID: "not-an-id"}})
+		var failed BulkError
+		require.ErrorAs(t, err, &failed)
+		assert.Len(t, failed, 3)
+		assert.Equal(t, ErrVersionConflict, failed[1])
+		assert.Equal(t, ErrEventNotfound, failed[2])
+		assert.Error(t, failed[3])
+
+		require.Len(t, updated, 4)
+		assert.Equal(t, int64(2), updated[0].Version)
+		found, err := repo.GetEventByID(ctx, ok.ID)
+		require.NoError(t, err)
+		assert.Equal(t, entities.CategoryNeedsAction, found.Category)
+		assert.Equal(t, int64(2), found.Version)
+
+		found, err = repo.GetEventByID(ctx, stale.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), found.Version)
+	})
+
+	t.Run("UpdateEvents detects a stale item next to an invalid id", func(t *testing.T) {
+		repo := newRepo(t)
+		created, err := repo.CreateEvents(ctx, []entities.Event{
+			{Name: "a", Type: "Incidente", Description: "d", Status: "Revisado"},
+			{Name: "b", Type: "Incidente", Description: "d", Status: "Revisado"},
+		})
+		require.NoError(t, err)
+
+		ok := created[0]
+		stale := created[1]
+		stale.Version = 5
+
+		updated, err := repo.UpdateEvents(ctx, []entities.Event{{ID: "not-an-id"}, ok, stale})
+		var failed BulkError
+		require.ErrorAs(t, err, &failed)
+		assert.Len(t, failed, 2)
+		assert.Error(t, failed[0])
+		assert.Equal(t, ErrVersionConflict, failed[2])
+
+		require.Len(t, updated, 3)
+		assert.Equal(t, int64(2), updated[1].Version)
+		assert.Equal(t, int64(5), updated[2].Version)
+		found, err := repo.GetEventByID(ctx, stale.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), found.Version)
+	})
+
+	t.Run("DeleteEvent", func(t *testing.T) {
+		repo := newRepo(t)
+		created := seed(t, repo, entities.Event{Name: "a", Type: "Reunión", Description: "d", Status: "Revisado"}, base)
+
+		require.NoError(t, repo.DeleteEvent(ctx, created.ID, base))
+
+		_, err := repo.GetEventByID(ctx, created.ID)
+		assert.Equal(t, ErrEventNotfound, err)
+
+		_, err = repo.UpdateEvent(ctx, created)
+		assert.Equal(t, ErrEventNotfound, err)
+
+		assert.Equal(t, ErrNotasks, repo.DeleteEvent(ctx, created.ID, base))
+		assert.Equal(t, ErrNotasks, repo.DeleteEvent(ctx, primitive.NewObjectID().Hex(), base))
+		assert.Error(t, repo.DeleteEvent(ctx, "not-an-id", base))
+	})
+
+	t.Run("Deleted events are hidden from listings", func(t *testing.T) {
+		repo := newRepo(t)
+		kept := seed(t, repo, entities.Event{Name: "a", Type: "Reunión", Description: "d", Status: "Revisado"}, base)
+		deleted := seed(t, repo, entities.Event{Name: "b", Type: "Reunión", Description: "d", Status: "Revisado"}, base.Add(time.Hour))
+		require.NoError(t, repo.DeleteEvent(ctx, deleted.ID, base.Add(2*time.Hour)))
+
+		page, err := repo.ListEvents(ctx, entities.EventQuery{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{kept.ID}, ids(page.Events))
+
+		page, err = repo.ListEvents(ctx, entities.EventQuery{IncludeDeleted: true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{deleted.ID, kept.ID}, ids(page.Events))
+		require.NotNil(t, page.Events[0].DeletedAt)
+		assert.True(t, base.Add(2*time.Hour).Equal(*page.Events[0].DeletedAt))
+		assert.Nil(t, page.Events[1].DeletedAt)
+	})
+
+	t.Run("RestoreEvent", func(t *testing.T) {
+		repo := newRepo(t)
+		created := seed(t, repo, entities.Event{Name: "a", Type: "Reunión", Description: "d", Status: "Revisado"}, base)
+
+		_, err := repo.RestoreEvent(ctx, created.ID)
+		assert.Equal(t, ErrNotDeleted, err)
+		_, err = repo.RestoreEvent(ctx, primitive.NewObjectID().Hex())
+		assert.Equal(t, ErrEventNotfound, err)
+		_, err = repo.RestoreEvent(ctx, "not-an-id")
+		assert.Equal(t, ErrEventNotfound, err)
+
+		require.NoError(t, repo.DeleteEvent(ctx, created.ID, base))
+		before, err := repo.RestoreEvent(ctx, created.ID)
+		require.NoError(t, err)
+		require.NotNil(t, before.DeletedAt)
+		assert.True(t, base.Equal(*before.DeletedAt))
+
+		found, err := repo.GetEventByID(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Nil(t, found.DeletedAt)
+		assert.Equal(t, "a", found.Name)
+	})
+
+	t.Run("PurgeDeleted removes events deleted before the cutoff", func(t *testing.T) {
+		repo := newRepo(t)
+		old := seed(t, repo, entities.Event{Name: "old", Type: "Reunión", Description: "d", Status: "Revisado"}, base)
+		recent := seed(t, repo, entities.Event{Name: "recent", Type: "Reunión", Description: "d", Status: "Revisado"}, base)
+		alive := seed(t, repo, entities.Event{Name: "alive", Type: "Reunión", Description: "d", Status: "Revisado"}, base)
+		require.NoError(t, repo.DeleteEvent(ctx, old.ID, base))
+		require.NoError(t, repo.DeleteEvent(ctx, recent.ID, base.Add(48*time.Hour)))
+
+		purged, err := repo.PurgeDeleted(ctx, base.Add(24*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), purged)
+
+		page, err := repo.ListEvents(ctx, entities.EventQuery{IncludeDeleted: true, SortBy: entities.SortByName, SortOrder: entities.SortAsc})
+		require.NoError(t, err)
+		assert.Equal(t, []string{alive.ID, recent.ID}, ids(page.Events))
+
+		_, err = repo.RestoreEvent(ctx, old.ID)
+		assert.Equal(t, ErrEventNotfound, err)
+	})
+
+	t.Run("Listing filters and sorts by date descending", func(t *testing.T) {
+		repo := newRepo(t)
+
+		pending := seed(t, repo, entities.Event{Name: "pending", Type: "Incidente", Description: "d", Status: "Pendiente por revisar", Category: "Requiere gestión", NeedsAction: true}, base.Add(3*time.Hour))
+		oldNeeds := seed(t, repo, entities.Event{Name: "old", Type: "Incidente", Description: "d", Status: "Revisado", Category: "Requiere gestión", NeedsAction: true}, base)
+		newNeeds := seed(t, repo, entities.Event{Name: "new", Type: "Error", Description: "d", Status: "Revisado", Category: "Requiere gestión", NeedsAction: true}, base.Add(2*time.Hour))
+		noAction := seed(t, repo, entities.Event{Name: "meeting", Type: "Reunión", Description: "d", Status: "Revisado", Category: "Sin gestión"}, base.Add(time.Hour))
+
+		all, err := repo.ListEvents(ctx, entities.EventQuery{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{pending.ID, newNeeds.ID, noAction.ID, oldNeeds.ID}, ids(all.Events))
+
+		reviewed, err := repo.ListEvents(ctx, entities.EventQuery{Status: "Revisado"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{newNeeds.ID, noAction.ID, oldNeeds.ID}, ids(reviewed.Events))
+
+		pendingOnly, err := repo.ListEvents(ctx, entities.EventQuery{Status: "Pendiente por revisar"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{pending.ID}, ids(pendingOnly.Events))
+
+		needsCategory, err := repo.ListEvents(ctx, entities.EventQuery{Category: "Requiere gestión", Status: "Revisado"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{newNeeds.ID, oldNeeds.ID}, ids(needsCategory.Events))
+
+		noCategory, err := repo.ListEvents(ctx, entities.EventQuery{Category: "Sin gestión", Status: "Revisado"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{noAction.ID}, ids(noCategory.Events))
+
+		needing, err := repo.ListEvents(ctx, needingAction(entities.PageRequest{}))
+		require.NoError(t, err)
+		assert.Equal(t, []string{newNeeds.ID, oldNeeds.ID}, ids(needing.Events))
+	})
+
+	t.Run("Listing pages with a cursor", func(t *testing.T) {
+		repo := newRepo(t)
+
+		// Varios eventos comparten fecha para comprobar el desempate por id.
+		for i := 0; i < 5; i++ {
+			date := base.Add(time.Duration(i/2) * time.Hour)
+			seed(t, repo, entities.Event{Name: "n", Type: "Incidente", Description: "d", Status: "Revisado", Category: "Requiere gestión", NeedsAction: true}, date)
+		}
+		seed(t, repo, entities.Event{Name: "other", Type: "Reunión", Description: "d", Status: "Pendiente por revisar"}, base.Add(10*time.Hour))
+
+		all, err := repo.ListEvents(ctx, needingAction(entities.PageRequest{Limit: 100}))
+		require.NoError(t, err)
+		require.Len(t, all.Events, 5)
+		assert.Empty(t, all.NextCursor)
+		want := ids(all.Events)
+
+		var got []string
+		page := entities.PageRequest{Limit: 2}
+		pages := 0
+		for {
+			res, err := repo.ListEvents(ctx, needingAction(page))
+			require.NoError(t, err)
+			got = append(got, ids(res.Events)...)
+			pages++
+			if res.NextCursor == "" {
+				break
+			}
+			assert.Len(t, res.Events, 2)
+			page.Cursor = res.NextCursor
+		}
+		assert.Equal(t, want, got)
+		assert.Equal(t, 3, pages)
+
+		_, err = repo.ListEvents(ctx, entities.EventQuery{Page: entities.PageRequest{Cursor: "not a cursor"}})
+		assert.Equal(t, ErrInvalidCursor, err)
+	})
+
+	t.Run("ListEvents combines filters", func(t *testing.T) {
+		repo := newRepo(t)
+
+		vpn := seed(t, repo, entities.Event{Name: "Caída VPN sede norte", Type: "Incidente", Description: "Sin acceso remoto", Status: "Revisado", Category: "Requiere gestión", NeedsAction: true}, base)
+		vpnOld := seed(t, repo, entities.Event{Name: "Lentitud vpn", Type: "Problema", Description: "Latencia alta", Status: "Revisado", Category: "Requiere gestión", NeedsAction: true}, base.Add(-48*time.Hour))
+		meeting := seed(t, repo, entities.Event{Name: "Reunión semanal", Type: "Reunión", Description: "Revisar VPN y correo", Status: "Revisado", Category: "Sin gestión"}, base.Add(time.Hour))
+		pending := seed(t, repo, entities.Event{Name: "Alerta de disco", Type: "Incidente", Description: "Disco al 90%", Status: "Pendiente por revisar"}, base.Add(2*time.Hour))
+
+		list := func(q entities.EventQuery) []string {
+			t.Helper()
+			res, err := repo.ListEvents(ctx, q)
+			require.NoError(t, err)
+			return ids(res.Events)
+		}
+		no := false
+
+		assert.Equal(t, []string{pending.ID, vpn.ID}, list(entities.EventQuery{Type: "Incidente"}))
+		assert.Equal(t, []string{vpn.ID, vpnOld.ID}, list(entities.EventQuery{Name: "VPN"}))
+		assert.Equal(t, []string{meeting.ID}, list(entities.EventQuery{Description: "vpn"}))
+		assert.Equal(t, []string{pending.ID, meeting.ID}, list(entities.EventQuery{NeedsAction: &no}))
+		assert.Equal(t, []string{meeting.ID, vpn.ID}, list(entities.EventQuery{From: base, To: base.Add(2 * time.Hour)}))
+		assert.Equal(t, []string{vpn.ID}, list(entities.EventQuery{Name: "vpn", Type: "Incidente", Category: "Requiere gestión", From: base.Add(-time.Hour)}))
+		assert.Empty(t, list(entities.EventQuery{Name: "vpn", Status: "Pendiente por revisar"}))
+		assert.Empty(t, list(entities.EventQuery{Name: ".*"}))
+
+		assert.Equal(t, []string{vpnOld.ID, vpn.ID, meeting.ID, pending.ID}, list(entities.EventQuery{SortOrder: entities.SortAsc}))
+		assert.Equal(t, []string{pending.ID, vpn.ID, vpnOld.ID, meeting.ID}, list(entities.EventQuery{SortBy: entities.SortByName, SortOrder: entities.SortAsc}))
+	})
+
+	t.Run("ListEvents pages with a non-date sort", func(t *testing.T) {
+		repo := newRepo(t)
+
+		for _, name := range []string{"b", "a", "c", "a", "b"} {
+			seed(t, repo, entities.Event{Name: name, Type: "Incidente", Description: "d", Status: "Revisado"}, base)
+		}
+		query := entities.EventQuery{SortBy: entities.SortByName, SortOrder: entities.SortAsc, Page: entities.PageRequest{Limit: 2}}
+
+		var names []string
+		for {
+			res, err := repo.ListEvents(ctx, query)
+			require.NoError(t, err)
+			for _, e := range res.Events {
+				names = append(names, e.Name)
+			}
+			if res.NextCursor == "" {
+				break
+			}
+			query.Page.Cursor = res.NextCursor
+		}
+		assert.Equal(t, []string{"a", "a", "b", "b", "c"}, names)
+
+		// Un cursor emitido para otro orden se rechaza.
+		query.SortOrder = entities.SortDesc
+		_, err := repo.ListEvents(ctx, query)
+		assert.Equal(t, ErrInvalidCursor, err)
+	})
+
+	hitIDs := func(hits []entities.SearchHit) []string {
+		out := make([]string, 0, len(hits))
+		for _, h := range hits {
+			out = append(out, h.Event.ID)
+		}
+		return out
+	}
+
+	t.Run("SearchEvents ranks by relevance and combines filters", func(t *testing.T) {
+		repo := newRepo(t)
+
+		inName := seed(t, repo, entities.Event{Name: "Caída de VPN", Type: "Incidente", Description: "Sin acceso remoto", Status: "Revisado", Category: "Requiere gestión", NeedsAction: true}, base)
+		inDescription := seed(t, repo, entities.Event{Name: "Reunión semanal", Type: "Reunión", Description: "Revisar la VPN y el correo", Status: "Revisado", Category: "Sin gestión"}, base.Add(time.Hour))
+		pending := seed(t, repo, entities.Event{Name: "VPN lenta", Type: "Incidente", Description: "Latencia alta", Status: "Pendiente por revisar"}, base.Add(2*time.Hour))
+		seed(t, repo, entities.Event{Name: "Alerta de disco", Type: "Incidente", Description: "Disco lleno", Status: "Revisado"}, base)
+		deleted := seed(t, repo, entities.Event{Name: "VPN antigua", Type: "Incidente", Description: "d", Status: "Revisado"}, base)
+		require.NoError(t, repo.DeleteEvent(ctx, deleted.ID, time.Now()))
+		_, err := repo.CreateEvent(tenant.WithID(ctx, "acme"), entities.Event{Name: "VPN de acme", Type: "Incidente", Description: "d", Status: "Revisado"})
+		require.NoError(t, err)
+
+		all, err := repo.SearchEvents(ctx, entities.SearchQuery{Text: "vpn"})
+		require.NoError(t, err)
+		require.Len(t, all.Hits, 3)
+		assert.ElementsMatch(t, []string{inName.ID, pending.ID}, hitIDs(all.Hits[:2]), "las coincidencias en el nombre pesan más")
+		assert.Equal(t, inDescription.ID, all.Hits[2].Event.ID)
+		assert.Greater(t, all.Hits[1].Score, all.Hits[2].Score)
+		assert.Empty(t, all.NextCursor)
+
+		for _, hit := range all.Hits {
+			switch hit.Event.ID {
+			case inName.ID:
+				assert.Equal(t, map[string]string{entities.SearchFieldName: "Caída de <em>VPN</em>"}, hit.Highlights)
+			case inDescription.ID:
+				assert.Equal(t, map[string]string{entities.SearchFieldDescription: "Revisar la <em>VPN</em> y el correo"}, hit.Highlights)
+			}
+		}
+
+		reviewed, err := repo.SearchEvents(ctx, entities.SearchQuery{Text: "VPN", Status: "Revisado"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{inName.ID, inDescription.ID}, hitIDs(reviewed.Hits))
+
+		category, err := repo.SearchEvents(ctx, entities.SearchQuery{Text: "VPN", Category: "Sin gestión"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{inDescription.ID}, hitIDs(category.Hits))
+
+		recent, err := repo.SearchEvents(ctx, entities.SearchQuery{Text: "VPN", From: base.Add(time.Hour), To: base.Add(3 * time.Hour)})
+		require.NoError(t, err)
+		assert.Equal(t, []string{pending.ID, inDescription.ID}, hitIDs(recent.Hits))
+
+		none, err := repo.SearchEvents(ctx, entities.SearchQuery{Text: "impresora"})
+		require.NoError(t, err)
+		assert.NotNil(t, none.Hits)
+		assert.Empty(t, none.Hits)
+	})
+
+	t.Run("SearchEvents supports phrases and exclusions", func(t *testing.T) {
+		repo := newRepo(t)
+
+		down := seed(t, repo, entities.Event{Name: "Caída de VPN", Type: "Incidente", Description: "Sin acceso remoto", Status: "Revisado"}, base)
+		slow := seed(t, repo, entities.Event{Name: "VPN lenta", Type: "Incidente", Description: "El acceso remoto tarda", Status: "Revisado"}, base)
+		seed(t, repo, entities.Event{Name: "Correo caído", Type: "Incidente", Description: "Sin acceso web, remoto tampoco", Status: "Revisado"}, base)
+
+		phrase, err := repo.SearchEvents(ctx, entities.SearchQuery{Text: `"acceso remoto"`})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{down.ID, slow.ID}, hitIDs(phrase.Hits))
+
+		excluded, err := repo.SearchEvents(ctx, entities.SearchQuery{Text: "vpn -lenta"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{down.ID}, hitIDs(excluded.Hits))
+	})
+
+	t.Run("SearchEvents pages with a cursor", func(t *testing.T) {
+		repo := newRepo(t)
+
+		// Todos tienen el mismo puntaje para comprobar el desempate por id.
+		for i := 0; i < 5; i++ {
+			seed(t, repo, entities.Event{Name: "Caída VPN", Type: "Incidente", Description: "d", Status: "Revisado"}, base)
+		}
+
+		all, err := repo.SearchEvents(ctx, entities.SearchQuery{Text: "vpn", Page: entities.PageRequest{Limit: 100}})
+		require.NoError(t, err)
+		require.Len(t, all.Hits, 5)
+		want := hitIDs(all.Hits)
+
+		var got []string
+		query := entities.SearchQuery{Text: "vpn", Page: entities.PageRequest{Limit: 2}}
+		pages := 0
+		for {
+			res, err := repo.SearchEvents(ctx, query)
+			require.NoError(t, err)
+			got = append(got, hitIDs(res.Hits)...)
+			pages++
+			if res.NextCursor == "" {
+				break
+			}
+			query.Page.Cursor = res.NextCursor
+		}
+		assert.Equal(t, want, got)
+		assert.Equal(t, 3, pages)
+
+		query.Text = "caída"
+		_, err = repo.SearchEvents(ctx, query)
+		assert.Equal(t, ErrInvalidCursor, err, "el cursor es de otra búsqueda")
+		_, err = repo.SearchEvents(ctx, entities.SearchQuery{Text: "vpn", Page: entities.PageRequest{Cursor: "not a cursor"}})
+		assert.Equal(t, ErrInvalidCursor, err)
+	})
+
+	t.Run("Listing an empty repository", func(t *testing.T) {
+		repo := newRepo(t)
+
+		all, err := repo.ListEvents(ctx, entities.EventQuery{})
+		require.NoError(t, err)
+		assert.Empty(t, all.Events)
+		assert.NotNil(t, all.Events)
+		assert.Empty(t, all.NextCursor)
+	})
+
+	t.Run("Concurrent writes", func(t *testing.T) {
+		repo := newRepo(t)
+
+		const workers = 20
+		var wg sync.WaitGroup
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				created, err := repo.CreateEvent(ctx, entities.Event{Name: "n", Type: "Incidente", Description: "d", Status: "Revisado"})
+				if !assert.NoError(t, err) {
+					return
+				}
+				created.Category = "Requiere gestión"
+				created.NeedsAction = true
+				_, err = repo.UpdateEvent(ctx, created)
+				assert.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+
+		needing, err := repo.ListEvents(ctx, needingAction(entities.PageRequest{}))
+		require.NoError(t, err)
+		assert.Len(t, needing.Events, workers)
+	})
+
+	t.Run("Tenants only see their own events", func(t *testing.T) {
+		repo := newRepo(t)
+		acme := tenant.WithID(ctx, "acme")
+		globex := tenant.WithID(ctx, "globex")
+
+		mine, err := repo.CreateEvent(acme, entities.Event{Name: "acme", Type: "Incidente", Description: "d", Status: "Revisado", TenantID: "globex"})
+		require.NoError(t, err)
+		assert.Equal(t, "acme", mine.TenantID, "el tenant sale del contexto, no del evento")
+		batch, err := repo.CreateEvents(globex, []entities.Event{{Name: "globex", Type: "Incidente", Description: "d", Status: "Revisado"}})
+		require.NoError(t, err)
+		theirs := batch[0]
+		assert.Equal(t, "globex", theirs.TenantID)
+		legacy, err := repo.CreateEvent(ctx, entities.Event{Name: "default", Type: "Incidente", Description: "d", Status: "Revisado"})
+		require.NoError(t, err)
+		assert.Equal(t, tenant.Default, legacy.TenantID)
+
+		found, err := repo.GetEventByID(acme, mine.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "acme", found.TenantID)
+		_, err = repo.GetEventByID(acme, theirs.ID)
+		assert.Equal(t, ErrEventNotfound, err)
+		_, err = repo.GetEventByID(ctx, mine.ID)
+		assert.Equal(t, ErrEventNotfound, err)
+
+		page, err := repo.ListEvents(acme, entities.EventQuery{IncludeDeleted: true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{mine.ID}, ids(page.Events))
+		page, err = repo.ListEvents(ctx, entities.EventQuery{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{legacy.ID}, ids(page.Events))
+
+		theirs.Name = "cambiado"
+		_, err = repo.UpdateEvent(acme, theirs)
+		assert.Equal(t, ErrEventNotfound, err)
+		_, err = repo.UpdateEvents(acme, []entities.Event{theirs})
+		assert.Equal(t, BulkError{0: ErrEventNotfound}, err)
+		assert.Equal(t, ErrNotasks, repo.DeleteEvent(acme, theirs.ID, base))
+
+		require.NoError(t, repo.DeleteEvent(globex, theirs.ID, base))
+		_, err = repo.RestoreEvent(acme, theirs.ID)
+		assert.Equal(t, ErrEventNotfound, err)
+
+		updated, err := repo.UpdateEvent(acme, mine)
+		require.NoError(t, err)
+		assert.Equal(t, "acme", updated.TenantID)
+	})
+
+	t.Run("PurgeDeleted covers every tenant", func(t *testing.T) {
+		repo := newRepo(t)
+		acme := tenant.WithID(ctx, "acme")
+
+		mine := seed(t, repo, entities.Event{Name: "default", Type: "Reunión", Description: "d", Status: "Revisado"}, base)
+		created, err := repo.CreateEvent(acme, entities.Event{Name: "acme", Type: "Reunión", Description: "d", Status: "Revisado"})
+		require.NoError(t, err)
+		require.NoError(t, repo.DeleteEvent(ctx, mine.ID, base))
+		require.NoError(t, repo.DeleteEvent(acme, created.ID, base))
+
+		purged, err := repo.PurgeDeleted(ctx, base.Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), purged)
+	})
+
+	t.Run("CountEvents groups every tenant and skips deleted events", func(t *testing.T) {
+		repo := newRepo(t)
+		counter, ok := repo.(EventCounter)
+		require.True(t, ok)
+		acme := tenant.WithID(ctx, "acme")
+
+		seed(t, repo, entities.Event{Name: "a", Type: "Incidente", Description: "d", Status: entities.StatusReviewed, Category: entities.CategoryNeedsAction, NeedsAction: true}, base)
+		seed(t, repo, entities.Event{Name: "b", Type: "Error", Description: "d", Status: entities.StatusReviewed, Category: entities.CategoryNeedsAction, NeedsAction: true}, base)
+		seed(t, repo, entities.Event{Name: "c", Type: "Reunión", Description: "d", Status: entities.StatusPending}, base)
+		deleted := seed(t, repo, entities.Event{Name: "d", Type: "Reunión", Description: "d", Status: entities.StatusPending}, base)
+		require.NoError(t, repo.DeleteEvent(ctx, deleted.ID, base))
+		_, err := repo.CreateEvent(acme, entities.Event{Name: "e", Type: "Incidente", Description: "d", Status: entities.StatusReviewed, Category: entities.CategoryNeedsAction, NeedsAction: true})
+		require.NoError(t, err)
+
+		counts, err := counter.CountEvents(ctx)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []entities.EventCount{
+			{Status: entities.StatusReviewed, Category: entities.CategoryNeedsAction, NeedsAction: true, Count: 3},
+			{Status: entities.StatusPending, Count: 1},
+		}, counts)
+	})
+}
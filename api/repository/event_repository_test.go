@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/tenant"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// TestMongoEventRepository necesita un MongoDB accesible en MONGO_TEST_URL,
+// por ejemplo el del docker-compose: MONGO_TEST_URL=mongodb://localhost:27017.
+// Cada subprueba usa una base de datos propia que se elimina al terminar.
+func TestMongoEventRepository(t *testing.T) {
+	url := os.Getenv("MONGO_TEST_URL")
+	if url == "" {
+		t.Skip("MONGO_TEST_URL no definida")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(url))
+	require.NoError(t, err)
+	require.NoError(t, client.Ping(ctx, nil))
+	t.Cleanup(func() { client.Disconnect(context.Background()) })
+
+	runEventRepositoryConformance(t, func(t *testing.T) EventRepository {
+		repo := NewMongoEventRepository(client, logrus.New())
+		repo.database = "events_test_" + primitive.NewObjectID().Hex()
+		t.Cleanup(func() { client.Database(repo.database).Drop(context.Background()) })
+		return repo
+	})
+
+	// Con una base por tenant las pruebas usan los tenants acme y globex.
+	runEventRepositoryConformance(t, func(t *testing.T) EventRepository {
+		repo := NewMongoEventRepository(client, logrus.New(), WithDatabasePerTenant())
+		repo.database = "events_test_" + primitive.NewObjectID().Hex()
+		t.Cleanup(func() {
+			for _, suffix := range []string{"", "_acme", "_globex"} {
+				client.Database(repo.database + suffix).Drop(context.Background())
+			}
+		})
+		return repo
+	})
+
+	t.Run("Tenant databases come from the registry", func(t *testing.T) {
+		repo := NewMongoEventRepository(client, logrus.New(), WithDatabasePerTenant())
+		repo.database = "events_test_" + primitive.NewObjectID().Hex()
+		t.Cleanup(func() {
+			for _, suffix := range []string{"", "_acme", "_backup"} {
+				client.Database(repo.database + suffix).Drop(context.Background())
+			}
+		})
+
+		// Una base con el mismo prefijo que no es de un tenant queda fuera.
+		_, err := client.Database(repo.database+"_backup").Collection(repo.collection).InsertOne(ctx, bson.M{"deleted_at": time.Unix(0, 0)})
+		require.NoError(t, err)
+		_, err = repo.CreateEvent(tenant.WithID(ctx, "acme"), entities.Event{Name: "VPN", Type: "Incidente", Description: "d", Status: "Revisado"})
+		require.NoError(t, err)
+
+		databases, err := repo.tenancy.databases(ctx, client, repo.database)
+		require.NoError(t, err)
+		assert.Equal(t, []string{repo.database, repo.database + "_acme"}, databases)
+
+		_, err = repo.PurgeDeleted(ctx, time.Now())
+		require.NoError(t, err)
+		left, err := client.Database(repo.database+"_backup").Collection(repo.collection).CountDocuments(ctx, bson.M{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), left)
+	})
+
+	runAuditRepositoryConformance(t, func(t *testing.T) AuditRepository {
+		repo := NewMongoAuditRepository(client, logrus.New())
+		repo.database = "events_test_" + primitive.NewObjectID().Hex()
+		t.Cleanup(func() { client.Database(repo.database).Drop(context.Background()) })
+		return repo
+	})
+
+	runWebhookRepositoryConformance(t, func(t *testing.T) WebhookRepository {
+		repo := NewMongoWebhookRepository(client, logrus.New())
+		repo.database = "events_test_" + primitive.NewObjectID().Hex()
+		t.Cleanup(func() { client.Database(repo.database).Drop(context.Background()) })
+		return repo
+	})
+
+	runAPIKeyRepositoryConformance(t, func(t *testing.T) APIKeyRepository {
+		repo := NewMongoAPIKeyRepository(client, logrus.New())
+		repo.database = "events_test_" + primitive.NewObjectID().Hex()
+		t.Cleanup(func() { client.Database(repo.database).Drop(context.Background()) })
+		return repo
+	})
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+	"time"
+)
+
+// OperationObserver recibe la duración y el resultado de cada operación de un
+// repositorio.
+type OperationObserver interface {
+	ObserveOperation(operation string, err error, elapsed time.Duration)
+}
+
+// InstrumentedEventRepository mide cada operación del repositorio que
+// envuelve y se la informa a un OperationObserver.
+type InstrumentedEventRepository struct {
+	next     EventRepository
+	observer OperationObserver
+}
+
+func NewInstrumentedEventRepository(next EventRepository, observer OperationObserver) *InstrumentedEventRepository {
+	return &InstrumentedEventRepository{next: next, observer: observer}
+}
+
+func (r *InstrumentedEventRepository) observe(operation string, start time.Time, err error) {
+	r.observer.ObserveOperation(operation, err, time.Since(start))
+}
+
+func (r *InstrumentedEventRepository) CreateEvent(ctx context.Context, event entities.Event) (entities.Event, error) {
+	start := time.Now()
+	created, err := r.next.CreateEvent(ctx, event)
+	r.observe("CreateEvent", start, err)
+	return created, err
+}
+
+func (r *InstrumentedEventRepository) CreateEvents(ctx context.Context, events []entities.Event) ([]entities.Event, error) {
+	start := time.Now()
+	created, err := r.next.CreateEvents(ctx, events)
+	r.observe("CreateEvents", start, err)
+	return created, err
+}
+
+func (r *InstrumentedEventRepository) GetEventByID(ctx context.Context, id string) (entities.Event, error) {
+	start := time.Now()
+	event, err := r.next.GetEventByID(ctx, id)
+	r.observe("GetEventByID", start, err)
+	return event, err
+}
+
+func (r *InstrumentedEventRepository) ListEvents(ctx context.Context, query entities.EventQuery) (entities.EventPage, error) {
+	start := time.Now()
+	page, err := r.next.ListEvents(ctx, query)
+	r.observe("ListEvents", start, err)
+	return page, err
+}
+
+func (r *InstrumentedEventRepository) SearchEvents(ctx context.Context, query entities.SearchQuery) (entities.SearchPage, error) {
+	start := time.Now()
+	page, err := r.next.SearchEvents(ctx, query)
+	r.observe("SearchEvents", start, err)
+	return page, err
+}
+
+func (r *InstrumentedEventRepository) UpdateEvent(ctx context.Context, event entities.Event) (entities.Event, error) {
+	start := time.Now()
+	updated, err := r.next.UpdateEvent(ctx, event)
+	r.observe("UpdateEvent", start, err)
+	return updated, err
+}
+
+func (r *InstrumentedEventRepository) UpdateEvents(ctx context.Context, events []entities.Event) ([]entities.Event, error) {
+	start := time.Now()
+	updated, err := r.next.UpdateEvents(ctx, events)
+	r.observe("UpdateEvents", start, err)
+	return updated, err
+}
+
+func (r *InstrumentedEventRepository) DeleteEvent(ctx context.Context, id string, deletedAt time.Time) error {
+	start := time.Now()
+	err := r.next.DeleteEvent(ctx, id, deletedAt)
+	r.observe("DeleteEvent", start, err)
+	return err
+}
+
+func (r *InstrumentedEventRepository) RestoreEvent(ctx context.Context, id string) (entities.Event, error) {
+	start := time.Now()
+	event, err := r.next.RestoreEvent(ctx, id)
+	r.observe("RestoreEvent", start, err)
+	return event, err
+}
+
+func (r *InstrumentedEventRepository) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	start := time.Now()
+	purged, err := r.next.PurgeDeleted(ctx, before)
+	r.observe("PurgeDeleted", start, err)
+	return purged, err
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingObserver struct {
+	operations []string
+	errs       []error
+}
+
+func (o *recordingObserver) ObserveOperation(operation string, err error, elapsed time.Duration) {
+	o.operations = append(o.operations, operation)
+	o.errs = append(o.errs, err)
+}
+
+func TestInstrumentedEventRepository(t *testing.T) {
+	observer := &recordingObserver{}
+	repo := NewInstrumentedEventRepository(NewMemoryEventRepository(logrus.New()), observer)
+
+	ctx := context.Background()
+	created, err := repo.CreateEvent(ctx, entities.Event{Name: "VPN", Type: "Incidente", Description: "d", Status: entities.StatusPending})
+	require.NoError(t, err)
+	_, err = repo.GetEventByID(ctx, "missing")
+	assert.Equal(t, ErrEventNotfound, err)
+	require.NoError(t, repo.DeleteEvent(ctx, created.ID, time.Now()))
+
+	assert.Equal(t, []string{"CreateEvent", "GetEventByID", "DeleteEvent"}, observer.operations)
+	assert.Equal(t, []error{nil, ErrEventNotfound, nil}, observer.errs)
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+	"sort"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MemoryAPIKeyRepository es la versión en memoria de MongoAPIKeyRepository.
+type MemoryAPIKeyRepository struct {
+	mu   sync.RWMutex
+	keys map[string]entities.APIKey
+}
+
+func NewMemoryAPIKeyRepository() *MemoryAPIKeyRepository {
+	return &MemoryAPIKeyRepository{keys: make(map[string]entities.APIKey)}
+}
+
+func (r *MemoryAPIKeyRepository) CreateAPIKey(ctx context.Context, key entities.APIKey) (entities.APIKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key.ID = primitive.NewObjectID().Hex()
+	key.Roles = append([]string(nil), key.Roles...)
+	key.Key = ""
+	r.keys[key.ID] = key
+	return key, nil
+}
+
+func (r *MemoryAPIKeyRepository) GetAPIKeyByHash(ctx context.Context, hash string) (entities.APIKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, key := range r.keys {
+		if key.Hash == hash {
+			return key, nil
+		}
+	}
+	return entities.APIKey{}, ErrAPIKeyNotFound
+}
+
+func (r *MemoryAPIKeyRepository) ListAPIKeys(ctx context.Context) ([]entities.APIKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	keys := make([]entities.APIKey, 0, len(r.keys))
+	for _, key := range r.keys {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].ID < keys[j].ID })
+	return keys, nil
+}
+
+func (r *MemoryAPIKeyRepository) RevokeAPIKey(ctx context.Context, id string, at time.Time) (entities.APIKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key, ok := r.keys[id]
+	if !ok {
+		return entities.APIKey{}, ErrAPIKeyNotFound
+	}
+	if key.RevokedAt == nil {
+		key.RevokedAt = &at
+		r.keys[id] = key
+	}
+	return key, nil
+}
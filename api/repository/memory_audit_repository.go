@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/tenant"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MemoryAuditRepository es la versión en memoria de MongoAuditRepository.
+type MemoryAuditRepository struct {
+	mu      sync.RWMutex
+	entries []entities.AuditEntry
+}
+
+func NewMemoryAuditRepository() *MemoryAuditRepository {
+	return &MemoryAuditRepository{}
+}
+
+func (r *MemoryAuditRepository) AppendEntry(ctx context.Context, entry entities.AuditEntry) (entities.AuditEntry, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry.ID = primitive.NewObjectID().Hex()
+	entry.TenantID = tenant.FromContext(ctx)
+	entry.Changes = append([]entities.FieldChange(nil), entry.Changes...)
+	r.entries = append(r.entries, entry)
+	return entry, nil
+}
+
+// ListEntries devuelve el historial de eventID en el orden en que se agregó.
+func (r *MemoryAuditRepository) ListEntries(ctx context.Context, eventID string) ([]entities.AuditEntry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entries := []entities.AuditEntry{}
+	for _, entry := range r.entries {
+		if entry.EventID == eventID && sameTenant(ctx, entry.TenantID) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
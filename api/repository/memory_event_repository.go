@@ -0,0 +1,329 @@
+package repository
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/logging"
+	"prueba_tecnica/api/tenant"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MemoryEventRepository guarda los eventos en memoria. Está pensado para
+// desarrollo local y pruebas; replica el comportamiento de MongoEventRepository
+// (ids ObjectID en hexadecimal, filtros, orden por fecha descendente y
+// aislamiento por tenant).
+type MemoryEventRepository struct {
+	mu     sync.RWMutex
+	events map[string]entities.Event
+	logger logrus.FieldLogger
+}
+
+func NewMemoryEventRepository(logger logrus.FieldLogger) *MemoryEventRepository {
+	return &MemoryEventRepository{
+		events: make(map[string]entities.Event),
+		logger: logger,
+	}
+}
+
+func (r *MemoryEventRepository) CreateEvent(ctx context.Context, event entities.Event) (entities.Event, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	event.ID = primitive.NewObjectID().Hex()
+	event.TenantID = tenant.FromContext(ctx)
+	event.Date = time.Now()
+	event.Version = 1
+	r.events[event.ID] = event
+
+	logging.For(ctx, r.logger, "memory_event_repository", "CreateEvent").WithFields(logging.Event(event)).Debug("evento guardado")
+	return event, nil
+}
+
+func (r *MemoryEventRepository) CreateEvents(ctx context.Context, events []entities.Event) ([]entities.Event, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	tenantID := tenant.FromContext(ctx)
+	created := make([]entities.Event, len(events))
+	for i, event := range events {
+		event.ID = primitive.NewObjectID().Hex()
+		event.TenantID = tenantID
+		event.Date = now
+		event.Version = 1
+		r.events[event.ID] = event
+		created[i] = event
+	}
+
+	logging.For(ctx, r.logger, "memory_event_repository", "CreateEvents").WithField("count", len(created)).Debug("eventos guardados")
+	return created, nil
+}
+
+func (r *MemoryEventRepository) GetEventByID(ctx context.Context, id string) (entities.Event, error) {
+	if _, err := primitive.ObjectIDFromHex(id); err != nil {
+		logging.For(ctx, r.logger, "memory_event_repository", "GetEventByID").WithError(err).Error("operación fallida")
+		return entities.Event{}, ErrEventNotfound
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	event, ok := r.lookup(ctx, id)
+	if !ok || event.DeletedAt != nil {
+		logging.For(ctx, r.logger, "memory_event_repository", "GetEventByID").WithError(ErrEventNotfound).Error("operación fallida")
+		return entities.Event{}, ErrEventNotfound
+	}
+	return event, nil
+}
+
+func (r *MemoryEventRepository) UpdateEvent(ctx context.Context, event entities.Event) (entities.Event, error) {
+	if _, err := primitive.ObjectIDFromHex(event.ID); err != nil {
+		logging.For(ctx, r.logger, "memory_event_repository", "UpdateEvent").WithError(err).Error("operación fallida")
+		return entities.Event{}, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.lookup(ctx, event.ID)
+	if !ok || stored.DeletedAt != nil {
+		logging.For(ctx, r.logger, "memory_event_repository", "UpdateEvent").WithError(ErrEventNotfound).Error("operación fallida")
+		return entities.Event{}, ErrEventNotfound
+	}
+	if stored.Version != event.Version {
+		logging.For(ctx, r.logger, "memory_event_repository", "UpdateEvent").WithError(ErrVersionConflict).Error("operación fallida")
+		return entities.Event{}, ErrVersionConflict
+	}
+	event.TenantID = stored.TenantID
+	event.Version++
+	event.DeletedAt = nil
+	event.StatusHistory = cloneHistory(event.StatusHistory)
+	r.events[event.ID] = event
+
+	logging.For(ctx, r.logger, "memory_event_repository", "UpdateEvent").WithFields(logging.Event(event)).Debug("evento actualizado")
+	return event, nil
+}
+
+func (r *MemoryEventRepository) UpdateEvents(ctx context.Context, events []entities.Event) ([]entities.Event, error) {
+	failed := BulkError{}
+	updated := make([]entities.Event, len(events))
+	for i, event := range events {
+		saved, err := r.UpdateEvent(ctx, event)
+		if err != nil {
+			failed[i] = err
+			saved = event
+		}
+		updated[i] = saved
+	}
+
+	if len(failed) > 0 {
+		return updated, failed
+	}
+	return updated, nil
+}
+
+func (r *MemoryEventRepository) DeleteEvent(ctx context.Context, id string, deletedAt time.Time) error {
+	if _, err := primitive.ObjectIDFromHex(id); err != nil {
+		logging.For(ctx, r.logger, "memory_event_repository", "DeleteEvent").WithError(err).Error("operación fallida")
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	event, ok := r.lookup(ctx, id)
+	if !ok || event.DeletedAt != nil {
+		logging.For(ctx, r.logger, "memory_event_repository", "DeleteEvent").WithError(ErrEventNotfound).Error("operación fallida")
+		return ErrEventNotfound
+	}
+	event.DeletedAt = &deletedAt
+	event.Version++
+	r.events[id] = event
+
+	logging.For(ctx, r.logger, "memory_event_repository", "DeleteEvent").WithField(logging.FieldEventID, id).Debug("evento eliminado")
+	return nil
+}
+
+func (r *MemoryEventRepository) RestoreEvent(ctx context.Context, id string) (entities.Event, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	event, ok := r.lookup(ctx, id)
+	if !ok {
+		logging.For(ctx, r.logger, "memory_event_repository", "RestoreEvent").WithError(ErrEventNotfound).Error("operación fallida")
+		return entities.Event{}, ErrEventNotfound
+	}
+	if event.DeletedAt == nil {
+		logging.For(ctx, r.logger, "memory_event_repository", "RestoreEvent").WithError(ErrNotDeleted).Error("operación fallida")
+		return entities.Event{}, ErrNotDeleted
+	}
+	restored := event
+	restored.DeletedAt = nil
+	restored.Version++
+	r.events[id] = restored
+
+	logging.For(ctx, r.logger, "memory_event_repository", "RestoreEvent").WithField(logging.FieldEventID, id).Debug("evento restaurado")
+	return event, nil
+}
+
+func (r *MemoryEventRepository) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var purged int64
+	for id, event := range r.events {
+		if event.DeletedAt != nil && event.DeletedAt.Before(before) {
+			delete(r.events, id)
+			purged++
+		}
+	}
+
+	logging.For(ctx, r.logger, "memory_event_repository", "PurgeDeleted").WithField("count", purged).Info("eventos purgados")
+	return purged, nil
+}
+
+func (r *MemoryEventRepository) CountEvents(ctx context.Context) ([]entities.EventCount, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	totals := map[entities.EventCount]int64{}
+	for _, event := range r.events {
+		if event.DeletedAt != nil {
+			continue
+		}
+		totals[entities.EventCount{Status: event.Status, Category: event.Category, NeedsAction: event.NeedsAction}]++
+	}
+	return eventCounts(totals), nil
+}
+
+func (r *MemoryEventRepository) ListEvents(ctx context.Context, query entities.EventQuery) (entities.EventPage, error) {
+	limit := pageLimit(query.Page)
+	field, desc := query.Sort()
+
+	var after *entities.Event
+	if query.Page.Cursor != "" {
+		c, err := decodeCursor(query)
+		if err != nil {
+			logging.For(ctx, r.logger, "memory_event_repository", "ListEvents").WithError(err).Error("operación fallida")
+			return entities.EventPage{}, err
+		}
+		after = &entities.Event{ID: c.ID, Date: c.Date}
+		switch field {
+		case entities.SortByName:
+			after.Name = c.Value
+		case entities.SortByType:
+			after.Type = c.Value
+		case entities.SortByStatus:
+			after.Status = entities.Status(c.Value)
+		}
+	}
+
+	// less indica si a va antes que b en el orden pedido.
+	less := func(a, b entities.Event) bool {
+		var c int
+		if field == entities.SortByDate {
+			c = a.Date.Compare(b.Date)
+		} else {
+			c = strings.Compare(sortValue(a, field), sortValue(b, field))
+		}
+		if c == 0 {
+			c = strings.Compare(a.ID, b.ID)
+		}
+		if desc {
+			return c > 0
+		}
+		return c < 0
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var events []entities.Event
+	for _, event := range r.events {
+		if !sameTenant(ctx, event.TenantID) || !matchesQuery(event, query) {
+			continue
+		}
+		if after != nil && !less(*after, event) {
+			continue
+		}
+		events = append(events, event)
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return less(events[i], events[j])
+	})
+	if len(events) > limit+1 {
+		events = events[:limit+1]
+	}
+	return newEventPage(events, query), nil
+}
+
+// SearchEvents no tiene índice: puntúa cada evento con ScanSearch.
+func (r *MemoryEventRepository) SearchEvents(ctx context.Context, query entities.SearchQuery) (entities.SearchPage, error) {
+	page, err := ScanSearch(ctx, r, query)
+	if err != nil {
+		logging.For(ctx, r.logger, "memory_event_repository", "SearchEvents").WithError(err).Error("operación fallida")
+	}
+	return page, err
+}
+
+// lookup busca id entre los eventos del tenant de ctx. Quien llama debe
+// tener tomado r.mu.
+func (r *MemoryEventRepository) lookup(ctx context.Context, id string) (entities.Event, bool) {
+	event, ok := r.events[id]
+	if !ok || !sameTenant(ctx, event.TenantID) {
+		return entities.Event{}, false
+	}
+	return event, true
+}
+
+// cloneHistory copia el historial con cap == len, así un append del llamador
+// nunca escribe sobre el arreglo guardado.
+func cloneHistory(history []entities.StatusTransition) []entities.StatusTransition {
+	if len(history) == 0 {
+		return nil
+	}
+	out := make([]entities.StatusTransition, len(history))
+	copy(out, history)
+	return out
+}
+
+func matchesQuery(event entities.Event, query entities.EventQuery) bool {
+	if event.DeletedAt != nil && !query.IncludeDeleted {
+		return false
+	}
+	if query.Status != "" && event.Status != query.Status {
+		return false
+	}
+	if query.Category != "" && event.Category != query.Category {
+		return false
+	}
+	if query.Type != "" && event.Type != query.Type {
+		return false
+	}
+	if query.NeedsAction != nil && event.NeedsAction != *query.NeedsAction {
+		return false
+	}
+	if !query.From.IsZero() && event.Date.Before(query.From) {
+		return false
+	}
+	if !query.To.IsZero() && !event.Date.Before(query.To) {
+		return false
+	}
+	if query.Name != "" && !containsFold(event.Name, query.Name) {
+		return false
+	}
+	if query.Description != "" && !containsFold(event.Description, query.Description) {
+		return false
+	}
+	return true
+}
+
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
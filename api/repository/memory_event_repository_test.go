@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestMemoryEventRepository(t *testing.T) {
+	runEventRepositoryConformance(t, func(t *testing.T) EventRepository {
+		return NewMemoryEventRepository(logrus.New())
+	})
+}
+
+func TestMemoryAuditRepository(t *testing.T) {
+	runAuditRepositoryConformance(t, func(t *testing.T) AuditRepository {
+		return NewMemoryAuditRepository()
+	})
+}
+
+func TestMemoryWebhookRepository(t *testing.T) {
+	runWebhookRepositoryConformance(t, func(t *testing.T) WebhookRepository {
+		return NewMemoryWebhookRepository()
+	})
+}
+
+func TestMemoryAPIKeyRepository(t *testing.T) {
+	runAPIKeyRepositoryConformance(t, func(t *testing.T) APIKeyRepository {
+		return NewMemoryAPIKeyRepository()
+	})
+}
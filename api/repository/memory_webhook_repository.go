@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+	"sort"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MemoryWebhookRepository es la versión en memoria de MongoWebhookRepository.
+type MemoryWebhookRepository struct {
+	mu          sync.RWMutex
+	webhooks    map[string]entities.Webhook
+	deliveries  map[string]entities.WebhookDelivery
+	deadLetters map[string]entities.WebhookDelivery
+}
+
+func NewMemoryWebhookRepository() *MemoryWebhookRepository {
+	return &MemoryWebhookRepository{
+		webhooks:    make(map[string]entities.Webhook),
+		deliveries:  make(map[string]entities.WebhookDelivery),
+		deadLetters: make(map[string]entities.WebhookDelivery),
+	}
+}
+
+func (r *MemoryWebhookRepository) CreateWebhook(ctx context.Context, webhook entities.Webhook) (entities.Webhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	webhook.ID = primitive.NewObjectID().Hex()
+	webhook.Triggers = append([]string(nil), webhook.Triggers...)
+	r.webhooks[webhook.ID] = webhook
+	return webhook, nil
+}
+
+func (r *MemoryWebhookRepository) GetWebhook(ctx context.Context, id string) (entities.Webhook, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	webhook, ok := r.webhooks[id]
+	if !ok {
+		return entities.Webhook{}, ErrWebhookNotFound
+	}
+	return webhook, nil
+}
+
+func (r *MemoryWebhookRepository) ListWebhooks(ctx context.Context) ([]entities.Webhook, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	webhooks := make([]entities.Webhook, 0, len(r.webhooks))
+	for _, webhook := range r.webhooks {
+		webhooks = append(webhooks, webhook)
+	}
+	// Los ids ObjectID en hexadecimal se ordenan por fecha de creación.
+	sort.Slice(webhooks, func(i, j int) bool { return webhooks[i].ID < webhooks[j].ID })
+	return webhooks, nil
+}
+
+func (r *MemoryWebhookRepository) UpdateWebhook(ctx context.Context, webhook entities.Webhook) (entities.Webhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.webhooks[webhook.ID]
+	if !ok {
+		return entities.Webhook{}, ErrWebhookNotFound
+	}
+	stored.URL = webhook.URL
+	stored.Triggers = append([]string(nil), webhook.Triggers...)
+	stored.Secret = webhook.Secret
+	stored.Active = webhook.Active
+	r.webhooks[webhook.ID] = stored
+	return stored, nil
+}
+
+func (r *MemoryWebhookRepository) DeleteWebhook(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.webhooks[id]; !ok {
+		return ErrWebhookNotFound
+	}
+	delete(r.webhooks, id)
+	return nil
+}
+
+func (r *MemoryWebhookRepository) SaveDelivery(ctx context.Context, delivery entities.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.deliveries[delivery.ID] = delivery
+	return nil
+}
+
+func (r *MemoryWebhookRepository) ListDeliveries(ctx context.Context, webhookID string, limit int) ([]entities.WebhookDelivery, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return latestDeliveries(r.deliveries, webhookID, limit), nil
+}
+
+func (r *MemoryWebhookRepository) AddDeadLetter(ctx context.Context, delivery entities.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.deadLetters[delivery.ID] = delivery
+	return nil
+}
+
+func (r *MemoryWebhookRepository) ListDeadLetters(ctx context.Context, webhookID string, limit int) ([]entities.WebhookDelivery, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return latestDeliveries(r.deadLetters, webhookID, limit), nil
+}
+
+func latestDeliveries(all map[string]entities.WebhookDelivery, webhookID string, limit int) []entities.WebhookDelivery {
+	deliveries := []entities.WebhookDelivery{}
+	for _, delivery := range all {
+		if delivery.WebhookID == webhookID {
+			deliveries = append(deliveries, delivery)
+		}
+	}
+	sort.Slice(deliveries, func(i, j int) bool {
+		a, b := deliveries[i], deliveries[j]
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.After(b.CreatedAt)
+		}
+		return a.ID > b.ID
+	})
+	if len(deliveries) > limit {
+		deliveries = deliveries[:limit]
+	}
+	return deliveries
+}
@@ -0,0 +1,44 @@
+package repository
+
+// Nombres por defecto de la base y de la colección de eventos.
+const (
+	DefaultDatabase        = "events_db"
+	DefaultEventCollection = "events"
+)
+
+// MongoOption configura la base, las colecciones y la separación por tenant
+// de los repositorios de MongoDB.
+type MongoOption func(*mongoOptions)
+
+type mongoOptions struct {
+	database        string
+	eventCollection string
+	tenancy         tenancy
+}
+
+// WithDatabase cambia la base en la que los repositorios guardan sus datos.
+// Con WithDatabasePerTenant es también el prefijo de las bases de cada tenant.
+func WithDatabase(name string) MongoOption {
+	return func(o *mongoOptions) {
+		o.database = name
+	}
+}
+
+// WithEventCollection cambia la colección de eventos. Solo la usa el
+// repositorio de eventos.
+func WithEventCollection(name string) MongoOption {
+	return func(o *mongoOptions) {
+		o.eventCollection = name
+	}
+}
+
+func newMongoOptions(opts []MongoOption) mongoOptions {
+	o := mongoOptions{
+		database:        DefaultDatabase,
+		eventCollection: DefaultEventCollection,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
@@ -0,0 +1,417 @@
+package repository
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"html"
+	"prueba_tecnica/api/entities"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// searchWeights es cuánto pesa una coincidencia en cada campo. MongoDB lo usa
+// como pesos del índice de texto y la búsqueda en memoria para su puntaje.
+var searchWeights = map[string]int32{
+	entities.SearchFieldName:        5,
+	entities.SearchFieldDescription: 1,
+}
+
+// fragmentLength es el largo en caracteres del recorte de la descripción en
+// los resaltados; fragmentContext es cuántas palabras se dejan antes de la
+// primera coincidencia.
+const (
+	fragmentLength  = 160
+	fragmentContext = 6
+)
+
+// searchTerms es el texto de una búsqueda ya separado y normalizado.
+type searchTerms struct {
+	words    []string
+	phrases  [][]string
+	excluded [][]string
+}
+
+// parseSearch separa text como lo hace MongoDB: frases entre comillas,
+// palabras con "-" delante para excluir y el resto como palabras sueltas.
+func parseSearch(text string) searchTerms {
+	var t searchTerms
+	for text != "" {
+		text = strings.TrimLeftFunc(text, unicode.IsSpace)
+		if text == "" {
+			break
+		}
+		negated := strings.HasPrefix(text, "-")
+		if negated {
+			text = text[1:]
+		}
+
+		var token string
+		if strings.HasPrefix(text, `"`) {
+			end := strings.Index(text[1:], `"`)
+			if end < 0 {
+				token, text = text[1:], ""
+			} else {
+				token, text = text[1:end+1], text[end+2:]
+			}
+			switch words := stems(token); {
+			case len(words) == 0:
+			case negated:
+				t.excluded = append(t.excluded, words)
+			default:
+				t.phrases = append(t.phrases, words)
+			}
+			continue
+		}
+		if end := strings.IndexFunc(text, unicode.IsSpace); end < 0 {
+			token, text = text, ""
+		} else {
+			token, text = text[:end], text[end:]
+		}
+
+		if negated {
+			for _, w := range stems(token) {
+				t.excluded = append(t.excluded, []string{w})
+			}
+		} else {
+			t.words = append(t.words, stems(token)...)
+		}
+	}
+	return t
+}
+
+// empty indica que la búsqueda no tiene nada que encontrar, por ejemplo si
+// solo excluye palabras.
+func (t searchTerms) empty() bool {
+	return len(t.words) == 0 && len(t.phrases) == 0
+}
+
+// word es una palabra de un texto: su posición en bytes y su raíz.
+type word struct {
+	start, end int
+	stem       string
+}
+
+// splitWords separa text en palabras de letras y dígitos.
+func splitWords(text string) []word {
+	var words []word
+	start := -1
+	for i, r := range text + " " {
+		inWord := unicode.IsLetter(r) || unicode.IsDigit(r)
+		switch {
+		case inWord && start < 0:
+			start = i
+		case !inWord && start >= 0:
+			words = append(words, word{start: start, end: i, stem: stem(text[start:i])})
+			start = -1
+		}
+	}
+	return words
+}
+
+func stems(text string) []string {
+	words := splitWords(text)
+	out := make([]string, len(words))
+	for i, w := range words {
+		out[i] = w.stem
+	}
+	return out
+}
+
+var foldAccents = strings.NewReplacer("á", "a", "é", "e", "í", "i", "ó", "o", "ú", "u", "ü", "u", "ñ", "n")
+
+// stem reduce una palabra a una raíz aproximada: sin mayúsculas, sin tildes y
+// sin plural, para que "Conexiones" coincida con "conexión". Es mucho más
+// simple que el stemmer de MongoDB, pero alcanza para los plurales.
+func stem(w string) string {
+	w = foldAccents.Replace(strings.ToLower(w))
+	switch n := len(w); {
+	case n > 4 && strings.HasSuffix(w, "es") && strings.ContainsRune("lnrdzj", rune(w[n-3])):
+		return w[:n-2]
+	case n > 3 && strings.HasSuffix(w, "s"):
+		return w[:n-1]
+	}
+	return w
+}
+
+// fieldMatch son las palabras de un campo y cuáles coinciden con la búsqueda.
+type fieldMatch struct {
+	text    string
+	words   []word
+	matched []bool
+	count   int
+}
+
+func matchField(text string, terms searchTerms) fieldMatch {
+	m := fieldMatch{text: text, words: splitWords(text)}
+	m.matched = make([]bool, len(m.words))
+	for i, w := range m.words {
+		for _, term := range terms.words {
+			if w.stem == term {
+				m.matched[i] = true
+				m.count++
+				break
+			}
+		}
+	}
+	for _, phrase := range terms.phrases {
+		for i := range m.words {
+			if !m.hasPhrase(i, phrase) {
+				continue
+			}
+			for j := i; j < i+len(phrase); j++ {
+				m.matched[j] = true
+			}
+			m.count += len(phrase)
+		}
+	}
+	return m
+}
+
+// hasPhrase indica si la frase empieza en la palabra i. Entre las palabras de
+// la frase solo puede haber espacios.
+func (m fieldMatch) hasPhrase(i int, phrase []string) bool {
+	if i+len(phrase) > len(m.words) {
+		return false
+	}
+	for j, term := range phrase {
+		w := m.words[i+j]
+		if w.stem != term {
+			return false
+		}
+		if j > 0 && strings.TrimSpace(m.text[m.words[i+j-1].end:w.start]) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func (m fieldMatch) containsPhrase(phrase []string) bool {
+	for i := range m.words {
+		if m.hasPhrase(i, phrase) {
+			return true
+		}
+	}
+	return false
+}
+
+// searchMatches busca terms en el nombre y la descripción de event.
+func searchMatches(event entities.Event, terms searchTerms) map[string]fieldMatch {
+	return map[string]fieldMatch{
+		entities.SearchFieldName:        matchField(event.Name, terms),
+		entities.SearchFieldDescription: matchField(event.Description, terms),
+	}
+}
+
+// searchScore puntúa event como lo haría el índice de texto: las frases deben
+// estar todas, las exclusiones ninguna y, si no hay frases, al menos una
+// palabra. Cada coincidencia suma el peso de su campo. Devuelve false si
+// el evento no cumple la búsqueda.
+func searchScore(event entities.Event, terms searchTerms) (float64, bool) {
+	if terms.empty() {
+		return 0, false
+	}
+	fields := searchMatches(event, terms)
+	for _, phrase := range terms.excluded {
+		for _, m := range fields {
+			if m.containsPhrase(phrase) {
+				return 0, false
+			}
+		}
+	}
+	for _, phrase := range terms.phrases {
+		found := false
+		for _, m := range fields {
+			found = found || m.containsPhrase(phrase)
+		}
+		if !found {
+			return 0, false
+		}
+	}
+
+	var score float64
+	for field, m := range fields {
+		score += float64(searchWeights[field]) * float64(m.count)
+	}
+	return score, score > 0
+}
+
+// highlights marca entre <em> y </em> las coincidencias de cada campo. El
+// texto va escapado como HTML para que los clientes lo puedan mostrar tal cual.
+func highlights(event entities.Event, terms searchTerms) map[string]string {
+	out := map[string]string{}
+	for field, m := range searchMatches(event, terms) {
+		if m.count == 0 {
+			continue
+		}
+		if field == entities.SearchFieldDescription {
+			out[field] = m.fragment()
+		} else {
+			out[field] = m.mark(0, len(m.text))
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+// fragment recorta la descripción alrededor de su primera coincidencia.
+func (m fieldMatch) fragment() string {
+	if utf8.RuneCountInString(m.text) <= fragmentLength {
+		return m.mark(0, len(m.text))
+	}
+	first := 0
+	for i, matched := range m.matched {
+		if matched {
+			first = i
+			break
+		}
+	}
+	start := m.words[max(first-fragmentContext, 0)].start
+	end := m.words[first].end
+	for _, w := range m.words[first:] {
+		if utf8.RuneCountInString(m.text[start:w.end]) > fragmentLength {
+			break
+		}
+		end = w.end
+	}
+
+	fragment := m.mark(start, end)
+	if start > 0 {
+		fragment = "…" + fragment
+	}
+	if end < len(m.text) {
+		fragment += "…"
+	}
+	return fragment
+}
+
+// mark devuelve m.text[start:end] con las palabras coincidentes resaltadas.
+func (m fieldMatch) mark(start, end int) string {
+	var b strings.Builder
+	pos := start
+	for i, w := range m.words {
+		if !m.matched[i] || w.start < start || w.end > end {
+			continue
+		}
+		b.WriteString(html.EscapeString(m.text[pos:w.start]))
+		b.WriteString("<em>")
+		b.WriteString(html.EscapeString(m.text[w.start:w.end]))
+		b.WriteString("</em>")
+		pos = w.end
+	}
+	b.WriteString(html.EscapeString(m.text[pos:end]))
+	return b.String()
+}
+
+// searchCursor es la posición del último resultado entregado en el orden
+// (puntaje, _id) descendente. Guarda el texto buscado para rechazar cursores
+// de otra búsqueda.
+type searchCursor struct {
+	Text  string  `json:"q"`
+	Score float64 `json:"sc"`
+	ID    string  `json:"id"`
+}
+
+func encodeSearchCursor(hit entities.SearchHit, query entities.SearchQuery) string {
+	b, _ := json.Marshal(searchCursor{Text: query.Text, Score: hit.Score, ID: hit.Event.ID})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeSearchCursor(query entities.SearchQuery) (searchCursor, error) {
+	var c searchCursor
+	b, err := base64.RawURLEncoding.DecodeString(query.Page.Cursor)
+	if err != nil {
+		return c, ErrInvalidCursor
+	}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return c, ErrInvalidCursor
+	}
+	if _, err := primitive.ObjectIDFromHex(c.ID); err != nil {
+		return c, ErrInvalidCursor
+	}
+	if c.Text != query.Text {
+		return c, ErrInvalidCursor
+	}
+	return c, nil
+}
+
+// follows indica si hit va después de la posición del cursor.
+func (c searchCursor) follows(hit entities.SearchHit) bool {
+	if hit.Score != c.Score {
+		return hit.Score < c.Score
+	}
+	return hit.Event.ID < c.ID
+}
+
+// newSearchPage recorta hits (que trae hasta limit+1 elementos), agrega los
+// resaltados y calcula el cursor de la siguiente página.
+func newSearchPage(hits []entities.SearchHit, query entities.SearchQuery) entities.SearchPage {
+	limit := pageLimit(query.Page)
+	page := entities.SearchPage{Hits: hits}
+	if page.Hits == nil {
+		page.Hits = []entities.SearchHit{}
+	}
+	if len(page.Hits) > limit {
+		page.Hits = page.Hits[:limit]
+		page.NextCursor = encodeSearchCursor(page.Hits[limit-1], query)
+	}
+	terms := parseSearch(query.Text)
+	for i := range page.Hits {
+		page.Hits[i].Highlights = highlights(page.Hits[i].Event, terms)
+	}
+	return page
+}
+
+// ScanSearch implementa SearchEvents sobre ListEvents para los repositorios
+// sin índice de texto: recorre todos los eventos que cumplen los filtros y
+// puntúa cada uno con las mismas reglas que el índice. Solo conviene con
+// pocos eventos.
+func ScanSearch(ctx context.Context, repo EventRepository, query entities.SearchQuery) (entities.SearchPage, error) {
+	var after *searchCursor
+	if query.Page.Cursor != "" {
+		c, err := decodeSearchCursor(query)
+		if err != nil {
+			return entities.SearchPage{}, err
+		}
+		after = &c
+	}
+
+	terms := parseSearch(query.Text)
+	filters := query.Filters()
+	filters.Page.Limit = MaxPageLimit
+	var hits []entities.SearchHit
+	for {
+		page, err := repo.ListEvents(ctx, filters)
+		if err != nil {
+			return entities.SearchPage{}, err
+		}
+		for _, event := range page.Events {
+			score, ok := searchScore(event, terms)
+			hit := entities.SearchHit{Event: event, Score: score}
+			if ok && (after == nil || after.follows(hit)) {
+				hits = append(hits, hit)
+			}
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		filters.Page.Cursor = page.NextCursor
+	}
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].Event.ID > hits[j].Event.ID
+	})
+	if limit := pageLimit(query.Page); len(hits) > limit+1 {
+		hits = hits[:limit+1]
+	}
+	return newSearchPage(hits, query), nil
+}
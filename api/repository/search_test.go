@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	"prueba_tecnica/api/entities"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchScore(t *testing.T) {
+	testCases := []struct {
+		name     string
+		text     string
+		event    entities.Event
+		expected float64
+		matches  bool
+	}{
+		{name: "Word in the name", text: "vpn", event: entities.Event{Name: "Caída de VPN", Description: "d"}, expected: 5, matches: true},
+		{name: "Word in the description", text: "vpn", event: entities.Event{Name: "Reunión", Description: "Revisar la VPN"}, expected: 1, matches: true},
+		{name: "Accents and plurals", text: "conexiones", event: entities.Event{Name: "Conexión caída", Description: "d"}, expected: 5, matches: true},
+		{name: "Any word is enough", text: "impresora vpn", event: entities.Event{Name: "VPN", Description: "d"}, expected: 5, matches: true},
+		{name: "No match", text: "impresora", event: entities.Event{Name: "VPN", Description: "d"}},
+		{name: "Phrase", text: `"acceso remoto"`, event: entities.Event{Name: "VPN", Description: "Sin acceso remoto"}, expected: 2, matches: true},
+		{name: "Missing phrase", text: `vpn "acceso remoto"`, event: entities.Event{Name: "VPN", Description: "Sin acceso, remoto tampoco"}},
+		{name: "Excluded word", text: "vpn -lenta", event: entities.Event{Name: "VPN lenta", Description: "d"}},
+		{name: "Excluded phrase", text: `vpn -"sede norte"`, event: entities.Event{Name: "VPN", Description: "En la sede norte"}},
+		{name: "Only exclusions", text: "-lenta", event: entities.Event{Name: "VPN", Description: "d"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			score, ok := searchScore(tc.event, parseSearch(tc.text))
+			assert.Equal(t, tc.matches, ok)
+			assert.Equal(t, tc.expected, score)
+		})
+	}
+}
+
+func TestHighlights(t *testing.T) {
+	terms := parseSearch("vpn")
+
+	escaped := highlights(entities.Event{Name: "<b>VPN</b> & correo", Description: "d"}, terms)
+	assert.Equal(t, map[string]string{entities.SearchFieldName: "&lt;b&gt;<em>VPN</em>&lt;/b&gt; &amp; correo"}, escaped)
+
+	long := strings.Repeat("antes ", 40) + "cae la VPN " + strings.Repeat("después ", 40)
+	fragment := highlights(entities.Event{Name: "Incidente", Description: long}, terms)[entities.SearchFieldDescription]
+	assert.True(t, strings.HasPrefix(fragment, "…antes "), fragment)
+	assert.True(t, strings.HasSuffix(fragment, "después…"), fragment)
+	assert.Contains(t, fragment, "cae la <em>VPN</em> después")
+	plain := strings.NewReplacer("<em>", "", "</em>", "", "…", "").Replace(fragment)
+	assert.LessOrEqual(t, len([]rune(plain)), fragmentLength)
+}
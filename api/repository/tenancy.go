@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"prueba_tecnica/api/tenant"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// tenantsCollection es el registro de los tenants con base propia. Está en la
+// base compartida y databases lo recorre en lugar de listar las bases del
+// servidor, que exige el privilegio listDatabases y encontraría bases ajenas
+// con el mismo prefijo.
+const tenantsCollection = "tenants"
+
+// WithDatabasePerTenant guarda cada tenant en su propia base, "<base>_<tenant>".
+// Los datos de tenant.Default siguen en la base compartida.
+func WithDatabasePerTenant() MongoOption {
+	return func(o *mongoOptions) {
+		o.tenancy.perDatabase = true
+		o.tenancy.registered = &sync.Map{}
+	}
+}
+
+// tenancy elige dónde están los documentos del tenant de la petición. Además
+// de la base, todas las consultas filtran por tenant_id.
+type tenancy struct {
+	perDatabase bool
+	// registered son los tenants que este proceso ya anotó en el registro.
+	registered *sync.Map
+}
+
+// databaseFor devuelve la base del tenant de ctx.
+func (t tenancy) databaseFor(ctx context.Context, base string) string {
+	id := tenant.FromContext(ctx)
+	if !t.perDatabase || id == tenant.Default {
+		return base
+	}
+	return base + "_" + id
+}
+
+// register anota el tenant de ctx en el registro antes de que escriba por
+// primera vez en su base.
+func (t tenancy) register(ctx context.Context, client *mongo.Client, base string) error {
+	id := tenant.FromContext(ctx)
+	if !t.perDatabase || id == tenant.Default {
+		return nil
+	}
+	if _, ok := t.registered.Load(id); ok {
+		return nil
+	}
+	update := bson.M{"$setOnInsert": bson.M{"created_at": time.Now()}}
+	_, err := client.Database(base).Collection(tenantsCollection).UpdateOne(ctx, bson.M{"_id": id}, update, options.Update().SetUpsert(true))
+	if err != nil {
+		return err
+	}
+	t.registered.Store(id, struct{}{})
+	return nil
+}
+
+// databases devuelve las bases de todos los tenants registrados, para las
+// tareas que no son de un solo tenant como la purga.
+func (t tenancy) databases(ctx context.Context, client *mongo.Client, base string) ([]string, error) {
+	if !t.perDatabase {
+		return []string{base}, nil
+	}
+	cursor, err := client.Database(base).Collection(tenantsCollection).Find(ctx, bson.D{}, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+	var registry []struct {
+		ID string `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &registry); err != nil {
+		return nil, err
+	}
+
+	databases := []string{base}
+	for _, entry := range registry {
+		databases = append(databases, base+"_"+entry.ID)
+	}
+	return databases, nil
+}
+
+// tenantFilter limita una consulta al tenant de ctx. Los documentos sin
+// tenant_id son anteriores a los tenants y pertenecen a tenant.Default.
+func tenantFilter(ctx context.Context) bson.E {
+	id := tenant.FromContext(ctx)
+	if id == tenant.Default {
+		return bson.E{Key: "tenant_id", Value: bson.M{"$in": bson.A{nil, tenant.Default}}}
+	}
+	return bson.E{Key: "tenant_id", Value: id}
+}
+
+// sameTenant indica si un documento con tenant id es visible desde ctx.
+func sameTenant(ctx context.Context, id string) bool {
+	return tenant.Of(id) == tenant.FromContext(ctx)
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/tracing"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracedEventRepository abre una span por cada operación del repositorio que
+// envuelve. Las spans de los comandos de MongoDB quedan como hijas.
+type TracedEventRepository struct {
+	next   EventRepository
+	tracer trace.Tracer
+}
+
+func NewTracedEventRepository(next EventRepository, tracer trace.Tracer) *TracedEventRepository {
+	return &TracedEventRepository{next: next, tracer: tracer}
+}
+
+func (r *TracedEventRepository) CreateEvent(ctx context.Context, event entities.Event) (entities.Event, error) {
+	ctx, span := r.tracer.Start(ctx, "EventRepository.CreateEvent")
+	created, err := r.next.CreateEvent(ctx, event)
+	tracing.End(span, err)
+	return created, err
+}
+
+func (r *TracedEventRepository) CreateEvents(ctx context.Context, events []entities.Event) ([]entities.Event, error) {
+	ctx, span := r.tracer.Start(ctx, "EventRepository.CreateEvents")
+	created, err := r.next.CreateEvents(ctx, events)
+	tracing.End(span, err)
+	return created, err
+}
+
+func (r *TracedEventRepository) GetEventByID(ctx context.Context, id string) (entities.Event, error) {
+	ctx, span := r.tracer.Start(ctx, "EventRepository.GetEventByID")
+	event, err := r.next.GetEventByID(ctx, id)
+	tracing.End(span, err)
+	return event, err
+}
+
+func (r *TracedEventRepository) ListEvents(ctx context.Context, query entities.EventQuery) (entities.EventPage, error) {
+	ctx, span := r.tracer.Start(ctx, "EventRepository.ListEvents")
+	page, err := r.next.ListEvents(ctx, query)
+	tracing.End(span, err)
+	return page, err
+}
+
+func (r *TracedEventRepository) SearchEvents(ctx context.Context, query entities.SearchQuery) (entities.SearchPage, error) {
+	ctx, span := r.tracer.Start(ctx, "EventRepository.SearchEvents")
+	page, err := r.next.SearchEvents(ctx, query)
+	tracing.End(span, err)
+	return page, err
+}
+
+func (r *TracedEventRepository) UpdateEvent(ctx context.Context, event entities.Event) (entities.Event, error) {
+	ctx, span := r.tracer.Start(ctx, "EventRepository.UpdateEvent")
+	updated, err := r.next.UpdateEvent(ctx, event)
+	tracing.End(span, err)
+	return updated, err
+}
+
+func (r *TracedEventRepository) UpdateEvents(ctx context.Context, events []entities.Event) ([]entities.Event, error) {
+	ctx, span := r.tracer.Start(ctx, "EventRepository.UpdateEvents")
+	updated, err := r.next.UpdateEvents(ctx, events)
+	tracing.End(span, err)
+	return updated, err
+}
+
+func (r *TracedEventRepository) DeleteEvent(ctx context.Context, id string, deletedAt time.Time) error {
+	ctx, span := r.tracer.Start(ctx, "EventRepository.DeleteEvent")
+	err := r.next.DeleteEvent(ctx, id, deletedAt)
+	tracing.End(span, err)
+	return err
+}
+
+func (r *TracedEventRepository) RestoreEvent(ctx context.Context, id string) (entities.Event, error) {
+	ctx, span := r.tracer.Start(ctx, "EventRepository.RestoreEvent")
+	event, err := r.next.RestoreEvent(ctx, id)
+	tracing.End(span, err)
+	return event, err
+}
+
+func (r *TracedEventRepository) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	ctx, span := r.tracer.Start(ctx, "EventRepository.PurgeDeleted")
+	purged, err := r.next.PurgeDeleted(ctx, before)
+	tracing.End(span, err)
+	return purged, err
+}
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracedEventRepository(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	repo := NewTracedEventRepository(NewMemoryEventRepository(logrus.New()), provider.Tracer("test"))
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "EventService.CreateEvent")
+	_, err := repo.CreateEvent(ctx, entities.Event{Name: "VPN", Type: "Incidente", Description: "d", Status: entities.StatusPending})
+	require.NoError(t, err)
+	_, err = repo.GetEventByID(ctx, "missing")
+	assert.Equal(t, ErrEventNotfound, err)
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+	assert.Equal(t, "EventRepository.CreateEvent", spans[0].Name())
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	assert.Equal(t, "EventRepository.GetEventByID", spans[1].Name())
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+}
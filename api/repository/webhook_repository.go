@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/logging"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// WebhookRepository guarda las suscripciones de webhooks, el registro de sus
+// envíos y los envíos que agotaron los reintentos.
+type WebhookRepository interface {
+	CreateWebhook(ctx context.Context, webhook entities.Webhook) (entities.Webhook, error)
+	GetWebhook(ctx context.Context, id string) (entities.Webhook, error)
+	ListWebhooks(ctx context.Context) ([]entities.Webhook, error)
+	UpdateWebhook(ctx context.Context, webhook entities.Webhook) (entities.Webhook, error)
+	DeleteWebhook(ctx context.Context, id string) error
+	// SaveDelivery crea o reemplaza el registro de un envío.
+	SaveDelivery(ctx context.Context, delivery entities.WebhookDelivery) error
+	// ListDeliveries devuelve los últimos limit envíos de un webhook, del más
+	// reciente al más antiguo.
+	ListDeliveries(ctx context.Context, webhookID string, limit int) ([]entities.WebhookDelivery, error)
+	AddDeadLetter(ctx context.Context, delivery entities.WebhookDelivery) error
+	ListDeadLetters(ctx context.Context, webhookID string, limit int) ([]entities.WebhookDelivery, error)
+}
+
+type MongoWebhookRepository struct {
+	db       *mongo.Client
+	database string
+	logger   logrus.FieldLogger
+}
+
+// NewMongoWebhookRepository solo usa WithDatabase de opts: sus colecciones
+// están siempre en la base compartida, también con WithDatabasePerTenant.
+func NewMongoWebhookRepository(db *mongo.Client, logger logrus.FieldLogger, opts ...MongoOption) *MongoWebhookRepository {
+	return &MongoWebhookRepository{
+		db:       db,
+		database: newMongoOptions(opts).database,
+		logger:   logger,
+	}
+}
+
+func (r *MongoWebhookRepository) webhooks() *mongo.Collection {
+	return r.db.Database(r.database).Collection("webhooks")
+}
+
+func (r *MongoWebhookRepository) deliveries() *mongo.Collection {
+	return r.db.Database(r.database).Collection("webhook_deliveries")
+}
+
+func (r *MongoWebhookRepository) deadLetters() *mongo.Collection {
+	return r.db.Database(r.database).Collection("webhook_dead_letters")
+}
+
+func (r *MongoWebhookRepository) CreateWebhook(ctx context.Context, webhook entities.Webhook) (entities.Webhook, error) {
+	webhook.ID = ""
+	result, err := r.webhooks().InsertOne(ctx, webhook)
+	if err != nil {
+		logging.For(ctx, r.logger, "webhook_repository", "CreateWebhook").WithError(err).Error("operación fallida")
+		return entities.Webhook{}, err
+	}
+	webhook.ID = result.InsertedID.(primitive.ObjectID).Hex()
+	return webhook, nil
+}
+
+func (r *MongoWebhookRepository) GetWebhook(ctx context.Context, id string) (entities.Webhook, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return entities.Webhook{}, ErrWebhookNotFound
+	}
+
+	var webhook entities.Webhook
+	err = r.webhooks().FindOne(ctx, bson.D{{Key: "_id", Value: oid}}).Decode(&webhook)
+	if err == mongo.ErrNoDocuments {
+		return entities.Webhook{}, ErrWebhookNotFound
+	}
+	if err != nil {
+		logging.For(ctx, r.logger, "webhook_repository", "GetWebhook").WithError(err).Error("operación fallida")
+		return entities.Webhook{}, err
+	}
+	return webhook, nil
+}
+
+// ListWebhooks devuelve los webhooks del más antiguo al más reciente.
+func (r *MongoWebhookRepository) ListWebhooks(ctx context.Context) ([]entities.Webhook, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}})
+	cursor, err := r.webhooks().Find(ctx, bson.D{}, opts)
+	if err != nil {
+		logging.For(ctx, r.logger, "webhook_repository", "ListWebhooks").WithError(err).Error("operación fallida")
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	webhooks := []entities.Webhook{}
+	if err := cursor.All(ctx, &webhooks); err != nil {
+		logging.For(ctx, r.logger, "webhook_repository", "ListWebhooks").WithError(err).Error("operación fallida")
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+func (r *MongoWebhookRepository) UpdateWebhook(ctx context.Context, webhook entities.Webhook) (entities.Webhook, error) {
+	oid, err := primitive.ObjectIDFromHex(webhook.ID)
+	if err != nil {
+		return entities.Webhook{}, ErrWebhookNotFound
+	}
+
+	update := bson.M{"$set": bson.M{
+		"url":      webhook.URL,
+		"triggers": webhook.Triggers,
+		"secret":   webhook.Secret,
+		"active":   webhook.Active,
+	}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	var updated entities.Webhook
+	err = r.webhooks().FindOneAndUpdate(ctx, bson.D{{Key: "_id", Value: oid}}, update, opts).Decode(&updated)
+	if err == mongo.ErrNoDocuments {
+		return entities.Webhook{}, ErrWebhookNotFound
+	}
+	if err != nil {
+		logging.For(ctx, r.logger, "webhook_repository", "UpdateWebhook").WithError(err).Error("operación fallida")
+		return entities.Webhook{}, err
+	}
+	return updated, nil
+}
+
+func (r *MongoWebhookRepository) DeleteWebhook(ctx context.Context, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return ErrWebhookNotFound
+	}
+	res, err := r.webhooks().DeleteOne(ctx, bson.D{{Key: "_id", Value: oid}})
+	if err != nil {
+		logging.For(ctx, r.logger, "webhook_repository", "DeleteWebhook").WithError(err).Error("operación fallida")
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return ErrWebhookNotFound
+	}
+	return nil
+}
+
+func (r *MongoWebhookRepository) SaveDelivery(ctx context.Context, delivery entities.WebhookDelivery) error {
+	opts := options.Replace().SetUpsert(true)
+	_, err := r.deliveries().ReplaceOne(ctx, bson.D{{Key: "_id", Value: delivery.ID}}, delivery, opts)
+	if err != nil {
+		logging.For(ctx, r.logger, "webhook_repository", "SaveDelivery").WithError(err).Error("operación fallida")
+	}
+	return err
+}
+
+func (r *MongoWebhookRepository) ListDeliveries(ctx context.Context, webhookID string, limit int) ([]entities.WebhookDelivery, error) {
+	return r.listDeliveries(ctx, r.deliveries(), webhookID, limit)
+}
+
+func (r *MongoWebhookRepository) AddDeadLetter(ctx context.Context, delivery entities.WebhookDelivery) error {
+	opts := options.Replace().SetUpsert(true)
+	_, err := r.deadLetters().ReplaceOne(ctx, bson.D{{Key: "_id", Value: delivery.ID}}, delivery, opts)
+	if err != nil {
+		logging.For(ctx, r.logger, "webhook_repository", "AddDeadLetter").WithError(err).Error("operación fallida")
+	}
+	return err
+}
+
+func (r *MongoWebhookRepository) ListDeadLetters(ctx context.Context, webhookID string, limit int) ([]entities.WebhookDelivery, error) {
+	return r.listDeliveries(ctx, r.deadLetters(), webhookID, limit)
+}
+
+func (r *MongoWebhookRepository) listDeliveries(ctx context.Context, coll *mongo.Collection, webhookID string, limit int) ([]entities.WebhookDelivery, error) {
+	filter := bson.D{{Key: "webhook_id", Value: webhookID}}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := coll.Find(ctx, filter, opts)
+	if err != nil {
+		logging.For(ctx, r.logger, "webhook_repository", "listDeliveries").WithError(err).Error("operación fallida")
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	deliveries := []entities.WebhookDelivery{}
+	if err := cursor.All(ctx, &deliveries); err != nil {
+		logging.For(ctx, r.logger, "webhook_repository", "listDeliveries").WithError(err).Error("operación fallida")
+		return nil, err
+	}
+	return deliveries, nil
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// runWebhookRepositoryConformance ejecuta el mismo contrato contra cualquier
+// implementación de WebhookRepository. newRepo debe devolver un repositorio vacío.
+func runWebhookRepositoryConformance(t *testing.T, newRepo func(t *testing.T) WebhookRepository) {
+	ctx := context.Background()
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Webhook CRUD", func(t *testing.T) {
+		repo := newRepo(t)
+
+		created, err := repo.CreateWebhook(ctx, entities.Webhook{
+			URL:       "https://example.com/hook",
+			Triggers:  []string{entities.TriggerNeedsAction},
+			Secret:    "s3cr3t",
+			Active:    true,
+			CreatedAt: base,
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, created.ID)
+		second, err := repo.CreateWebhook(ctx, entities.Webhook{URL: "https://example.com/other", Triggers: []string{entities.TriggerDelete}})
+		require.NoError(t, err)
+
+		found, err := repo.GetWebhook(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "s3cr3t", found.Secret)
+		assert.True(t, base.Equal(found.CreatedAt))
+
+		created.URL = "https://example.com/new"
+		created.Triggers = []string{entities.TriggerDelete, entities.TriggerStatusChange}
+		created.Active = false
+		updated, err := repo.UpdateWebhook(ctx, created)
+		require.NoError(t, err)
+		assert.Equal(t, "https://example.com/new", updated.URL)
+		assert.Equal(t, []string{entities.TriggerDelete, entities.TriggerStatusChange}, updated.Triggers)
+		assert.False(t, updated.Active)
+
+		all, err := repo.ListWebhooks(ctx)
+		require.NoError(t, err)
+		require.Len(t, all, 2)
+		assert.Equal(t, created.ID, all[0].ID)
+		assert.Equal(t, second.ID, all[1].ID)
+
+		require.NoError(t, repo.DeleteWebhook(ctx, created.ID))
+		_, err = repo.GetWebhook(ctx, created.ID)
+		assert.Equal(t, ErrWebhookNotFound, err)
+		assert.Equal(t, ErrWebhookNotFound, repo.DeleteWebhook(ctx, created.ID))
+		_, err = repo.UpdateWebhook(ctx, created)
+		assert.Equal(t, ErrWebhookNotFound, err)
+		_, err = repo.GetWebhook(ctx, "not-an-id")
+		assert.Equal(t, ErrWebhookNotFound, err)
+	})
+
+	t.Run("Deliveries and dead letters", func(t *testing.T) {
+		repo := newRepo(t)
+		delivery := func(webhookID string, at time.Time) entities.WebhookDelivery {
+			return entities.WebhookDelivery{
+				ID:            primitive.NewObjectID().Hex(),
+				WebhookID:     webhookID,
+				EventID:       "e1",
+				Trigger:       entities.TriggerNeedsAction,
+				Payload:       `{"trigger":"needs_action"}`,
+				Status:        entities.DeliveryRetrying,
+				Attempts:      1,
+				CreatedAt:     at,
+				LastAttemptAt: at,
+			}
+		}
+
+		old := delivery("w1", base)
+		recent := delivery("w1", base.Add(time.Minute))
+		require.NoError(t, repo.SaveDelivery(ctx, old))
+		require.NoError(t, repo.SaveDelivery(ctx, recent))
+		require.NoError(t, repo.SaveDelivery(ctx, delivery("w2", base)))
+
+		old.Status = entities.DeliveryDelivered
+		old.Attempts = 2
+		old.StatusCode = 204
+		require.NoError(t, repo.SaveDelivery(ctx, old))
+
+		deliveries, err := repo.ListDeliveries(ctx, "w1", 10)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 2)
+		assert.Equal(t, recent.ID, deliveries[0].ID)
+		assert.Equal(t, old.ID, deliveries[1].ID)
+		assert.Equal(t, entities.DeliveryDelivered, deliveries[1].Status)
+		assert.Equal(t, 2, deliveries[1].Attempts)
+
+		deliveries, err = repo.ListDeliveries(ctx, "w1", 1)
+		require.NoError(t, err)
+		assert.Len(t, deliveries, 1)
+
+		dead := recent
+		dead.Status = entities.DeliveryDead
+		require.NoError(t, repo.AddDeadLetter(ctx, dead))
+		letters, err := repo.ListDeadLetters(ctx, "w1", 10)
+		require.NoError(t, err)
+		require.Len(t, letters, 1)
+		assert.Equal(t, `{"trigger":"needs_action"}`, letters[0].Payload)
+
+		letters, err = repo.ListDeadLetters(ctx, "w2", 10)
+		require.NoError(t, err)
+		assert.Empty(t, letters)
+	})
+}
@@ -0,0 +1,113 @@
+package rules
+
+import (
+	"context"
+	"errors"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/logging"
+	"prueba_tecnica/api/taxonomy"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+var ErrNoRules = errors.New("la fuente de reglas no devolvió ninguna regla")
+
+// Engine clasifica eventos con la primera regla que coincide. Las reglas se
+// pueden recargar en caliente; si una recarga falla se conservan las anteriores.
+type Engine struct {
+	source   Source
+	logger   logrus.FieldLogger
+	taxonomy *taxonomy.Taxonomy
+
+	mu    sync.RWMutex
+	rules []Rule
+}
+
+// Option configura dependencias opcionales del motor.
+type Option func(*Engine)
+
+// WithTaxonomy valida las reglas y elige la categoría por defecto con t en
+// lugar de la taxonomía por defecto.
+func WithTaxonomy(t *taxonomy.Taxonomy) Option {
+	return func(e *Engine) {
+		e.taxonomy = t
+	}
+}
+
+// NewEngine arranca con DefaultRules hasta que se llame a Reload.
+func NewEngine(source Source, logger logrus.FieldLogger, opts ...Option) *Engine {
+	e := &Engine{
+		source:   source,
+		logger:   logger,
+		taxonomy: taxonomy.Default(),
+		rules:    DefaultRules(),
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+func (e *Engine) Reload(ctx context.Context) error {
+	rules, err := e.source.Load(ctx)
+	if err != nil {
+		logging.Layer(e.logger, "rules_engine", "Reload").WithError(err).Error("operación fallida")
+		return err
+	}
+	if len(rules) == 0 {
+		logging.Layer(e.logger, "rules_engine", "Reload").WithError(ErrNoRules).Error("operación fallida")
+		return ErrNoRules
+	}
+	for _, r := range rules {
+		if err := r.validate(e.taxonomy); err != nil {
+			logging.Layer(e.logger, "rules_engine", "Reload").WithError(err).Error("operación fallida")
+			return err
+		}
+	}
+
+	e.mu.Lock()
+	e.rules = rules
+	e.mu.Unlock()
+
+	logging.Layer(e.logger, "rules_engine", "Reload").WithField("count", len(rules)).Debug("reglas cargadas")
+	return nil
+}
+
+// Watch recarga las reglas cada interval hasta que ctx se cancele.
+func (e *Engine) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_ = e.Reload(ctx)
+		}
+	}
+}
+
+// Rules devuelve una copia de las reglas vigentes.
+func (e *Engine) Rules() []Rule {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return append([]Rule(nil), e.rules...)
+}
+
+func (e *Engine) Classify(event entities.Event) entities.Classification {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	for _, r := range e.rules {
+		if r.matches(event) {
+			return entities.Classification{
+				Rule:        r.Name,
+				Category:    r.Category,
+				NeedsAction: r.NeedsAction,
+			}
+		}
+	}
+	return entities.Classification{Category: e.taxonomy.DefaultCategory()}
+}
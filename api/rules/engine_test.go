@@ -0,0 +1,122 @@
+package rules
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"prueba_tecnica/api/entities"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEngineClassify(t *testing.T) {
+	engine := NewEngine(StaticSource{
+		{Name: "vpn", Keywords: []string{"VPN"}, Statuses: []string{"Revisado"}, Category: "Requiere gestión", NeedsAction: true},
+		{Name: "incidentes", Types: []string{"incidente"}, Category: "Requiere gestión", NeedsAction: true},
+		{Name: "reuniones", Types: []string{"Reunión"}, Category: "Sin gestión"},
+	}, logrus.New())
+	require.NoError(t, engine.Reload(context.Background()))
+
+	testCases := []struct {
+		name     string
+		event    entities.Event
+		expected entities.Classification
+	}{
+		{
+			name:     "Keyword in description",
+			event:    entities.Event{Type: "Reunión", Description: "Revisar la vpn", Status: "Revisado"},
+			expected: entities.Classification{Rule: "vpn", Category: "Requiere gestión", NeedsAction: true},
+		},
+		{
+			name:     "Keyword with a non matching status",
+			event:    entities.Event{Type: "Reunión", Name: "VPN", Status: "Pendiente por revisar"},
+			expected: entities.Classification{Rule: "reuniones", Category: "Sin gestión"},
+		},
+		{
+			name:     "Type ignores case",
+			event:    entities.Event{Type: "INCIDENTE"},
+			expected: entities.Classification{Rule: "incidentes", Category: "Requiere gestión", NeedsAction: true},
+		},
+		{
+			name:     "No rule matches",
+			event:    entities.Event{Type: "Conferencia"},
+			expected: entities.Classification{Category: DefaultCategory},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, engine.Classify(tc.event))
+		})
+	}
+}
+
+func TestEngineDefaultRules(t *testing.T) {
+	engine := NewEngine(StaticSource(DefaultRules()), logrus.New())
+
+	for _, typ := range []string{"Incidente", "Problema", "Emergencia", "Error", "Critico"} {
+		c := engine.Classify(entities.Event{Type: typ})
+		assert.Equal(t, entities.CategoryNeedsAction, c.Category, typ)
+		assert.True(t, c.NeedsAction, typ)
+	}
+	for _, typ := range []string{"Reunión", "Informe", "Actualización", "Notificación", "Consulta", "Otro"} {
+		c := engine.Classify(entities.Event{Type: typ})
+		assert.Equal(t, entities.CategoryNoAction, c.Category, typ)
+		assert.False(t, c.NeedsAction, typ)
+	}
+}
+
+func TestEngineReloadFromFile(t *testing.T) {
+	dir := t.TempDir()
+	yamlPath := filepath.Join(dir, "rules.yaml")
+	jsonPath := filepath.Join(dir, "rules.json")
+
+	require.NoError(t, os.WriteFile(yamlPath, []byte(`
+rules:
+  - name: todo
+    category: Requiere gestión
+    needs_action: true
+`), 0o644))
+	require.NoError(t, os.WriteFile(jsonPath, []byte(`{"rules": [{"name": "json", "types": ["Informe"], "category": "Sin gestión"}]}`), 0o644))
+
+	engine := NewEngine(FileSource{Path: yamlPath}, logrus.New())
+	require.NoError(t, engine.Reload(context.Background()))
+	assert.Equal(t, "todo", engine.Classify(entities.Event{Type: "Informe"}).Rule)
+
+	// Una recarga con el archivo modificado aplica las nuevas reglas.
+	require.NoError(t, os.WriteFile(yamlPath, []byte(`
+rules:
+  - name: informes
+    types: [Informe]
+    category: Sin gestión
+`), 0o644))
+	require.NoError(t, engine.Reload(context.Background()))
+	assert.Equal(t, "informes", engine.Classify(entities.Event{Type: "Informe"}).Rule)
+
+	jsonEngine := NewEngine(FileSource{Path: jsonPath}, logrus.New())
+	require.NoError(t, jsonEngine.Reload(context.Background()))
+	assert.Equal(t, "json", jsonEngine.Classify(entities.Event{Type: "informe"}).Rule)
+}
+
+func TestEngineReloadKeepsRulesOnError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules.yaml")
+	engine := NewEngine(FileSource{Path: path}, logrus.New())
+
+	// Archivo inexistente, categoría inválida y lista vacía.
+	assert.Error(t, engine.Reload(context.Background()))
+	require.NoError(t, os.WriteFile(path, []byte("rules:\n  - name: x\n    category: Otra\n"), 0o644))
+	assert.Error(t, engine.Reload(context.Background()))
+	require.NoError(t, os.WriteFile(path, []byte("rules: []\n"), 0o644))
+	assert.Equal(t, ErrNoRules, engine.Reload(context.Background()))
+
+	assert.Equal(t, DefaultRules(), engine.Rules())
+}
+
+func TestShippedRulesFile(t *testing.T) {
+	engine := NewEngine(FileSource{Path: "../../config/classification_rules.yaml"}, logrus.New())
+	require.NoError(t, engine.Reload(context.Background()))
+	assert.NotEmpty(t, engine.Rules())
+}
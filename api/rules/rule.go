@@ -0,0 +1,89 @@
+package rules
+
+import (
+	"fmt"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/taxonomy"
+	"strings"
+)
+
+// Rule asigna Category y NeedsAction a los eventos que cumplen todas sus
+// condiciones. Dentro de cada lista basta con que coincida un elemento y una
+// lista vacía no restringe, así que una regla sin condiciones atrapa todo.
+type Rule struct {
+	Name        string            `json:"name" yaml:"name" bson:"name"`
+	Priority    int               `json:"priority,omitempty" yaml:"priority,omitempty" bson:"priority"`
+	Types       []string          `json:"types,omitempty" yaml:"types,omitempty" bson:"types,omitempty"`
+	Keywords    []string          `json:"keywords,omitempty" yaml:"keywords,omitempty" bson:"keywords,omitempty"`
+	Statuses    []string          `json:"statuses,omitempty" yaml:"statuses,omitempty" bson:"statuses,omitempty"`
+	Category    entities.Category `json:"category" yaml:"category" bson:"category"`
+	NeedsAction bool              `json:"needs_action" yaml:"needs_action" bson:"needs_action"`
+}
+
+// DefaultCategory es la categoría por defecto de la taxonomía por defecto. Con
+// otra taxonomía se asigna la categoría por defecto de esa.
+const DefaultCategory = entities.CategoryNoAction
+
+// DefaultRules reproduce la clasificación por tipo original del servicio.
+func DefaultRules() []Rule {
+	return []Rule{
+		{
+			Name:        "requiere-gestion",
+			Types:       []string{"Incidente", "Problema", "Emergencia", "Error", "Critico"},
+			Category:    entities.CategoryNeedsAction,
+			NeedsAction: true,
+		},
+		{
+			Name:     "sin-gestion",
+			Types:    []string{"Reunión", "Informe", "Actualización", "Notificación", "Consulta"},
+			Category: entities.CategoryNoAction,
+		},
+	}
+}
+
+func (r Rule) validate(t *taxonomy.Taxonomy) error {
+	if r.Name == "" {
+		return fmt.Errorf("regla sin nombre")
+	}
+	if !t.ValidCategory(r.Category) {
+		return fmt.Errorf("regla %q: categoría inválida %q", r.Name, r.Category)
+	}
+	for _, s := range r.Statuses {
+		if !t.ValidStatus(entities.Status(s)) {
+			return fmt.Errorf("regla %q: estado inválido %q", r.Name, s)
+		}
+	}
+	return nil
+}
+
+func (r Rule) matches(event entities.Event) bool {
+	if len(r.Types) > 0 && !anyEqualFold(r.Types, event.Type) {
+		return false
+	}
+	if len(r.Statuses) > 0 && !anyEqualFold(r.Statuses, string(event.Status)) {
+		return false
+	}
+	if len(r.Keywords) > 0 {
+		text := strings.ToLower(event.Name + "\n" + event.Description)
+		found := false
+		for _, k := range r.Keywords {
+			if strings.Contains(text, strings.ToLower(k)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func anyEqualFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package rules
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"gopkg.in/yaml.v3"
+)
+
+// Source entrega las reglas en el orden en que deben evaluarse.
+type Source interface {
+	Load(ctx context.Context) ([]Rule, error)
+}
+
+// StaticSource siempre devuelve las mismas reglas.
+type StaticSource []Rule
+
+func (s StaticSource) Load(ctx context.Context) ([]Rule, error) {
+	return s, nil
+}
+
+// FileSource lee las reglas de un archivo YAML o JSON (según la extensión)
+// con la forma {"rules": [...]}. Se evalúan en el orden del archivo.
+type FileSource struct {
+	Path string
+}
+
+type ruleFile struct {
+	Rules []Rule `json:"rules" yaml:"rules"`
+}
+
+func (s FileSource) Load(ctx context.Context) ([]Rule, error) {
+	b, err := os.ReadFile(s.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file ruleFile
+	switch strings.ToLower(filepath.Ext(s.Path)) {
+	case ".json":
+		err = json.Unmarshal(b, &file)
+	default:
+		err = yaml.Unmarshal(b, &file)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", s.Path, err)
+	}
+	return file.Rules, nil
+}
+
+// MongoSource lee las reglas de una colección, ordenadas por priority ascendente.
+type MongoSource struct {
+	Collection *mongo.Collection
+}
+
+// NewMongoSource lee las reglas de la colección classification_rules de la
+// base database.
+func NewMongoSource(client *mongo.Client, database string) MongoSource {
+	return MongoSource{Collection: client.Database(database).Collection("classification_rules")}
+}
+
+func (s MongoSource) Load(ctx context.Context) ([]Rule, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "priority", Value: 1}, {Key: "_id", Value: 1}})
+	cursor, err := s.Collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	var rules []Rule
+	if err := cursor.All(ctx, &rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
@@ -1,46 +1,343 @@
 package server
 
 import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"prueba_tecnica/api/auth"
+	"prueba_tecnica/api/changes"
+	"prueba_tecnica/api/config"
 	_ "prueba_tecnica/api/docs"
 	"prueba_tecnica/api/endpoints"
+	"prueba_tecnica/api/health"
+	"prueba_tecnica/api/logging"
+	"prueba_tecnica/api/metrics"
+	pb "prueba_tecnica/api/pb/event"
 	"prueba_tecnica/api/repository"
+	"prueba_tecnica/api/rules"
 	"prueba_tecnica/api/service"
+	"prueba_tecnica/api/taxonomy"
+	"prueba_tecnica/api/tracing"
+	transport "prueba_tecnica/api/transports/grpc"
 	transports "prueba_tecnica/api/transports/http"
+	"prueba_tecnica/api/webhooks"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
+// readinessTimeout limita cada comprobación de /readyz y grpc.health.v1.
+const readinessTimeout = 2 * time.Second
+
+// disconnectTimeout limita el cierre de la conexión a MongoDB al detenerse.
+const disconnectTimeout = 5 * time.Second
+
+// changeFeedBuffer es cuántos cambios puede acumular un suscriptor del feed
+// antes de que se lo desconecte por lento.
+const changeFeedBuffer = 256
+
 type Server struct {
 	router  *gin.Engine
 	grpcSrv *grpc.Server
 	client  *mongo.Client
 	logger  logrus.FieldLogger
+	config  config.Config
 }
 
-func NewServer(client *mongo.Client, logger logrus.FieldLogger) *Server {
-	router := gin.Default()
+func NewServer(client *mongo.Client, logger logrus.FieldLogger, cfg config.Config) *Server {
+	// gin.Logger no se usa: RequestLogger escribe cada petición con los
+	// campos del resto del log.
+	router := gin.New()
+	router.Use(gin.Recovery())
 	return &Server{
 		router: router,
 		client: client,
 		logger: logger,
+		config: cfg,
 	}
 }
 
-func (s *Server) Run() {
-	eventRepo := repository.NewMongoEventRepository(s.client, s.logger)
-	eventService := service.NewEventService(eventRepo, s.logger)
+// Run levanta los transportes HTTP y gRPC y bloquea hasta que ctx se cancele
+// o alguno de los dos falle. Al detenerse deja de estar listo, espera a las
+// peticiones en curso hasta config.ShutdownTimeout y al final cierra la
+// conexión a MongoDB.
+func (s *Server) Run(ctx context.Context) error {
+	defer s.disconnect()
+
+	var eventRepo repository.EventRepository
+	var auditRepo repository.AuditRepository
+	var webhookRepo repository.WebhookRepository
+	var apiKeyRepo repository.APIKeyRepository
+	if s.client != nil {
+		opts := []repository.MongoOption{
+			repository.WithDatabase(s.config.Mongo.Database),
+			repository.WithEventCollection(s.config.Mongo.Collection),
+		}
+		if s.config.Tenancy.Mode == config.TenancyDatabase {
+			opts = append(opts, repository.WithDatabasePerTenant())
+		}
+		mongoEvents := repository.NewMongoEventRepository(s.client, s.logger, opts...)
+		// Sin el índice de texto el servicio arranca igual: la primera
+		// búsqueda lo vuelve a intentar.
+		if err := mongoEvents.EnsureIndexes(ctx); err != nil {
+			logging.Layer(s.logger, "server", "Run").WithError(err).Warn("no se pudo crear el índice de búsqueda")
+		}
+		eventRepo = mongoEvents
+		auditRepo = repository.NewMongoAuditRepository(s.client, s.logger, opts...)
+		webhookRepo = repository.NewMongoWebhookRepository(s.client, s.logger, opts...)
+		apiKeyRepo = repository.NewMongoAPIKeyRepository(s.client, s.logger, opts...)
+	} else {
+		logging.Layer(s.logger, "server", "Run").Warn("sin cliente de MongoDB, usando repositorio en memoria")
+		eventRepo = repository.NewMemoryEventRepository(s.logger)
+		auditRepo = repository.NewMemoryAuditRepository()
+		webhookRepo = repository.NewMemoryWebhookRepository()
+		apiKeyRepo = repository.NewMemoryAPIKeyRepository()
+	}
+
+	// Los totales de eventos se cuentan sobre el repositorio sin envolver; las
+	// operaciones se miden en el decorador.
+	observability := metrics.New()
+	if counter, ok := eventRepo.(repository.EventCounter); ok {
+		if err := observability.Register(metrics.NewEventsCollector(counter, s.logger)); err != nil {
+			return err
+		}
+	}
+	eventRepo = repository.NewInstrumentedEventRepository(eventRepo, observability)
+	eventRepo = repository.NewTracedEventRepository(eventRepo, tracing.Tracer())
+
+	vocabulary, err := s.taxonomy()
+	if err != nil {
+		logging.Layer(s.logger, "server", "Run").WithError(err).Error("operación fallida")
+		return err
+	}
+	if err := transports.UseTaxonomy(vocabulary); err != nil {
+		return err
+	}
+
+	rulesSource, err := s.rulesSource()
+	if err != nil {
+		logging.Layer(s.logger, "server", "Run").WithError(err).Error("operación fallida")
+		return err
+	}
+	classifier := rules.NewEngine(rulesSource, s.logger, rules.WithTaxonomy(vocabulary))
+	if err := classifier.Reload(ctx); err != nil {
+		return err
+	}
+
+	broker := changes.NewBroker(changeFeedBuffer, s.logger)
+	// Los webhooks tienen su propia cola: una suscripción al broker se corta
+	// si se queda atrás y perdería cambios.
+	dispatcher := webhooks.NewDispatcher(webhookRepo, s.config.Webhooks, s.logger)
+	eventService := service.NewEventService(eventRepo, s.logger,
+		service.WithClassifier(classifier),
+		service.WithAuditLog(auditRepo),
+		service.WithPublisher(broker),
+		service.WithNotifier(dispatcher),
+		service.WithTaxonomy(vocabulary),
+	)
+	webhookService := service.NewWebhookService(webhookRepo, s.logger)
+	apiKeyService := service.NewAPIKeyService(apiKeyRepo, s.logger)
+
+	authenticator, err := s.authenticator(ctx, apiKeyRepo)
+	if err != nil {
+		return err
+	}
+	// Los permisos por rol solo se aplican si hay un principal autenticado.
+	if authenticator != nil {
+		eventService = service.NewAuthorizedEventService(eventService, s.logger)
+		webhookService = service.NewAuthorizedWebhookService(webhookService, s.logger)
+		apiKeyService = service.NewAuthorizedAPIKeyService(apiKeyService, s.logger)
+	}
+	eventService = service.NewTracedEventService(eventService, tracing.Tracer())
+	webhookService = service.NewTracedWebhookService(webhookService, tracing.Tracer())
+	apiKeyService = service.NewTracedAPIKeyService(apiKeyService, tracing.Tracer())
 	eventEndpoints := endpoints.NewEventEndpoints(eventService)
 
-	transports.NewEventRouter(s.router, eventEndpoints, s.logger)
+	checker := health.NewChecker(readinessTimeout)
+	if s.client != nil {
+		checker.Add("mongo", health.Mongo(s.client))
+	}
+
+	unary := []grpc.UnaryServerInterceptor{transport.TenantUnaryInterceptor(s.logger), transport.AuditUnaryInterceptor()}
+	stream := []grpc.StreamServerInterceptor{transport.TenantStreamInterceptor(s.logger), transport.AuditStreamInterceptor()}
+	if authenticator != nil {
+		unary = append([]grpc.UnaryServerInterceptor{transport.AuthUnaryInterceptor(authenticator, s.logger)}, unary...)
+		stream = append([]grpc.StreamServerInterceptor{transport.AuthStreamInterceptor(authenticator, s.logger)}, stream...)
+	}
+	unary = append([]grpc.UnaryServerInterceptor{transport.MetricsUnaryInterceptor(observability), transport.LoggingUnaryInterceptor(s.logger)}, unary...)
+	stream = append([]grpc.StreamServerInterceptor{transport.MetricsStreamInterceptor(observability), transport.LoggingStreamInterceptor(s.logger)}, stream...)
+	s.grpcSrv = grpc.NewServer(
+		grpc.StatsHandler(transport.TracingHandler()),
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	)
 
+	s.router.Use(transports.Metrics(observability))
+	s.router.Use(transports.Tracing(s.config.Tracing.ServiceName))
+	s.router.Use(transports.RequestLogger(s.logger))
+	// Las rutas registradas antes de la autenticación, como Swagger, las
+	// sondas de salud y /metrics, quedan públicas.
 	s.setupSwagger()
+	transports.NewHealthRouter(s.router, checker, s.logger)
+	transports.MetricsHandler(s.router, observability.Handler())
+	if authenticator != nil {
+		s.router.Use(transports.Authenticate(authenticator, s.logger))
+	}
+	s.router.Use(transports.ResolveTenant(s.logger))
+
+	transports.NewEventRouter(s.router, eventEndpoints, s.logger)
+	transports.NewTaxonomyRouter(s.router, eventEndpoints, s.logger)
+	transports.NewWebhookRouter(s.router, endpoints.NewWebhookEndpoints(webhookService), s.logger)
+	transports.NewAPIKeyRouter(s.router, endpoints.NewAPIKeyEndpoints(apiKeyService), s.logger)
+	pb.RegisterEventServiceServer(s.grpcSrv, transport.NewEventHandler(eventEndpoints, s.logger))
+	healthpb.RegisterHealthServer(s.grpcSrv, transport.NewHealthHandler(checker, s.logger))
+
+	lis, err := net.Listen("tcp", s.config.GRPC.Addr)
+	if err != nil {
+		logging.Layer(s.logger, "server", "Run").WithError(err).Error("operación fallida")
+		return err
+	}
+
+	httpSrv := &http.Server{
+		Addr:    s.config.HTTP.Addr,
+		Handler: s.router,
+	}
 
-	s.router.Run(":8080")
+	g, gctx := errgroup.WithContext(ctx)
+
+	g.Go(func() error {
+		logging.Layer(s.logger, "server", "Run").WithField("addr", s.config.HTTP.Addr).Info("HTTP escuchando")
+		if err := httpSrv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	})
+
+	g.Go(func() error {
+		logging.Layer(s.logger, "server", "Run").WithField("addr", s.config.GRPC.Addr).Info("gRPC escuchando")
+		return s.grpcSrv.Serve(lis)
+	})
+
+	if s.config.Rules.Source != "" && s.config.Rules.ReloadInterval > 0 {
+		g.Go(func() error {
+			classifier.Watch(gctx, s.config.Rules.ReloadInterval)
+			return nil
+		})
+	}
+
+	if s.config.Purge.Retention > 0 && s.config.Purge.Interval > 0 {
+		purger := service.NewPurger(eventRepo, s.config.Purge.Retention, s.logger)
+		g.Go(func() error {
+			purger.Run(gctx, s.config.Purge.Interval)
+			return nil
+		})
+	}
+
+	g.Go(func() error {
+		dispatcher.Run(gctx)
+		return nil
+	})
+
+	g.Go(func() error {
+		<-gctx.Done()
+		logging.Layer(s.logger, "server", "Run").Info("deteniendo servidores")
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), s.config.ShutdownTimeout)
+		defer cancel()
+
+		// Las sondas responden que no está listo mientras se drena.
+		checker.Drain()
+		// Cerrar el feed termina los streams abiertos, que si no
+		// bloquearían el apagado.
+		broker.Close()
+
+		grpcStopped := make(chan struct{})
+		go func() {
+			s.grpcSrv.GracefulStop()
+			close(grpcStopped)
+		}()
+		err := httpSrv.Shutdown(shutdownCtx)
+		select {
+		case <-grpcStopped:
+		case <-shutdownCtx.Done():
+			logging.Layer(s.logger, "server", "Run").Warn("se agotó el tiempo de apagado, cerrando las llamadas gRPC en curso")
+			s.grpcSrv.Stop()
+		}
+		return err
+	})
+
+	if err := g.Wait(); err != nil {
+		logging.Layer(s.logger, "server", "Run").WithError(err).Error("operación fallida")
+		return err
+	}
+	return nil
+}
+
+// authenticator arma la autenticación de config.Auth y registra la API key
+// inicial. Devuelve nil si la autenticación no está configurada.
+func (s *Server) authenticator(ctx context.Context, keys repository.APIKeyRepository) (auth.Authenticator, error) {
+	if !s.config.Auth.Enabled() {
+		logging.Layer(s.logger, "server", "authenticator").Warn("autenticación deshabilitada: la API queda abierta")
+		return nil, nil
+	}
+	authenticator, err := auth.New(s.config.Auth, keys, s.logger)
+	if err != nil {
+		return nil, err
+	}
+	if s.config.Auth.BootstrapAPIKey != "" {
+		if err := auth.EnsureAPIKey(ctx, keys, s.config.Auth.BootstrapAPIKey); err != nil {
+			logging.Layer(s.logger, "server", "authenticator").WithError(err).Error("operación fallida")
+			return nil, err
+		}
+	}
+	return authenticator, nil
+}
+
+// taxonomy carga config.TaxonomyFile o, si no hay archivo, la taxonomía por
+// defecto.
+func (s *Server) taxonomy() (*taxonomy.Taxonomy, error) {
+	if s.config.TaxonomyFile == "" {
+		return taxonomy.Default(), nil
+	}
+	return taxonomy.Load(s.config.TaxonomyFile)
+}
+
+func (s *Server) rulesSource() (rules.Source, error) {
+	switch s.config.Rules.Source {
+	case "":
+		return rules.StaticSource(rules.DefaultRules()), nil
+	case "mongo":
+		if s.client == nil {
+			return nil, errors.New("las reglas de clasificación en mongo requieren una conexión a MongoDB")
+		}
+		return rules.NewMongoSource(s.client, s.config.Mongo.Database), nil
+	default:
+		return rules.FileSource{Path: s.config.Rules.Source}, nil
+	}
+}
+
+// disconnect cierra la conexión a MongoDB cuando ya no quedan peticiones ni
+// tareas que la usen.
+func (s *Server) disconnect() {
+	if s.client == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), disconnectTimeout)
+	defer cancel()
+	if err := s.client.Disconnect(ctx); err != nil {
+		logging.Layer(s.logger, "server", "disconnect").WithError(err).Error("operación fallida")
+		return
+	}
+	logging.Layer(s.logger, "server", "disconnect").Info("conexión a MongoDB cerrada")
 }
 
 func (s *Server) setupSwagger() {
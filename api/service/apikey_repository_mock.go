@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type mockAPIKeyRepository struct {
+	mock.Mock
+}
+
+func (m *mockAPIKeyRepository) CreateAPIKey(ctx context.Context, key entities.APIKey) (entities.APIKey, error) {
+	args := m.Called(ctx, key)
+	if fn, ok := args.Get(0).(func(context.Context, entities.APIKey) entities.APIKey); ok {
+		return fn(ctx, key), args.Error(1)
+	}
+	return args.Get(0).(entities.APIKey), args.Error(1)
+}
+
+func (m *mockAPIKeyRepository) GetAPIKeyByHash(ctx context.Context, hash string) (entities.APIKey, error) {
+	args := m.Called(ctx, hash)
+	return args.Get(0).(entities.APIKey), args.Error(1)
+}
+
+func (m *mockAPIKeyRepository) ListAPIKeys(ctx context.Context) ([]entities.APIKey, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]entities.APIKey), args.Error(1)
+}
+
+func (m *mockAPIKeyRepository) RevokeAPIKey(ctx context.Context, id string, at time.Time) (entities.APIKey, error) {
+	args := m.Called(ctx, id, at)
+	return args.Get(0).(entities.APIKey), args.Error(1)
+}
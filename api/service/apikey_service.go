@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"prueba_tecnica/api/apperr"
+	"prueba_tecnica/api/auth"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/logging"
+	"prueba_tecnica/api/repository"
+	"prueba_tecnica/api/tenant"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/sirupsen/logrus"
+)
+
+// APIKeyService administra las API keys con las que se autentican los
+// clientes. La validación de las claves la hace auth.APIKeyAuthenticator. Las
+// claves valen para todos los tenants, así que solo las administra quien no
+// está atado a uno.
+type APIKeyService interface {
+	// CreateAPIKey genera una clave nueva. Es la única vez que se devuelve
+	// la clave en claro, en Key.
+	CreateAPIKey(ctx context.Context, key entities.APIKey) (entities.APIKey, error)
+	ListAPIKeys(ctx context.Context) ([]entities.APIKey, error)
+	// RevokeAPIKey deja de aceptar la clave. La clave se conserva en el
+	// listado para saber quién la usaba.
+	RevokeAPIKey(ctx context.Context, id string) (entities.APIKey, error)
+}
+
+type apiKeyService struct {
+	repo     repository.APIKeyRepository
+	logger   logrus.FieldLogger
+	validate *validator.Validate
+}
+
+func NewAPIKeyService(repo repository.APIKeyRepository, logger logrus.FieldLogger) APIKeyService {
+	return &apiKeyService{
+		repo:     repo,
+		logger:   logger,
+		validate: newValidator(),
+	}
+}
+
+func (s *apiKeyService) CreateAPIKey(ctx context.Context, key entities.APIKey) (entities.APIKey, error) {
+	if err := s.global(ctx, "CreateAPIKey"); err != nil {
+		return entities.APIKey{}, err
+	}
+	if err := s.validate.Struct(key); err != nil {
+		logging.For(ctx, s.logger, "apikey_service", "CreateAPIKey").WithError(err).Error("operación fallida")
+		return entities.APIKey{}, apperr.Invalid(ErrAPIKey, err)
+	}
+	if key.Tenant != "" && !tenant.Valid(key.Tenant) {
+		logging.For(ctx, s.logger, "apikey_service", "CreateAPIKey").WithError(ErrTenant).Error("operación fallida")
+		return entities.APIKey{}, ErrTenant
+	}
+	plain, prefix, hash, err := auth.GenerateAPIKey()
+	if err != nil {
+		logging.For(ctx, s.logger, "apikey_service", "CreateAPIKey").WithError(err).Error("operación fallida")
+		return entities.APIKey{}, err
+	}
+	key.Prefix = prefix
+	key.Hash = hash
+	key.CreatedAt = time.Now()
+	key.RevokedAt = nil
+
+	created, err := s.repo.CreateAPIKey(ctx, key)
+	if err != nil {
+		logging.For(ctx, s.logger, "apikey_service", "CreateAPIKey").WithError(err).Error("operación fallida")
+		return entities.APIKey{}, err
+	}
+	created.Key = plain
+	return created, nil
+}
+
+func (s *apiKeyService) ListAPIKeys(ctx context.Context) ([]entities.APIKey, error) {
+	if err := s.global(ctx, "ListAPIKeys"); err != nil {
+		return nil, err
+	}
+	keys, err := s.repo.ListAPIKeys(ctx)
+	if err != nil {
+		logging.For(ctx, s.logger, "apikey_service", "ListAPIKeys").WithError(err).Error("operación fallida")
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (s *apiKeyService) RevokeAPIKey(ctx context.Context, id string) (entities.APIKey, error) {
+	if err := s.global(ctx, "RevokeAPIKey"); err != nil {
+		return entities.APIKey{}, err
+	}
+	key, err := s.repo.RevokeAPIKey(ctx, id, time.Now())
+	if err != nil {
+		logging.For(ctx, s.logger, "apikey_service", "RevokeAPIKey").WithError(err).Error("operación fallida")
+		return entities.APIKey{}, err
+	}
+	return key, nil
+}
+
+// global rechaza con ErrForbidden a los principales atados a un tenant.
+func (s *apiKeyService) global(ctx context.Context, method string) error {
+	if principal, ok := PrincipalFromContext(ctx); ok && principal.Tenant != "" {
+		logging.For(ctx, s.logger, "apikey_service", method).WithFields(logrus.Fields{"subject": principal.Subject, logging.FieldTenant: principal.Tenant}).WithError(ErrForbidden).Warn("operación rechazada")
+		return ErrForbidden
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"prueba_tecnica/api/auth"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/repository"
+	"prueba_tecnica/api/tenant"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAPIKey(t *testing.T) {
+	testCases := []struct {
+		name          string
+		key           entities.APIKey
+		callsCreate   bool
+		expectedError error
+	}{
+		{name: "Success", key: entities.APIKey{Name: "ci", Subject: "svc-ci", Roles: []string{"operator"}}, callsCreate: true},
+		{name: "Failure - No name", key: entities.APIKey{Subject: "svc-ci", Roles: []string{"operator"}}, expectedError: ErrAPIKey},
+		{name: "Failure - No subject", key: entities.APIKey{Name: "ci", Roles: []string{"operator"}}, expectedError: ErrAPIKey},
+		{name: "Failure - No roles", key: entities.APIKey{Name: "ci", Subject: "svc-ci"}, expectedError: ErrAPIKey},
+		{name: "Failure - Unknown role", key: entities.APIKey{Name: "ci", Subject: "svc-ci", Roles: []string{"root"}}, expectedError: ErrAPIKey},
+		{name: "Success - Bound to a tenant", key: entities.APIKey{Name: "ci", Subject: "svc-ci", Roles: []string{"operator"}, Tenant: "acme"}, callsCreate: true},
+		{name: "Failure - Invalid tenant", key: entities.APIKey{Name: "ci", Subject: "svc-ci", Roles: []string{"operator"}, Tenant: "Acme Corp"}, expectedError: ErrTenant},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(mockAPIKeyRepository)
+			service := NewAPIKeyService(mockRepo, logrus.New())
+
+			if tc.callsCreate {
+				mockRepo.On("CreateAPIKey", mock.Anything, mock.MatchedBy(func(k entities.APIKey) bool {
+					return k.Key == "" && len(k.Hash) == 64 && strings.HasPrefix(k.Prefix, auth.APIKeyPrefix) && !k.CreatedAt.IsZero()
+				})).Return(func(_ context.Context, k entities.APIKey) entities.APIKey {
+					k.ID = "k1"
+					return k
+				}, nil)
+			}
+
+			created, err := service.CreateAPIKey(context.Background(), tc.key)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "k1", created.ID)
+				assert.True(t, strings.HasPrefix(created.Key, created.Prefix))
+				assert.Equal(t, auth.HashAPIKey(created.Key), created.Hash)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRevokeAPIKey(t *testing.T) {
+	now := time.Now()
+	testCases := []struct {
+		name          string
+		repoError     error
+		expectedError error
+	}{
+		{name: "Success"},
+		{name: "Failure - Not found", repoError: repository.ErrAPIKeyNotFound, expectedError: ErrAPIKeyNotFound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(mockAPIKeyRepository)
+			service := NewAPIKeyService(mockRepo, logrus.New())
+			revoked := entities.APIKey{ID: "k1", RevokedAt: &now}
+			if tc.repoError != nil {
+				revoked = entities.APIKey{}
+			}
+			mockRepo.On("RevokeAPIKey", mock.Anything, "k1", mock.AnythingOfType("time.Time")).Return(revoked, tc.repoError)
+
+			key, err := service.RevokeAPIKey(context.Background(), "k1")
+
+			assert.Equal(t, tc.expectedError, err)
+			assert.Equal(t, revoked, key)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestActorFromContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, AnonymousActor, ActorFromContext(ctx))
+
+	ctx = WithActor(ctx, "ana")
+	assert.Equal(t, "ana", ActorFromContext(ctx))
+
+	ctx = WithPrincipal(ctx, entities.Principal{Subject: "svc-ci", Method: entities.AuthMethodAPIKey})
+	assert.Equal(t, "svc-ci", ActorFromContext(ctx), "el principal autenticado gana sobre X-Actor")
+	principal, ok := PrincipalFromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, "svc-ci", principal.Subject)
+}
+
+func TestAPIKeysNeedAGlobalPrincipal(t *testing.T) {
+	mockRepo := new(mockAPIKeyRepository)
+	service := NewAPIKeyService(mockRepo, logrus.New())
+	ctx := WithPrincipal(context.Background(), entities.Principal{Subject: "ana", Roles: []string{entities.RoleAdmin}, Tenant: "acme"})
+
+	_, err := service.CreateAPIKey(ctx, entities.APIKey{Name: "ci", Subject: "svc-ci", Roles: []string{"admin"}})
+	assert.Equal(t, ErrForbidden, err)
+	_, err = service.ListAPIKeys(ctx)
+	assert.Equal(t, ErrForbidden, err)
+	_, err = service.RevokeAPIKey(ctx, "k1")
+	assert.Equal(t, ErrForbidden, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestResolveTenant(t *testing.T) {
+	bound := WithPrincipal(context.Background(), entities.Principal{Subject: "ana", Tenant: "acme"})
+	global := WithPrincipal(context.Background(), entities.Principal{Subject: "root"})
+
+	testCases := []struct {
+		name          string
+		ctx           context.Context
+		requested     string
+		expected      string
+		expectedError error
+	}{
+		{name: "Default", ctx: context.Background(), expected: tenant.Default},
+		{name: "Header without authentication", ctx: context.Background(), requested: "globex", expected: "globex"},
+		{name: "Principal tenant", ctx: bound, expected: "acme"},
+		{name: "Principal tenant repeated in the header", ctx: bound, requested: "acme", expected: "acme"},
+		{name: "Global principal chooses", ctx: global, requested: "globex", expected: "globex"},
+		{name: "Failure - Another tenant", ctx: bound, requested: "globex", expectedError: ErrForbidden},
+		{name: "Failure - Invalid tenant", ctx: global, requested: "../events_db", expectedError: ErrTenant},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, err := ResolveTenant(tc.ctx, tc.requested)
+			if tc.expectedError != nil {
+				assert.Equal(t, tc.expectedError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, tenant.FromContext(ctx))
+		})
+	}
+}
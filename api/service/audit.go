@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/logging"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// record agrega una entrada al historial de auditoría. Un fallo al escribir el
+// historial se registra en el log pero no revierte la operación, que ya se
+// guardó.
+func (s *eventService) record(ctx context.Context, action string, before, after entities.Event) {
+	if s.audit == nil {
+		return
+	}
+
+	eventID := after.ID
+	if eventID == "" {
+		eventID = before.ID
+	}
+	entry := entities.AuditEntry{
+		EventID:   eventID,
+		Action:    action,
+		Actor:     ActorFromContext(ctx),
+		Transport: TransportFromContext(ctx),
+		Changes:   diffEvents(before, after),
+		At:        time.Now(),
+	}
+	if _, err := s.audit.AppendEntry(ctx, entry); err != nil {
+		logging.For(ctx, s.logger, "event_service", "record").WithFields(logrus.Fields{logging.FieldEventID: eventID, "action": action}).WithError(err).Error("no se pudo registrar el cambio en el historial")
+	}
+}
+
+// diffEvents lista los campos que cambian de before a after. Un before sin ID
+// es un evento nuevo: se registran todos los campos con Old en nil.
+func diffEvents(before, after entities.Event) []entities.FieldChange {
+	changes := []entities.FieldChange{}
+	value := func(e entities.Event, v any) any {
+		if e.ID == "" {
+			return nil
+		}
+		return v
+	}
+	add := func(field string, changed bool, old, new any) {
+		if changed {
+			changes = append(changes, entities.FieldChange{
+				Field: field,
+				Old:   value(before, old),
+				New:   value(after, new),
+			})
+		}
+	}
+
+	whole := before.ID == ""
+	add("name", whole || before.Name != after.Name, before.Name, after.Name)
+	add("type", whole || before.Type != after.Type, before.Type, after.Type)
+	add("description", whole || before.Description != after.Description, before.Description, after.Description)
+	add("date", whole || !before.Date.Equal(after.Date), before.Date, after.Date)
+	add("status", whole || before.Status != after.Status, string(before.Status), string(after.Status))
+	add("category", whole || before.Category != after.Category, string(before.Category), string(after.Category))
+	add("needs_action", whole || before.NeedsAction != after.NeedsAction, before.NeedsAction, after.NeedsAction)
+	add("deleted_at", !equalTime(before.DeletedAt, after.DeletedAt), timeValue(before.DeletedAt), timeValue(after.DeletedAt))
+	return changes
+}
+
+func equalTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// timeValue evita guardar un *time.Time nil con tipo dentro de un any.
+func timeValue(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return *t
+}
@@ -0,0 +1,363 @@
+package service
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/logging"
+	"prueba_tecnica/api/taxonomy"
+
+	"github.com/sirupsen/logrus"
+)
+
+var readRoles = []string{entities.RoleViewer, entities.RoleOperator, entities.RoleTriager}
+
+// permissions es la matriz de permisos: los roles que pueden llamar cada
+// método de EventService, WebhookService y APIKeyService. admin puede llamar
+// todos; un método que no está en la matriz no lo puede llamar nadie más.
+var permissions = map[string][]string{
+	"GetEventByID":           readRoles,
+	"ListEvents":             readRoles,
+	"SearchEvents":           readRoles,
+	"GetAllEvents":           readRoles,
+	"GetEventsByStatus":      readRoles,
+	"GetEventsByCategory":    readRoles,
+	"GetEventsNeedingAction": readRoles,
+	"GetEventHistory":        readRoles,
+	"DryRunClassification":   readRoles,
+	"WatchEvents":            readRoles,
+	"GetTaxonomy":            readRoles,
+	"CreateEvent":            {entities.RoleOperator},
+	"CreateEvents":           {entities.RoleOperator},
+	"UpdateEvent":            {entities.RoleOperator},
+	"UpdateEvents":           {entities.RoleOperator},
+	"PatchEvent":             {entities.RoleOperator},
+	"TransitionEvent":        {entities.RoleOperator, entities.RoleTriager},
+	"ClassifyEvent":          {entities.RoleTriager},
+	"ClassifyEvents":         {entities.RoleTriager},
+	"ManualClassifyEvent":    {entities.RoleTriager},
+	// DeleteEvent, RestoreEvent, los webhooks y las API keys son solo de admin.
+}
+
+// Authorize indica si el principal de ctx puede llamar method. Sin principal
+// la operación se rechaza.
+func Authorize(ctx context.Context, method string) error {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return ErrForbidden
+	}
+	if principal.HasRole(entities.RoleAdmin) {
+		return nil
+	}
+	for _, role := range permissions[method] {
+		if principal.HasRole(role) {
+			return nil
+		}
+	}
+	return ErrForbidden
+}
+
+// authorizer comparten los decoradores que aplican Authorize antes de llamar
+// al servicio.
+type authorizer struct {
+	logger logrus.FieldLogger
+}
+
+func (a authorizer) authorize(ctx context.Context, method string) error {
+	if err := Authorize(ctx, method); err != nil {
+		principal, _ := PrincipalFromContext(ctx)
+		logging.For(ctx, a.logger, "authorization", method).WithFields(logrus.Fields{"subject": principal.Subject, "roles": principal.Roles}).WithError(err).Warn("operación rechazada")
+		return err
+	}
+	return nil
+}
+
+// NewAuthorizedEventService envuelve next y rechaza con ErrForbidden las
+// llamadas que el rol del principal no permite. Como los dos transportes usan
+// el mismo servicio, HTTP y gRPC aplican los mismos permisos.
+func NewAuthorizedEventService(next EventService, logger logrus.FieldLogger) EventService {
+	return &authorizedEventService{next: next, authorizer: authorizer{logger: logger}}
+}
+
+type authorizedEventService struct {
+	authorizer
+	next EventService
+}
+
+func (s *authorizedEventService) CreateEvent(ctx context.Context, event entities.Event) (entities.Event, error) {
+	if err := s.authorize(ctx, "CreateEvent"); err != nil {
+		return entities.Event{}, err
+	}
+	return s.next.CreateEvent(ctx, event)
+}
+
+func (s *authorizedEventService) GetEventByID(ctx context.Context, id string) (entities.Event, error) {
+	if err := s.authorize(ctx, "GetEventByID"); err != nil {
+		return entities.Event{}, err
+	}
+	return s.next.GetEventByID(ctx, id)
+}
+
+func (s *authorizedEventService) ListEvents(ctx context.Context, query entities.EventQuery) (entities.EventPage, error) {
+	if err := s.authorize(ctx, "ListEvents"); err != nil {
+		return entities.EventPage{}, err
+	}
+	return s.next.ListEvents(ctx, query)
+}
+
+func (s *authorizedEventService) SearchEvents(ctx context.Context, query entities.SearchQuery) (entities.SearchPage, error) {
+	if err := s.authorize(ctx, "SearchEvents"); err != nil {
+		return entities.SearchPage{}, err
+	}
+	return s.next.SearchEvents(ctx, query)
+}
+
+func (s *authorizedEventService) GetAllEvents(ctx context.Context, page entities.PageRequest) (entities.EventPage, error) {
+	if err := s.authorize(ctx, "GetAllEvents"); err != nil {
+		return entities.EventPage{}, err
+	}
+	return s.next.GetAllEvents(ctx, page)
+}
+
+func (s *authorizedEventService) GetEventsByStatus(ctx context.Context, status entities.Status, page entities.PageRequest) (entities.EventPage, error) {
+	if err := s.authorize(ctx, "GetEventsByStatus"); err != nil {
+		return entities.EventPage{}, err
+	}
+	return s.next.GetEventsByStatus(ctx, status, page)
+}
+
+func (s *authorizedEventService) GetEventsByCategory(ctx context.Context, category entities.Category, page entities.PageRequest) (entities.EventPage, error) {
+	if err := s.authorize(ctx, "GetEventsByCategory"); err != nil {
+		return entities.EventPage{}, err
+	}
+	return s.next.GetEventsByCategory(ctx, category, page)
+}
+
+func (s *authorizedEventService) GetEventsNeedingAction(ctx context.Context, page entities.PageRequest) (entities.EventPage, error) {
+	if err := s.authorize(ctx, "GetEventsNeedingAction"); err != nil {
+		return entities.EventPage{}, err
+	}
+	return s.next.GetEventsNeedingAction(ctx, page)
+}
+
+func (s *authorizedEventService) UpdateEvent(ctx context.Context, event entities.Event) (entities.Event, error) {
+	if err := s.authorize(ctx, "UpdateEvent"); err != nil {
+		return entities.Event{}, err
+	}
+	if s.reclassifies(ctx, event) {
+		if err := s.authorize(ctx, "ManualClassifyEvent"); err != nil {
+			return entities.Event{}, err
+		}
+	}
+	return s.next.UpdateEvent(ctx, event)
+}
+
+func (s *authorizedEventService) DeleteEvent(ctx context.Context, id string) error {
+	if err := s.authorize(ctx, "DeleteEvent"); err != nil {
+		return err
+	}
+	return s.next.DeleteEvent(ctx, id)
+}
+
+func (s *authorizedEventService) ClassifyEvent(ctx context.Context, id string) (entities.Event, error) {
+	if err := s.authorize(ctx, "ClassifyEvent"); err != nil {
+		return entities.Event{}, err
+	}
+	return s.next.ClassifyEvent(ctx, id)
+}
+
+func (s *authorizedEventService) ManualClassifyEvent(ctx context.Context, id string, category entities.Category) (entities.Event, error) {
+	if err := s.authorize(ctx, "ManualClassifyEvent"); err != nil {
+		return entities.Event{}, err
+	}
+	return s.next.ManualClassifyEvent(ctx, id, category)
+}
+
+func (s *authorizedEventService) DryRunClassification(ctx context.Context, event entities.Event) (entities.Classification, error) {
+	if err := s.authorize(ctx, "DryRunClassification"); err != nil {
+		return entities.Classification{}, err
+	}
+	return s.next.DryRunClassification(ctx, event)
+}
+
+func (s *authorizedEventService) TransitionEvent(ctx context.Context, id string, transition entities.StatusTransition) (entities.Event, error) {
+	if err := s.authorize(ctx, "TransitionEvent"); err != nil {
+		return entities.Event{}, err
+	}
+	return s.next.TransitionEvent(ctx, id, transition)
+}
+
+func (s *authorizedEventService) GetEventHistory(ctx context.Context, id string) ([]entities.AuditEntry, error) {
+	if err := s.authorize(ctx, "GetEventHistory"); err != nil {
+		return nil, err
+	}
+	return s.next.GetEventHistory(ctx, id)
+}
+
+func (s *authorizedEventService) RestoreEvent(ctx context.Context, id string) (entities.Event, error) {
+	if err := s.authorize(ctx, "RestoreEvent"); err != nil {
+		return entities.Event{}, err
+	}
+	return s.next.RestoreEvent(ctx, id)
+}
+
+func (s *authorizedEventService) PatchEvent(ctx context.Context, id string, patch entities.EventPatch) (entities.Event, error) {
+	if err := s.authorize(ctx, "PatchEvent"); err != nil {
+		return entities.Event{}, err
+	}
+	// Cambiar la categoría o needs_action es una clasificación manual.
+	if patch.Category != nil || patch.NeedsAction != nil {
+		if err := s.authorize(ctx, "ManualClassifyEvent"); err != nil {
+			return entities.Event{}, err
+		}
+	}
+	return s.next.PatchEvent(ctx, id, patch)
+}
+
+func (s *authorizedEventService) CreateEvents(ctx context.Context, events []entities.Event) ([]entities.BulkResult, error) {
+	if err := s.authorize(ctx, "CreateEvents"); err != nil {
+		return nil, err
+	}
+	return s.next.CreateEvents(ctx, events)
+}
+
+func (s *authorizedEventService) UpdateEvents(ctx context.Context, events []entities.Event) ([]entities.BulkResult, error) {
+	if err := s.authorize(ctx, "UpdateEvents"); err != nil {
+		return nil, err
+	}
+	for _, event := range events {
+		if s.reclassifies(ctx, event) {
+			if err := s.authorize(ctx, "ManualClassifyEvent"); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+	return s.next.UpdateEvents(ctx, events)
+}
+
+func (s *authorizedEventService) ClassifyEvents(ctx context.Context, ids []string) ([]entities.BulkResult, error) {
+	if err := s.authorize(ctx, "ClassifyEvents"); err != nil {
+		return nil, err
+	}
+	return s.next.ClassifyEvents(ctx, ids)
+}
+
+func (s *authorizedEventService) WatchEvents(ctx context.Context, filter entities.ChangeFilter) (<-chan entities.EventChange, error) {
+	if err := s.authorize(ctx, "WatchEvents"); err != nil {
+		return nil, err
+	}
+	return s.next.WatchEvents(ctx, filter)
+}
+
+func (s *authorizedEventService) GetTaxonomy(ctx context.Context) (taxonomy.Taxonomy, error) {
+	if err := s.authorize(ctx, "GetTaxonomy"); err != nil {
+		return taxonomy.Taxonomy{}, err
+	}
+	return s.next.GetTaxonomy(ctx)
+}
+
+// NewAuthorizedWebhookService envuelve next con la matriz de permisos.
+func NewAuthorizedWebhookService(next WebhookService, logger logrus.FieldLogger) WebhookService {
+	return &authorizedWebhookService{next: next, authorizer: authorizer{logger: logger}}
+}
+
+type authorizedWebhookService struct {
+	authorizer
+	next WebhookService
+}
+
+func (s *authorizedWebhookService) CreateWebhook(ctx context.Context, webhook entities.Webhook) (entities.Webhook, error) {
+	if err := s.authorize(ctx, "CreateWebhook"); err != nil {
+		return entities.Webhook{}, err
+	}
+	return s.next.CreateWebhook(ctx, webhook)
+}
+
+func (s *authorizedWebhookService) GetWebhook(ctx context.Context, id string) (entities.Webhook, error) {
+	if err := s.authorize(ctx, "GetWebhook"); err != nil {
+		return entities.Webhook{}, err
+	}
+	return s.next.GetWebhook(ctx, id)
+}
+
+func (s *authorizedWebhookService) ListWebhooks(ctx context.Context) ([]entities.Webhook, error) {
+	if err := s.authorize(ctx, "ListWebhooks"); err != nil {
+		return nil, err
+	}
+	return s.next.ListWebhooks(ctx)
+}
+
+func (s *authorizedWebhookService) UpdateWebhook(ctx context.Context, webhook entities.Webhook) (entities.Webhook, error) {
+	if err := s.authorize(ctx, "UpdateWebhook"); err != nil {
+		return entities.Webhook{}, err
+	}
+	return s.next.UpdateWebhook(ctx, webhook)
+}
+
+func (s *authorizedWebhookService) DeleteWebhook(ctx context.Context, id string) error {
+	if err := s.authorize(ctx, "DeleteWebhook"); err != nil {
+		return err
+	}
+	return s.next.DeleteWebhook(ctx, id)
+}
+
+func (s *authorizedWebhookService) ListDeliveries(ctx context.Context, id string, limit int) ([]entities.WebhookDelivery, error) {
+	if err := s.authorize(ctx, "ListDeliveries"); err != nil {
+		return nil, err
+	}
+	return s.next.ListDeliveries(ctx, id, limit)
+}
+
+func (s *authorizedWebhookService) ListDeadLetters(ctx context.Context, id string, limit int) ([]entities.WebhookDelivery, error) {
+	if err := s.authorize(ctx, "ListDeadLetters"); err != nil {
+		return nil, err
+	}
+	return s.next.ListDeadLetters(ctx, id, limit)
+}
+
+// NewAuthorizedAPIKeyService envuelve next con la matriz de permisos.
+func NewAuthorizedAPIKeyService(next APIKeyService, logger logrus.FieldLogger) APIKeyService {
+	return &authorizedAPIKeyService{next: next, authorizer: authorizer{logger: logger}}
+}
+
+type authorizedAPIKeyService struct {
+	authorizer
+	next APIKeyService
+}
+
+func (s *authorizedAPIKeyService) CreateAPIKey(ctx context.Context, key entities.APIKey) (entities.APIKey, error) {
+	if err := s.authorize(ctx, "CreateAPIKey"); err != nil {
+		return entities.APIKey{}, err
+	}
+	return s.next.CreateAPIKey(ctx, key)
+}
+
+func (s *authorizedAPIKeyService) ListAPIKeys(ctx context.Context) ([]entities.APIKey, error) {
+	if err := s.authorize(ctx, "ListAPIKeys"); err != nil {
+		return nil, err
+	}
+	return s.next.ListAPIKeys(ctx)
+}
+
+func (s *authorizedAPIKeyService) RevokeAPIKey(ctx context.Context, id string) (entities.APIKey, error) {
+	if err := s.authorize(ctx, "RevokeAPIKey"); err != nil {
+		return entities.APIKey{}, err
+	}
+	return s.next.RevokeAPIKey(ctx, id)
+}
+
+// reclassifies indica si un PUT pide una clasificación distinta de la
+// guardada. El servicio la ignora, pero pedirla exige el permiso de
+// ManualClassifyEvent para que un rol sin él no crea que la cambió. Reenviar la
+// guardada, como en un GET seguido de un PUT, no lo exige.
+func (s *authorizedEventService) reclassifies(ctx context.Context, event entities.Event) bool {
+	if event.Category == "" && !event.NeedsAction {
+		return false
+	}
+	// Si el evento no existe, UpdateEvent devuelve el error.
+	current, err := s.next.GetEventByID(ctx, event.ID)
+	if err != nil {
+		return false
+	}
+	return (event.Category != "" && event.Category != current.Category) || (event.NeedsAction && !current.NeedsAction)
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/repository"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func as(roles ...string) context.Context {
+	return WithPrincipal(context.Background(), entities.Principal{Subject: "ana", Roles: roles})
+}
+
+func TestAuthorize(t *testing.T) {
+	testCases := []struct {
+		name    string
+		ctx     context.Context
+		method  string
+		allowed bool
+	}{
+		{name: "Viewer reads", ctx: as(entities.RoleViewer), method: "ListEvents", allowed: true},
+		{name: "Viewer cannot create", ctx: as(entities.RoleViewer), method: "CreateEvent"},
+		{name: "Viewer cannot classify", ctx: as(entities.RoleViewer), method: "ManualClassifyEvent"},
+		{name: "Operator creates", ctx: as(entities.RoleOperator), method: "CreateEvent", allowed: true},
+		{name: "Operator transitions", ctx: as(entities.RoleOperator), method: "TransitionEvent", allowed: true},
+		{name: "Operator cannot classify", ctx: as(entities.RoleOperator), method: "ManualClassifyEvent"},
+		{name: "Operator cannot delete", ctx: as(entities.RoleOperator), method: "DeleteEvent"},
+		{name: "Triager classifies", ctx: as(entities.RoleTriager), method: "ManualClassifyEvent", allowed: true},
+		{name: "Triager cannot update", ctx: as(entities.RoleTriager), method: "UpdateEvent"},
+		{name: "Triager cannot delete", ctx: as(entities.RoleTriager), method: "DeleteEvent"},
+		{name: "Roles add up", ctx: as(entities.RoleOperator, entities.RoleTriager), method: "ClassifyEvents", allowed: true},
+		{name: "Admin deletes", ctx: as(entities.RoleAdmin), method: "DeleteEvent", allowed: true},
+		{name: "Admin manages API keys", ctx: as(entities.RoleAdmin), method: "CreateAPIKey", allowed: true},
+		{name: "Operator cannot manage API keys", ctx: as(entities.RoleOperator), method: "CreateAPIKey"},
+		{name: "Unknown role", ctx: as("superuser"), method: "ListEvents"},
+		{name: "No principal", ctx: context.Background(), method: "ListEvents"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Authorize(tc.ctx, tc.method)
+			if tc.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, ErrForbidden, err)
+			}
+		})
+	}
+}
+
+// TestPermissionsCoverEventService evita que un método nuevo de EventService
+// quede solo para admin sin que nadie lo decida.
+func TestPermissionsCoverEventService(t *testing.T) {
+	adminOnly := map[string]bool{"DeleteEvent": true, "RestoreEvent": true}
+	methods := reflect.TypeOf((*EventService)(nil)).Elem()
+	for i := 0; i < methods.NumMethod(); i++ {
+		name := methods.Method(i).Name
+		_, inMatrix := permissions[name]
+		assert.True(t, inMatrix || adminOnly[name], "%s no está en la matriz de permisos", name)
+	}
+}
+
+func TestAuthorizedEventService(t *testing.T) {
+	logger := logrus.New()
+	service := NewAuthorizedEventService(NewEventService(repository.NewMemoryEventRepository(logger), logger), logger)
+
+	created, err := service.CreateEvent(as(entities.RoleOperator), entities.Event{
+		Name:        "VPN",
+		Type:        "Incidente",
+		Description: "caída de la VPN",
+		Date:        time.Now(),
+		Status:      "Revisado",
+	})
+	require.NoError(t, err)
+
+	_, err = service.GetEventByID(as(entities.RoleViewer), created.ID)
+	assert.NoError(t, err)
+
+	_, err = service.ManualClassifyEvent(as(entities.RoleViewer), created.ID, "Sin gestión")
+	assert.Equal(t, ErrForbidden, err)
+	classified, err := service.ManualClassifyEvent(as(entities.RoleTriager), created.ID, "Sin gestión")
+	require.NoError(t, err)
+	assert.Equal(t, entities.CategoryNoAction, classified.Category)
+
+	// Un operador no puede clasificar con un PUT o un PATCH.
+	reclassified := classified
+	reclassified.Category = entities.CategoryNeedsAction
+	_, err = service.UpdateEvent(as(entities.RoleOperator), reclassified)
+	assert.Equal(t, ErrForbidden, err)
+	_, err = service.UpdateEvents(as(entities.RoleOperator), []entities.Event{reclassified})
+	assert.Equal(t, ErrForbidden, err)
+	category := entities.CategoryNeedsAction
+	_, err = service.PatchEvent(as(entities.RoleOperator), created.ID, entities.EventPatch{Category: &category})
+	assert.Equal(t, ErrForbidden, err)
+	needsAction := true
+	_, err = service.PatchEvent(as(entities.RoleOperator), created.ID, entities.EventPatch{NeedsAction: &needsAction})
+	assert.Equal(t, ErrForbidden, err)
+
+	// Reenviar la clasificación guardada, como en un GET seguido de un PUT, sí
+	// está permitido.
+	stored, err := service.GetEventByID(as(entities.RoleOperator), created.ID)
+	require.NoError(t, err)
+	stored.Name = "VPN caída"
+	updated, err := service.UpdateEvent(as(entities.RoleOperator), stored)
+	require.NoError(t, err)
+	assert.Equal(t, "VPN caída", updated.Name)
+	assert.Equal(t, entities.CategoryNoAction, updated.Category)
+	stored.Version = 0
+	results, err := service.UpdateEvents(as(entities.RoleOperator), []entities.Event{stored})
+	require.NoError(t, err)
+	assert.Empty(t, results[0].Error)
+
+	assert.Equal(t, ErrForbidden, service.DeleteEvent(as(entities.RoleTriager), created.ID))
+	assert.NoError(t, service.DeleteEvent(as(entities.RoleAdmin), created.ID))
+
+	_, err = service.WatchEvents(context.Background(), entities.ChangeFilter{})
+	assert.Equal(t, ErrForbidden, err)
+}
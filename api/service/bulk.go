@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/logging"
+	"prueba_tecnica/api/repository"
+)
+
+// MaxBatchSize es la cantidad máxima de elementos de un lote.
+const MaxBatchSize = 1000
+
+func (s *eventService) CreateEvents(ctx context.Context, events []entities.Event) ([]entities.BulkResult, error) {
+	if err := s.checkBatch(ctx, len(events), "CreateEvents"); err != nil {
+		return nil, err
+	}
+
+	results := make([]entities.BulkResult, len(events))
+	valid := make([]entities.Event, 0, len(events))
+	positions := make([]int, 0, len(events))
+	for i, event := range events {
+		results[i].Index = i
+		event, err := s.prepareCreate(ctx, event)
+		if err != nil {
+			setBulkError(&results[i], err)
+			continue
+		}
+		valid = append(valid, event)
+		positions = append(positions, i)
+	}
+	if len(valid) == 0 {
+		return results, nil
+	}
+
+	created, err := s.repo.CreateEvents(ctx, valid)
+	failed, err := bulkFailures(err)
+	if err != nil {
+		logging.For(ctx, s.logger, "event_service", "CreateEvents").WithError(err).Error("operación fallida")
+		return nil, err
+	}
+
+	for i, event := range created {
+		result := &results[positions[i]]
+		if err, ok := failed[i]; ok {
+			setBulkError(result, err)
+			continue
+		}
+		result.ID = event.ID
+		result.Version = event.Version
+		s.committed(ctx, entities.AuditCreate, entities.Event{}, event)
+	}
+	return results, nil
+}
+
+func (s *eventService) UpdateEvents(ctx context.Context, events []entities.Event) ([]entities.BulkResult, error) {
+	if err := s.checkBatch(ctx, len(events), "UpdateEvents"); err != nil {
+		return nil, err
+	}
+
+	results := make([]entities.BulkResult, len(events))
+	pending := newPendingBatch(len(events))
+	for i, event := range events {
+		results[i] = entities.BulkResult{Index: i, ID: event.ID}
+		current, event, err := s.prepareUpdate(ctx, event)
+		if err != nil {
+			setBulkError(&results[i], err)
+			continue
+		}
+		pending.add(i, current, event)
+	}
+
+	return s.saveBatch(ctx, entities.AuditUpdate, pending, results)
+}
+
+func (s *eventService) ClassifyEvents(ctx context.Context, ids []string) ([]entities.BulkResult, error) {
+	if err := s.checkBatch(ctx, len(ids), "ClassifyEvents"); err != nil {
+		return nil, err
+	}
+
+	results := make([]entities.BulkResult, len(ids))
+	pending := newPendingBatch(len(ids))
+	for i, id := range ids {
+		results[i] = entities.BulkResult{Index: i, ID: id}
+		before, event, err := s.prepareClassify(ctx, id)
+		if err != nil {
+			setBulkError(&results[i], err)
+			continue
+		}
+		pending.add(i, before, event)
+	}
+
+	return s.saveBatch(ctx, entities.AuditClassify, pending, results)
+}
+
+func (s *eventService) checkBatch(ctx context.Context, size int, method string) error {
+	var err error
+	switch {
+	case size == 0:
+		err = ErrEmptyBatch
+	case size > MaxBatchSize:
+		err = ErrBatchSize
+	}
+	if err != nil {
+		logging.For(ctx, s.logger, "event_service", method).WithError(err).Error("operación fallida")
+	}
+	return err
+}
+
+// pendingBatch junta los eventos de un lote que pasaron la validación, con su
+// estado anterior para la auditoría y su posición en el lote.
+type pendingBatch struct {
+	positions []int
+	before    []entities.Event
+	after     []entities.Event
+}
+
+func newPendingBatch(size int) *pendingBatch {
+	return &pendingBatch{
+		positions: make([]int, 0, size),
+		before:    make([]entities.Event, 0, size),
+		after:     make([]entities.Event, 0, size),
+	}
+}
+
+func (p *pendingBatch) add(position int, before, after entities.Event) {
+	p.positions = append(p.positions, position)
+	p.before = append(p.before, before)
+	p.after = append(p.after, after)
+}
+
+// saveBatch es el equivalente de save para un lote.
+func (s *eventService) saveBatch(ctx context.Context, action string, pending *pendingBatch, results []entities.BulkResult) ([]entities.BulkResult, error) {
+	if len(pending.after) == 0 {
+		return results, nil
+	}
+
+	updated, err := s.repo.UpdateEvents(ctx, pending.after)
+	failed, err := bulkFailures(err)
+	if err != nil {
+		logging.For(ctx, s.logger, "event_service", "saveBatch").WithError(err).Error("operación fallida")
+		return nil, err
+	}
+
+	for i, event := range updated {
+		result := &results[pending.positions[i]]
+		if err, ok := failed[i]; ok {
+			setBulkError(result, err)
+			continue
+		}
+		result.Version = event.Version
+		s.committed(ctx, action, pending.before[i], event)
+	}
+	return results, nil
+}
+
+// bulkFailures separa los fallos por elemento de un error que afecta a todo
+// el lote.
+func bulkFailures(err error) (repository.BulkError, error) {
+	var failed repository.BulkError
+	if err == nil || errors.As(err, &failed) {
+		return failed, nil
+	}
+	return nil, err
+}
+
+func setBulkError(result *entities.BulkResult, err error) {
+	result.Err = err
+	result.Error = err.Error()
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/logging"
+	"prueba_tecnica/api/tenant"
+	"time"
+)
+
+// Publisher reparte los cambios guardados de los eventos a quienes los
+// observan.
+type Publisher interface {
+	Publish(change entities.EventChange)
+	Subscribe(ctx context.Context, filter entities.ChangeFilter) <-chan entities.EventChange
+}
+
+// WithPublisher publica cada cambio guardado en publisher. Sin esta opción
+// WatchEvents devuelve ErrWatchDisabled.
+func WithPublisher(publisher Publisher) Option {
+	return func(s *eventService) {
+		s.publisher = publisher
+	}
+}
+
+// Notifier recibe cada cambio guardado sin pasar por las suscripciones de
+// Publisher, que se cortan si el suscriptor se queda atrás. Lo usan los
+// webhooks, que no pueden perder cambios.
+type Notifier interface {
+	Notify(change entities.EventChange)
+}
+
+// WithNotifier avisa a notifier de cada cambio guardado.
+func WithNotifier(notifier Notifier) Option {
+	return func(s *eventService) {
+		s.notifier = notifier
+	}
+}
+
+// changeTypes traduce las acciones de auditoría a los tipos de cambio del feed.
+var changeTypes = map[string]string{
+	entities.AuditCreate:         entities.ChangeCreated,
+	entities.AuditUpdate:         entities.ChangeUpdated,
+	entities.AuditPatch:          entities.ChangeUpdated,
+	entities.AuditTransition:     entities.ChangeUpdated,
+	entities.AuditClassify:       entities.ChangeClassified,
+	entities.AuditManualClassify: entities.ChangeClassified,
+	entities.AuditDelete:         entities.ChangeDeleted,
+	entities.AuditRestore:        entities.ChangeRestored,
+}
+
+// committed se llama después de guardar un cambio: lo registra en el
+// historial, lo publica y avisa al notifier.
+func (s *eventService) committed(ctx context.Context, action string, before, after entities.Event) {
+	s.record(ctx, action, before, after)
+	if s.publisher == nil && s.notifier == nil {
+		return
+	}
+
+	change := entities.EventChange{Type: changeTypes[action], Event: after, At: time.Now()}
+	if before.ID != "" {
+		change.Previous = &before
+	}
+	if s.publisher != nil {
+		s.publisher.Publish(change)
+	}
+	if s.notifier != nil {
+		s.notifier.Notify(change)
+	}
+}
+
+// WatchEvents devuelve los cambios que cumplen filter desde este momento. El
+// canal se cierra al terminar ctx. Solo se reciben los cambios del tenant de
+// ctx.
+func (s *eventService) WatchEvents(ctx context.Context, filter entities.ChangeFilter) (<-chan entities.EventChange, error) {
+	if s.publisher == nil {
+		logging.For(ctx, s.logger, "event_service", "WatchEvents").WithError(ErrWatchDisabled).Error("operación fallida")
+		return nil, ErrWatchDisabled
+	}
+	if filter.Status != "" && !s.taxonomy.ValidStatus(filter.Status) {
+		logging.For(ctx, s.logger, "event_service", "WatchEvents").WithError(ErrStatus).Error("operación fallida")
+		return nil, s.errStatus("status")
+	}
+	if filter.Category != "" && !s.taxonomy.ValidCategory(filter.Category) {
+		logging.For(ctx, s.logger, "event_service", "WatchEvents").WithError(ErrTypeCategory).Error("operación fallida")
+		return nil, s.errCategory(ErrTypeCategory, "category")
+	}
+	filter.Tenant = tenant.FromContext(ctx)
+	return s.publisher.Subscribe(ctx, filter), nil
+}
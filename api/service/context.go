@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/tenant"
+)
+
+type contextKey int
+
+const (
+	actorKey contextKey = iota
+	transportKey
+	principalKey
+)
+
+// AnonymousActor se registra cuando la operación no indica quién la hizo.
+const AnonymousActor = "anónimo"
+
+// WithActor guarda en ctx quién hace la operación.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey, actor)
+}
+
+// ActorFromContext devuelve el subject del principal autenticado, el actor
+// guardado con WithActor o AnonymousActor. Con autenticación el actor no se
+// puede elegir.
+func ActorFromContext(ctx context.Context) string {
+	if principal, ok := PrincipalFromContext(ctx); ok {
+		return principal.Subject
+	}
+	if actor, ok := ctx.Value(actorKey).(string); ok && actor != "" {
+		return actor
+	}
+	return AnonymousActor
+}
+
+// WithTransport guarda en ctx el transporte por el que llegó la operación.
+func WithTransport(ctx context.Context, transport string) context.Context {
+	return context.WithValue(ctx, transportKey, transport)
+}
+
+func TransportFromContext(ctx context.Context) string {
+	transport, _ := ctx.Value(transportKey).(string)
+	return transport
+}
+
+// WithPrincipal guarda en ctx quién se autenticó en la petición.
+func WithPrincipal(ctx context.Context, principal entities.Principal) context.Context {
+	return context.WithValue(ctx, principalKey, principal)
+}
+
+// PrincipalFromContext devuelve el principal guardado con WithPrincipal; ok
+// es false si la petición no se autenticó.
+func PrincipalFromContext(ctx context.Context) (principal entities.Principal, ok bool) {
+	principal, ok = ctx.Value(principalKey).(entities.Principal)
+	return principal, ok
+}
+
+// ResolveTenant guarda en ctx el tenant de la petición. Un principal con
+// tenant solo puede usar el suyo: si requested pide otro devuelve
+// ErrForbidden. Sin tenant en el principal, o sin autenticación, se usa
+// requested, que es el encabezado X-Tenant-ID o el metadata x-tenant-id, y si
+// está vacío tenant.Default.
+func ResolveTenant(ctx context.Context, requested string) (context.Context, error) {
+	if requested != "" && !tenant.Valid(requested) {
+		return ctx, ErrTenant
+	}
+	if principal, ok := PrincipalFromContext(ctx); ok && principal.Tenant != "" {
+		if requested != "" && requested != principal.Tenant {
+			return ctx, ErrForbidden
+		}
+		return tenant.WithID(ctx, principal.Tenant), nil
+	}
+	if requested == "" {
+		return ctx, nil
+	}
+	return tenant.WithID(ctx, requested), nil
+}
@@ -1,11 +1,41 @@
 package service
 
-import "errors"
+import (
+	"prueba_tecnica/api/apperr"
+	"prueba_tecnica/api/repository"
+)
 
-var ErrValidation = errors.New("Error en la estructura del request llene todos los campos")
-var ErrStatus = errors.New("El estado debe de ser Pendiente por revisar o Revisado)")
-var ErrEventNotfound = errors.New("evento con ese id no encontrado")
-var ErrTypeCategory = errors.New("categoría inválida")
-var ErrNoID = errors.New("Id del evento requerido")
-var ErrCategory = errors.New("categoría debe ser 'Requiere gestión' o 'Sin gestión'")
-var ErrEventRevi = errors.New("Solo se pueden clasificar eventos revisados")
+var ErrValidation = apperr.New(apperr.InvalidArgument, "event.invalid")
+var ErrStatus = apperr.New(apperr.InvalidArgument, "status.invalid")
+var ErrInitialStatus = apperr.New(apperr.InvalidArgument, "status.invalid_initial")
+var ErrTypeCategory = apperr.New(apperr.InvalidArgument, "category.invalid_type")
+var ErrType = apperr.New(apperr.InvalidArgument, "event.invalid_type")
+var ErrNoID = apperr.New(apperr.InvalidArgument, "event.id_required")
+var ErrCategory = apperr.New(apperr.InvalidArgument, "category.invalid")
+var ErrEventRevi = apperr.New(apperr.FailedPrecondition, "category.not_reviewed")
+var ErrPageLimit = apperr.New(apperr.InvalidArgument, "page.invalid_limit")
+var ErrSort = apperr.New(apperr.InvalidArgument, "page.invalid_sort")
+var ErrDateRange = apperr.New(apperr.InvalidArgument, "page.invalid_range")
+var ErrSearchText = apperr.New(apperr.InvalidArgument, "search.invalid_text")
+var ErrTransition = apperr.New(apperr.FailedPrecondition, "transition.not_allowed")
+var ErrStatusChange = apperr.New(apperr.InvalidArgument, "status.requires_transition")
+var ErrActor = apperr.New(apperr.InvalidArgument, "transition.actor_required")
+var ErrHistoryDisabled = apperr.New(apperr.Unavailable, "history.disabled")
+var ErrEmptyBatch = apperr.New(apperr.InvalidArgument, "batch.empty")
+var ErrBatchSize = apperr.New(apperr.InvalidArgument, "batch.too_large")
+var ErrWatchDisabled = apperr.New(apperr.Unavailable, "watch.disabled")
+var ErrWebhook = apperr.New(apperr.InvalidArgument, "webhook.invalid")
+var ErrAPIKey = apperr.New(apperr.InvalidArgument, "apikey.invalid")
+var ErrForbidden = apperr.New(apperr.PermissionDenied, "auth.forbidden")
+var ErrTenant = apperr.New(apperr.InvalidArgument, "tenant.invalid")
+
+// Los errores de los repositorios llegan sin traducir; estos nombres existen
+// para que los transportes solo dependan del servicio.
+var (
+	ErrEventNotfound   = repository.ErrEventNotfound
+	ErrInvalidCursor   = repository.ErrInvalidCursor
+	ErrNotDeleted      = repository.ErrNotDeleted
+	ErrVersionConflict = repository.ErrVersionConflict
+	ErrWebhookNotFound = repository.ErrWebhookNotFound
+	ErrAPIKeyNotFound  = repository.ErrAPIKeyNotFound
+)
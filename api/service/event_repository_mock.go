@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"prueba_tecnica/api/entities"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 )
@@ -16,37 +17,64 @@ func (m *mockEventRepository) CreateEvent(ctx context.Context, event entities.Ev
 	return args.Get(0).(entities.Event), args.Error(1)
 }
 
+func (m *mockEventRepository) CreateEvents(ctx context.Context, events []entities.Event) ([]entities.Event, error) {
+	args := m.Called(ctx, events)
+	return args.Get(0).([]entities.Event), args.Error(1)
+}
+
 func (m *mockEventRepository) GetEventByID(ctx context.Context, id string) (entities.Event, error) {
 	args := m.Called(ctx, id)
 	return args.Get(0).(entities.Event), args.Error(1)
 }
 
-func (m *mockEventRepository) GetAllEvents(ctx context.Context) ([]entities.Event, error) {
-	args := m.Called(ctx)
-	return args.Get(0).([]entities.Event), args.Error(1)
+func (m *mockEventRepository) ListEvents(ctx context.Context, query entities.EventQuery) (entities.EventPage, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).(entities.EventPage), args.Error(1)
 }
 
-func (m *mockEventRepository) GetEventsByStatus(ctx context.Context, status string) ([]entities.Event, error) {
-	args := m.Called(ctx, status)
-	return args.Get(0).([]entities.Event), args.Error(1)
+func (m *mockEventRepository) SearchEvents(ctx context.Context, query entities.SearchQuery) (entities.SearchPage, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).(entities.SearchPage), args.Error(1)
 }
 
-func (m *mockEventRepository) GetEventsByCategory(ctx context.Context, category string) ([]entities.Event, error) {
-	args := m.Called(ctx, category)
-	return args.Get(0).([]entities.Event), args.Error(1)
+func (m *mockEventRepository) UpdateEvent(ctx context.Context, event entities.Event) (entities.Event, error) {
+	args := m.Called(ctx, event)
+	if fn, ok := args.Get(0).(func(context.Context, entities.Event) entities.Event); ok {
+		return fn(ctx, event), args.Error(1)
+	}
+	return args.Get(0).(entities.Event), args.Error(1)
 }
 
-func (m *mockEventRepository) GetEventsNeedingAction(ctx context.Context) ([]entities.Event, error) {
-	args := m.Called(ctx)
+func (m *mockEventRepository) UpdateEvents(ctx context.Context, events []entities.Event) ([]entities.Event, error) {
+	args := m.Called(ctx, events)
 	return args.Get(0).([]entities.Event), args.Error(1)
 }
 
-func (m *mockEventRepository) UpdateEvent(ctx context.Context, event entities.Event) (entities.Event, error) {
-	args := m.Called(ctx, event)
-	return args.Get(0).(entities.Event), args.Error(1)
+func (m *mockEventRepository) DeleteEvent(ctx context.Context, id string, deletedAt time.Time) error {
+	args := m.Called(ctx, id, deletedAt)
+	return args.Error(0)
 }
 
-func (m *mockEventRepository) DeleteEvent(ctx context.Context, id string) error {
+func (m *mockEventRepository) RestoreEvent(ctx context.Context, id string) (entities.Event, error) {
 	args := m.Called(ctx, id)
-	return args.Error(0)
+	return args.Get(0).(entities.Event), args.Error(1)
+}
+
+func (m *mockEventRepository) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	args := m.Called(ctx, before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+type mockAuditRepository struct {
+	mock.Mock
+}
+
+func (m *mockAuditRepository) AppendEntry(ctx context.Context, entry entities.AuditEntry) (entities.AuditEntry, error) {
+	args := m.Called(ctx, entry)
+	return args.Get(0).(entities.AuditEntry), args.Error(1)
+}
+
+func (m *mockAuditRepository) ListEntries(ctx context.Context, eventID string) ([]entities.AuditEntry, error) {
+	args := m.Called(ctx, eventID)
+	return args.Get(0).([]entities.AuditEntry), args.Error(1)
 }
@@ -2,9 +2,13 @@ package service
 
 import (
 	"context"
-	"errors"
+	"prueba_tecnica/api/apperr"
 	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/logging"
 	"prueba_tecnica/api/repository"
+	"prueba_tecnica/api/rules"
+	"prueba_tecnica/api/taxonomy"
+	"strings"
 	"time"
 
 	"github.com/go-playground/validator/v10"
@@ -14,43 +18,130 @@ import (
 type EventService interface {
 	CreateEvent(ctx context.Context, event entities.Event) (entities.Event, error)
 	GetEventByID(ctx context.Context, id string) (entities.Event, error)
-	GetAllEvents(ctx context.Context) ([]entities.Event, error)
-	GetEventsByStatus(ctx context.Context, status string) ([]entities.Event, error)
-	GetEventsByCategory(ctx context.Context, category string) ([]entities.Event, error)
-	GetEventsNeedingAction(ctx context.Context) ([]entities.Event, error)
+	ListEvents(ctx context.Context, query entities.EventQuery) (entities.EventPage, error)
+	// SearchEvents busca texto en el nombre y la descripción de los eventos
+	// y los devuelve por relevancia, con las coincidencias resaltadas.
+	SearchEvents(ctx context.Context, query entities.SearchQuery) (entities.SearchPage, error)
+	GetAllEvents(ctx context.Context, page entities.PageRequest) (entities.EventPage, error)
+	GetEventsByStatus(ctx context.Context, status entities.Status, page entities.PageRequest) (entities.EventPage, error)
+	GetEventsByCategory(ctx context.Context, category entities.Category, page entities.PageRequest) (entities.EventPage, error)
+	GetEventsNeedingAction(ctx context.Context, page entities.PageRequest) (entities.EventPage, error)
 	UpdateEvent(ctx context.Context, event entities.Event) (entities.Event, error)
 	DeleteEvent(ctx context.Context, id string) error
 	ClassifyEvent(ctx context.Context, id string) (entities.Event, error)
-	ManualClassifyEvent(ctx context.Context, id string, category string) (entities.Event, error)
+	ManualClassifyEvent(ctx context.Context, id string, category entities.Category) (entities.Event, error)
+	DryRunClassification(ctx context.Context, event entities.Event) (entities.Classification, error)
+	TransitionEvent(ctx context.Context, id string, transition entities.StatusTransition) (entities.Event, error)
+	GetEventHistory(ctx context.Context, id string) ([]entities.AuditEntry, error)
+	RestoreEvent(ctx context.Context, id string) (entities.Event, error)
+	PatchEvent(ctx context.Context, id string, patch entities.EventPatch) (entities.Event, error)
+	// CreateEvents, UpdateEvents y ClassifyEvents aplican la operación
+	// individual a cada elemento del lote por separado y guardan los válidos
+	// en una sola escritura. Solo devuelven error si falla el lote completo.
+	CreateEvents(ctx context.Context, events []entities.Event) ([]entities.BulkResult, error)
+	UpdateEvents(ctx context.Context, events []entities.Event) ([]entities.BulkResult, error)
+	ClassifyEvents(ctx context.Context, ids []string) ([]entities.BulkResult, error)
+	WatchEvents(ctx context.Context, filter entities.ChangeFilter) (<-chan entities.EventChange, error)
+	// GetTaxonomy devuelve los estados, categorías y tipos que acepta el
+	// servicio.
+	GetTaxonomy(ctx context.Context) (taxonomy.Taxonomy, error)
+}
+
+// Classifier decide la categoría de un evento revisado.
+type Classifier interface {
+	Classify(event entities.Event) entities.Classification
 }
 
 type eventService struct {
-	repo     repository.EventRepository
-	logger   logrus.FieldLogger
-	validate *validator.Validate
+	repo       repository.EventRepository
+	logger     logrus.FieldLogger
+	validate   *validator.Validate
+	classifier Classifier
+	audit      repository.AuditRepository
+	publisher  Publisher
+	notifier   Notifier
+	taxonomy   *taxonomy.Taxonomy
+}
+
+// Option configura dependencias opcionales del servicio.
+type Option func(*eventService)
+
+// WithClassifier reemplaza las reglas de clasificación por defecto.
+func WithClassifier(c Classifier) Option {
+	return func(s *eventService) {
+		s.classifier = c
+	}
+}
+
+// WithAuditLog registra cada cambio de los eventos en audit. Sin esta opción
+// no se guarda historial.
+func WithAuditLog(audit repository.AuditRepository) Option {
+	return func(s *eventService) {
+		s.audit = audit
+	}
 }
 
-func NewEventService(repo repository.EventRepository, logger logrus.FieldLogger) EventService {
-	return &eventService{
+// WithTaxonomy valida estados, categorías y tipos con t en lugar de la
+// taxonomía por defecto. El clasificador debe usar la misma taxonomía.
+func WithTaxonomy(t *taxonomy.Taxonomy) Option {
+	return func(s *eventService) {
+		s.taxonomy = t
+	}
+}
+
+func NewEventService(repo repository.EventRepository, logger logrus.FieldLogger, opts ...Option) EventService {
+	s := &eventService{
 		repo:     repo,
 		logger:   logger,
-		validate: validator.New(),
+		validate: newValidator(),
+		taxonomy: taxonomy.Default(),
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	if s.classifier == nil {
+		s.classifier = rules.NewEngine(rules.StaticSource(rules.DefaultRules()), logger, rules.WithTaxonomy(s.taxonomy))
+	}
+	return s
 }
 
 func (s *eventService) CreateEvent(ctx context.Context, event entities.Event) (entities.Event, error) {
+	event, err := s.prepareCreate(ctx, event)
+	if err != nil {
+		return entities.Event{}, err
+	}
+
+	created, err := s.repo.CreateEvent(ctx, event)
+	if err != nil {
+		return created, err
+	}
+	s.committed(ctx, entities.AuditCreate, entities.Event{}, created)
+	return created, nil
+}
+
+// prepareCreate valida un evento nuevo y lo deja listo para guardarlo.
+func (s *eventService) prepareCreate(ctx context.Context, event entities.Event) (entities.Event, error) {
 	if err := s.validate.Struct(event); err != nil {
-		s.logger.Errorln("Layer: event_service", "Method: CreateEvent", "Error:", err)
-		return entities.Event{}, ErrValidation
+		logging.For(ctx, s.logger, "event_service", "CreateEvent").WithError(err).Error("operación fallida")
+		return entities.Event{}, apperr.Invalid(ErrValidation, err)
 	}
 
-	if event.Status != "Pendiente por revisar" && event.Status != "Revisado" {
-		s.logger.Errorln("Layer: event_service", "Method: CreateEvent", "Error:", ErrStatus)
-		return entities.Event{}, ErrStatus
+	if !s.taxonomy.ValidStatus(event.Status) {
+		logging.For(ctx, s.logger, "event_service", "CreateEvent").WithError(ErrStatus).Error("operación fallida")
+		return entities.Event{}, s.errStatus("status")
+	}
+	if !s.taxonomy.IsInitial(event.Status) {
+		logging.For(ctx, s.logger, "event_service", "CreateEvent").WithError(ErrInitialStatus).Error("operación fallida")
+		return entities.Event{}, s.errInitialStatus()
+	}
+	if !s.taxonomy.ValidType(event.Type) {
+		logging.For(ctx, s.logger, "event_service", "CreateEvent").WithError(ErrType).Error("operación fallida")
+		return entities.Event{}, s.errType()
 	}
 
+	event.StatusHistory = nil
 	event.Date = time.Now()
-	return s.repo.CreateEvent(ctx, event)
+	return event, nil
 }
 
 func (s *eventService) GetEventByID(ctx context.Context, id string) (entities.Event, error) {
@@ -58,110 +149,420 @@ func (s *eventService) GetEventByID(ctx context.Context, id string) (entities.Ev
 	return s.repo.GetEventByID(ctx, id)
 }
 
-func (s *eventService) GetAllEvents(ctx context.Context) ([]entities.Event, error) {
-	return s.repo.GetAllEvents(ctx)
+func (s *eventService) ListEvents(ctx context.Context, query entities.EventQuery) (entities.EventPage, error) {
+	if err := s.validateQuery(query); err != nil {
+		logging.For(ctx, s.logger, "event_service", "ListEvents").WithError(err).Error("operación fallida")
+		return entities.EventPage{}, err
+	}
+	return pageResult(s.repo.ListEvents(ctx, query))
 }
 
-func (s *eventService) GetEventsByStatus(ctx context.Context, status string) ([]entities.Event, error) {
-	if status != "Pendiente por revisar" && status != "Revisado" {
-		s.logger.Errorln("Layer: event_service", "Method: GetEventsByStatus", "Error:", ErrStatus)
-		return nil, errors.New("status debe ser 'Pendiente por revisar' o 'Revisado'")
+func (s *eventService) GetAllEvents(ctx context.Context, page entities.PageRequest) (entities.EventPage, error) {
+	return s.ListEvents(ctx, entities.EventQuery{Page: page})
+}
+
+func (s *eventService) GetEventsByStatus(ctx context.Context, status entities.Status, page entities.PageRequest) (entities.EventPage, error) {
+	if !s.taxonomy.ValidStatus(status) {
+		logging.For(ctx, s.logger, "event_service", "GetEventsByStatus").WithError(ErrStatus).Error("operación fallida")
+		return entities.EventPage{}, s.errStatus("status")
 	}
-	return s.repo.GetEventsByStatus(ctx, status)
+	return s.ListEvents(ctx, entities.EventQuery{Status: status, Page: page})
 }
 
-func (s *eventService) GetEventsByCategory(ctx context.Context, category string) ([]entities.Event, error) {
-	if category != "Requiere gestión" && category != "Sin gestión" {
-		s.logger.Errorln("Layer: event_service", "Method: GetEventsByCategory", "Error:", ErrTypeCategory)
-		return nil, ErrTypeCategory
+// GetEventsByCategory solo lista eventos revisados (el estado clasificado de la
+// taxonomía): los demás aún no tienen una categoría definitiva.
+func (s *eventService) GetEventsByCategory(ctx context.Context, category entities.Category, page entities.PageRequest) (entities.EventPage, error) {
+	if !s.taxonomy.ValidCategory(category) {
+		logging.For(ctx, s.logger, "event_service", "GetEventsByCategory").WithError(ErrTypeCategory).Error("operación fallida")
+		return entities.EventPage{}, s.errCategory(ErrTypeCategory, "category")
 	}
-	return s.repo.GetEventsByCategory(ctx, category)
+	return s.ListEvents(ctx, entities.EventQuery{Category: category, Status: s.taxonomy.ClassifiedStatus(), Page: page})
 }
 
-func (s *eventService) GetEventsNeedingAction(ctx context.Context) ([]entities.Event, error) {
-	return s.repo.GetEventsNeedingAction(ctx)
+func (s *eventService) GetEventsNeedingAction(ctx context.Context, page entities.PageRequest) (entities.EventPage, error) {
+	needsAction := true
+	return s.ListEvents(ctx, entities.EventQuery{NeedsAction: &needsAction, Status: s.taxonomy.ClassifiedStatus(), Page: page})
+}
+
+func (s *eventService) validateQuery(query entities.EventQuery) error {
+	if query.Status != "" && !s.taxonomy.ValidStatus(query.Status) {
+		return s.errStatus("status")
+	}
+	if query.Category != "" && !s.taxonomy.ValidCategory(query.Category) {
+		return s.errCategory(ErrTypeCategory, "category")
+	}
+	switch query.SortBy {
+	case "", entities.SortByDate, entities.SortByName, entities.SortByType, entities.SortByStatus:
+	default:
+		return ErrSort
+	}
+	if query.SortOrder != "" && query.SortOrder != entities.SortAsc && query.SortOrder != entities.SortDesc {
+		return ErrSort
+	}
+	if !query.From.IsZero() && !query.To.IsZero() && !query.From.Before(query.To) {
+		return ErrDateRange
+	}
+	if query.Page.Limit < 0 || query.Page.Limit > repository.MaxPageLimit {
+		return ErrPageLimit
+	}
+	return nil
+}
+
+// maxSearchLength limita el largo en bytes del texto de una búsqueda.
+const maxSearchLength = 256
+
+func (s *eventService) SearchEvents(ctx context.Context, query entities.SearchQuery) (entities.SearchPage, error) {
+	if err := s.validateSearch(query); err != nil {
+		logging.For(ctx, s.logger, "event_service", "SearchEvents").WithError(err).Error("operación fallida")
+		return entities.SearchPage{}, err
+	}
+	page, err := s.repo.SearchEvents(ctx, query)
+	if err != nil {
+		return entities.SearchPage{}, err
+	}
+	return page, nil
+}
+
+// validateSearch valida el texto y, como en ListEvents, los filtros y la
+// página.
+func (s *eventService) validateSearch(query entities.SearchQuery) error {
+	if strings.TrimSpace(query.Text) == "" || len(query.Text) > maxSearchLength {
+		return ErrSearchText
+	}
+	filters := query.Filters()
+	filters.Page = query.Page
+	return s.validateQuery(filters)
+}
+
+// pageResult descarta la página si el repositorio devolvió un error.
+func pageResult(page entities.EventPage, err error) (entities.EventPage, error) {
+	if err != nil {
+		return entities.EventPage{}, err
+	}
+	return page, nil
 }
 
 func (s *eventService) UpdateEvent(ctx context.Context, event entities.Event) (entities.Event, error) {
+	current, event, err := s.prepareUpdate(ctx, event)
+	if err != nil {
+		return entities.Event{}, err
+	}
+	return s.save(ctx, entities.AuditUpdate, current, event)
+}
+
+// prepareUpdate valida el cambio contra el evento guardado y devuelve ambos:
+// el guardado, para la auditoría, y el que se debe guardar.
+func (s *eventService) prepareUpdate(ctx context.Context, event entities.Event) (entities.Event, entities.Event, error) {
 	if err := s.validate.Struct(event); err != nil {
-		s.logger.Errorln("Layer: user_services", "Method: UpdateUser", "Error:", err)
-		return entities.Event{}, ErrValidation
+		logging.For(ctx, s.logger, "event_service", "UpdateEvent").WithError(err).Error("operación fallida")
+		return entities.Event{}, entities.Event{}, apperr.Invalid(ErrValidation, err)
+	}
+	if !s.taxonomy.ValidStatus(event.Status) {
+		logging.For(ctx, s.logger, "event_service", "UpdateEvent").WithError(ErrStatus).Error("operación fallida")
+		return entities.Event{}, entities.Event{}, s.errStatus("status")
+	}
+	if !s.taxonomy.ValidType(event.Type) {
+		logging.For(ctx, s.logger, "event_service", "UpdateEvent").WithError(ErrType).Error("operación fallida")
+		return entities.Event{}, entities.Event{}, s.errType()
+	}
+
+	current, err := s.repo.GetEventByID(ctx, event.ID)
+	if err != nil {
+		logging.For(ctx, s.logger, "event_service", "UpdateEvent").WithError(err).Error("operación fallida")
+		return entities.Event{}, entities.Event{}, err
+	}
+
+	// El estado y su historial no se editan con un PUT: ver TransitionEvent.
+	// La clasificación tampoco: ver ManualClassifyEvent y PatchEvent.
+	if event.Status != current.Status {
+		logging.For(ctx, s.logger, "event_service", "UpdateEvent").WithError(ErrStatusChange).Error("operación fallida")
+		return entities.Event{}, entities.Event{}, ErrStatusChange
+	}
+	event.StatusHistory = current.StatusHistory
+	event.Category = current.Category
+	event.NeedsAction = current.NeedsAction
+	if event.Date.IsZero() {
+		event.Date = current.Date
+	}
+
+	// Version 0 significa que el cliente no pidió una versión concreta.
+	if event.Version != 0 && event.Version != current.Version {
+		logging.For(ctx, s.logger, "event_service", "UpdateEvent").WithError(ErrVersionConflict).Error("operación fallida")
+		return entities.Event{}, entities.Event{}, ErrVersionConflict
+	}
+	event.Version = current.Version
+
+	if event.Status == s.taxonomy.ClassifiedStatus() && (event.Type != current.Type || event.Category == "") {
+		s.classify(&event)
+	}
+	return current, event, nil
+}
+
+// PatchEvent modifica solo los campos presentes en patch. Un cambio de estado
+// debe ser una transición válida y queda en el historial a nombre del actor
+// del contexto. Si cambia el tipo o el estado de un evento revisado se vuelve
+// a clasificar, salvo que el mismo patch indique la categoría.
+func (s *eventService) PatchEvent(ctx context.Context, id string, patch entities.EventPatch) (entities.Event, error) {
+	var empty []apperr.FieldViolation
+	for _, field := range []struct {
+		name  string
+		value *string
+	}{{"name", patch.Name}, {"type", patch.Type}, {"description", patch.Description}, {"status", (*string)(patch.Status)}} {
+		if field.value != nil && *field.value == "" {
+			empty = append(empty, apperr.Violation(field.name, "validation.not_empty"))
+		}
+	}
+	if len(empty) > 0 {
+		logging.For(ctx, s.logger, "event_service", "PatchEvent").WithError(ErrValidation).Error("operación fallida")
+		return entities.Event{}, ErrValidation.WithFields(empty...)
+	}
+	if patch.Status != nil && !s.taxonomy.ValidStatus(*patch.Status) {
+		logging.For(ctx, s.logger, "event_service", "PatchEvent").WithError(ErrStatus).Error("operación fallida")
+		return entities.Event{}, s.errStatus("status")
 	}
-	if event.Status != "Pendiente por revisar" && event.Status != "Revisado" {
-		s.logger.Errorln("Layer: event_service", "Method: CreateEvent", "Error:", ErrStatus)
-		return entities.Event{}, ErrStatus
+	if patch.Category != nil && *patch.Category != "" && !s.taxonomy.ValidCategory(*patch.Category) {
+		logging.For(ctx, s.logger, "event_service", "PatchEvent").WithError(ErrCategory).Error("operación fallida")
+		return entities.Event{}, s.errCategory(ErrCategory, "category")
+	}
+	if patch.Type != nil && !s.taxonomy.ValidType(*patch.Type) {
+		logging.For(ctx, s.logger, "event_service", "PatchEvent").WithError(ErrType).Error("operación fallida")
+		return entities.Event{}, s.errType()
 	}
 
-	_, err := s.repo.GetEventByID(ctx, event.ID)
+	current, err := s.repo.GetEventByID(ctx, id)
 	if err != nil {
-		s.logger.Errorln("Layer: event_service", "Method: CreateEvent", "Error:", err)
-		return entities.Event{}, ErrEventNotfound
+		logging.For(ctx, s.logger, "event_service", "PatchEvent").WithError(err).Error("operación fallida")
+		return entities.Event{}, err
+	}
+	if patch.Version != 0 && patch.Version != current.Version {
+		logging.For(ctx, s.logger, "event_service", "PatchEvent").WithError(ErrVersionConflict).Error("operación fallida")
+		return entities.Event{}, ErrVersionConflict
 	}
 
-	if event.Status == "Revisado" && event.Category == "" {
-		if _, err := s.ClassifyEvent(ctx, event.ID); err != nil {
-			s.logger.Errorln("Layer: event_service", "Method: CreateEvent", "Error:", err)
-			return entities.Event{}, err
+	event := current
+	if patch.Name != nil {
+		event.Name = *patch.Name
+	}
+	if patch.Type != nil {
+		event.Type = *patch.Type
+	}
+	if patch.Description != nil {
+		event.Description = *patch.Description
+	}
+	if patch.Date != nil {
+		event.Date = *patch.Date
+	}
+	if patch.Status != nil && *patch.Status != current.Status {
+		if !s.taxonomy.CanTransition(current.Status, *patch.Status) {
+			logging.For(ctx, s.logger, "event_service", "PatchEvent").WithFields(logrus.Fields{logging.FieldEventID: id, "from": current.Status, "to": *patch.Status}).WithError(ErrTransition).Error("operación fallida")
+			return entities.Event{}, ErrTransition
+		}
+		event.Status = *patch.Status
+		event.StatusHistory = append(event.StatusHistory, entities.StatusTransition{
+			From:  current.Status,
+			To:    event.Status,
+			Actor: ActorFromContext(ctx),
+			At:    time.Now(),
+		})
+	}
+	if patch.NeedsAction != nil {
+		event.NeedsAction = *patch.NeedsAction
+	}
+	if patch.Category != nil {
+		if *patch.Category != "" && event.Status != s.taxonomy.ClassifiedStatus() {
+			logging.For(ctx, s.logger, "event_service", "PatchEvent").WithError(ErrEventRevi).Error("operación fallida")
+			return entities.Event{}, ErrEventRevi
 		}
+		event.Category = *patch.Category
+		if patch.NeedsAction == nil {
+			event.NeedsAction = s.taxonomy.NeedsAction(event.Category)
+		}
+	} else if event.Status == s.taxonomy.ClassifiedStatus() && (event.Type != current.Type || event.Status != current.Status || event.Category == "") {
+		s.classify(&event)
+	}
+
+	if len(diffEvents(current, event)) == 0 {
+		return current, nil
 	}
+	return s.save(ctx, entities.AuditPatch, current, event)
+}
 
-	return s.repo.UpdateEvent(ctx, event)
+// TransitionEvent mueve el evento al estado transition.To si el ciclo de vida
+// lo permite y guarda la transición en su historial. Al pasar al estado
+// clasificado (Revisado) un evento sin categoría se clasifica automáticamente.
+func (s *eventService) TransitionEvent(ctx context.Context, id string, transition entities.StatusTransition) (entities.Event, error) {
+	if !s.taxonomy.ValidStatus(transition.To) {
+		logging.For(ctx, s.logger, "event_service", "TransitionEvent").WithError(ErrStatus).Error("operación fallida")
+		return entities.Event{}, s.errStatus("to")
+	}
+	// Con autenticación el historial registra al principal; el actor enviado
+	// solo se usa si la API está abierta.
+	if principal, ok := PrincipalFromContext(ctx); ok {
+		transition.Actor = principal.Subject
+	}
+	if transition.Actor == "" {
+		logging.For(ctx, s.logger, "event_service", "TransitionEvent").WithError(ErrActor).Error("operación fallida")
+		return entities.Event{}, ErrActor
+	}
+
+	event, err := s.repo.GetEventByID(ctx, id)
+	if err != nil {
+		logging.For(ctx, s.logger, "event_service", "TransitionEvent").WithError(err).Error("operación fallida")
+		return entities.Event{}, err
+	}
+
+	if !s.taxonomy.CanTransition(event.Status, transition.To) {
+		logging.For(ctx, s.logger, "event_service", "TransitionEvent").WithFields(logrus.Fields{logging.FieldEventID: id, "from": event.Status, "to": transition.To}).WithError(ErrTransition).Error("operación fallida")
+		return entities.Event{}, ErrTransition
+	}
+
+	before := event
+	transition.From = event.Status
+	transition.At = time.Now()
+	event.Status = transition.To
+	event.StatusHistory = append(event.StatusHistory, transition)
+
+	if event.Status == s.taxonomy.ClassifiedStatus() && event.Category == "" {
+		s.classify(&event)
+	}
+
+	return s.save(WithActor(ctx, transition.Actor), entities.AuditTransition, before, event)
 }
 
 func (s *eventService) DeleteEvent(ctx context.Context, id string) error {
 	if id == "" {
-		s.logger.Errorln("Layer: event_service", "Method: DeleteEvent", "Error:", ErrNoID)
+		logging.For(ctx, s.logger, "event_service", "DeleteEvent").WithError(ErrNoID).Error("operación fallida")
 		return ErrNoID
 	}
-	return s.repo.DeleteEvent(ctx, id)
+
+	now := time.Now()
+	before := entities.Event{ID: id}
+	found := false
+	if s.audit != nil || s.publisher != nil || s.notifier != nil {
+		// Si no existe, DeleteEvent devuelve el error correspondiente.
+		if current, err := s.repo.GetEventByID(ctx, id); err == nil {
+			before, found = current, true
+		}
+	}
+	if err := s.repo.DeleteEvent(ctx, id, now); err != nil {
+		return err
+	}
+	after := before
+	after.DeletedAt = &now
+	if found {
+		// El repositorio incrementa la versión al eliminar.
+		after.Version++
+	}
+	s.committed(ctx, entities.AuditDelete, before, after)
+	return nil
+}
+
+// RestoreEvent deshace la eliminación de un evento que aún no se ha purgado.
+func (s *eventService) RestoreEvent(ctx context.Context, id string) (entities.Event, error) {
+	before, err := s.repo.RestoreEvent(ctx, id)
+	if err != nil {
+		logging.For(ctx, s.logger, "event_service", "RestoreEvent").WithError(err).Error("operación fallida")
+		return entities.Event{}, err
+	}
+
+	restored := before
+	restored.DeletedAt = nil
+	restored.Version++
+	s.committed(ctx, entities.AuditRestore, before, restored)
+	return restored, nil
+}
+
+// GetEventHistory devuelve el historial de auditoría de un evento, incluso si
+// ya fue eliminado.
+func (s *eventService) GetEventHistory(ctx context.Context, id string) ([]entities.AuditEntry, error) {
+	if s.audit == nil {
+		logging.For(ctx, s.logger, "event_service", "GetEventHistory").WithError(ErrHistoryDisabled).Error("operación fallida")
+		return nil, ErrHistoryDisabled
+	}
+
+	entries, err := s.audit.ListEntries(ctx, id)
+	if err != nil {
+		logging.For(ctx, s.logger, "event_service", "GetEventHistory").WithError(err).Error("operación fallida")
+		return nil, err
+	}
+	if len(entries) == 0 {
+		if _, err := s.repo.GetEventByID(ctx, id); err != nil {
+			logging.For(ctx, s.logger, "event_service", "GetEventHistory").WithError(err).Error("operación fallida")
+			return nil, err
+		}
+	}
+	return entries, nil
 }
 
 func (s *eventService) ClassifyEvent(ctx context.Context, id string) (entities.Event, error) {
-	event, err := s.repo.GetEventByID(ctx, id)
+	before, event, err := s.prepareClassify(ctx, id)
 	if err != nil {
-		s.logger.Errorln("Layer: event_service", "Method: ClassifyEvent", "Error:", err)
 		return entities.Event{}, err
 	}
+	return s.save(ctx, entities.AuditClassify, before, event)
+}
 
-	if event.Status != "Revisado" {
-		s.logger.Errorln("Layer: event_service", "Method: ClassifyEvent", "Error:", ErrEventRevi)
-		return entities.Event{}, ErrEventRevi
+// prepareClassify devuelve el evento guardado y el mismo evento clasificado.
+func (s *eventService) prepareClassify(ctx context.Context, id string) (entities.Event, entities.Event, error) {
+	event, err := s.repo.GetEventByID(ctx, id)
+	if err != nil {
+		logging.For(ctx, s.logger, "event_service", "ClassifyEvent").WithError(err).Error("operación fallida")
+		return entities.Event{}, entities.Event{}, err
 	}
 
-	switch event.Type {
-	case "Incidente", "Problema", "Emergencia", "Error", "Critico":
-		event.Category = "Requiere gestión"
-		event.NeedsAction = true
-	case "Reunión", "Informe", "Actualización", "Notificación", "Consulta":
-		event.Category = "Sin gestión"
-		event.NeedsAction = false
-	default:
-		event.Category = "Sin gestión"
-		event.NeedsAction = false
+	if event.Status != s.taxonomy.ClassifiedStatus() {
+		logging.For(ctx, s.logger, "event_service", "ClassifyEvent").WithError(ErrEventRevi).Error("operación fallida")
+		return entities.Event{}, entities.Event{}, ErrEventRevi
 	}
 
-	return s.repo.UpdateEvent(ctx, event)
+	before := event
+	s.classify(&event)
+	return before, event, nil
+}
+
+func (s *eventService) classify(event *entities.Event) {
+	classification := s.classifier.Classify(*event)
+	event.Category = classification.Category
+	event.NeedsAction = classification.NeedsAction
+}
+
+// DryRunClassification evalúa las reglas vigentes sobre event sin guardar nada.
+func (s *eventService) DryRunClassification(ctx context.Context, event entities.Event) (entities.Classification, error) {
+	return s.classifier.Classify(event), nil
 }
 
-func (s *eventService) ManualClassifyEvent(ctx context.Context, id string, category string) (entities.Event, error) {
-	if category != "Requiere gestión" && category != "Sin gestión" {
-		s.logger.Errorln("Layer: event_service", "Method: ClassifyEvent", "Error:", ErrCategory)
-		return entities.Event{}, ErrCategory
+func (s *eventService) ManualClassifyEvent(ctx context.Context, id string, category entities.Category) (entities.Event, error) {
+	if !s.taxonomy.ValidCategory(category) {
+		logging.For(ctx, s.logger, "event_service", "ClassifyEvent").WithError(ErrCategory).Error("operación fallida")
+		return entities.Event{}, s.errCategory(ErrCategory, "category")
 	}
 
 	event, err := s.repo.GetEventByID(ctx, id)
 	if err != nil {
-		s.logger.Errorln("Layer: event_service", "Method: ClassifyEvent", "Error:", err)
+		logging.For(ctx, s.logger, "event_service", "ClassifyEvent").WithError(err).Error("operación fallida")
 		return entities.Event{}, err
 	}
 
-	if event.Status != "Revisado" {
-		s.logger.Errorln("Layer: event_service", "Method: ClassifyEvent", "Error:", ErrEventRevi)
+	if event.Status != s.taxonomy.ClassifiedStatus() {
+		logging.For(ctx, s.logger, "event_service", "ClassifyEvent").WithError(ErrEventRevi).Error("operación fallida")
 		return entities.Event{}, ErrEventRevi
 	}
 
+	before := event
 	event.Category = category
-	event.NeedsAction = (category == "Requiere gestión")
+	event.NeedsAction = s.taxonomy.NeedsAction(category)
+
+	return s.save(ctx, entities.AuditManualClassify, before, event)
+}
 
-	return s.repo.UpdateEvent(ctx, event)
+// save guarda after y lo registra en el historial como action. Si otra
+// operación modificó el evento desde que se leyó devuelve ErrVersionConflict.
+func (s *eventService) save(ctx context.Context, action string, before, after entities.Event) (entities.Event, error) {
+	updated, err := s.repo.UpdateEvent(ctx, after)
+	if err != nil {
+		logging.For(ctx, s.logger, "event_service", "save").WithError(err).Error("operación fallida")
+		return entities.Event{}, err
+	}
+	s.committed(ctx, action, before, updated)
+	return updated, nil
 }
@@ -3,13 +3,20 @@ package service
 import (
 	"context"
 	"errors"
+	"prueba_tecnica/api/apperr"
+	"prueba_tecnica/api/changes"
 	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/repository"
+	"prueba_tecnica/api/taxonomy"
+	"prueba_tecnica/api/tenant"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCreateEvent(t *testing.T) {
@@ -51,6 +58,18 @@ func TestCreateEvent(t *testing.T) {
 			mockError:     nil,
 			expectedError: ErrStatus,
 		},
+		{
+			name: "Failure - Status is not an initial status",
+			event: entities.Event{
+				Name:        "Test Event",
+				Description: "Test Description",
+				Type:        "Incidente",
+				Status:      "Cerrado",
+			},
+			mockResponse:  entities.Event{},
+			mockError:     nil,
+			expectedError: ErrInitialStatus,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -70,7 +89,7 @@ func TestCreateEvent(t *testing.T) {
 			// Assert
 			if tc.expectedError != nil {
 				assert.Error(t, err)
-				assert.Equal(t, tc.expectedError, err)
+				assert.ErrorIs(t, err, tc.expectedError)
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, tc.mockResponse.ID, result.ID)
@@ -80,6 +99,63 @@ func TestCreateEvent(t *testing.T) {
 	}
 }
 
+func TestCreateEventReportsInvalidFields(t *testing.T) {
+	service := NewEventService(new(mockEventRepository), logrus.New())
+
+	_, err := service.CreateEvent(context.Background(), entities.Event{Name: "VPN", Status: entities.StatusPending})
+
+	require.ErrorIs(t, err, ErrValidation)
+	var appErr *apperr.Error
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, apperr.InvalidArgument, appErr.Code)
+	assert.Equal(t, []apperr.FieldViolation{
+		apperr.Violation("type", "validation.required"),
+		apperr.Violation("description", "validation.required"),
+	}, appErr.Fields)
+}
+
+func TestCreateEventWithCustomTaxonomy(t *testing.T) {
+	vocabulary := &taxonomy.Taxonomy{
+		Statuses: []taxonomy.Status{
+			{Name: "Abierto", Initial: true, Classified: true, Next: []entities.Status{"Archivado"}},
+			{Name: "Archivado"},
+		},
+		Categories: []taxonomy.Category{{Name: "Urgente", NeedsAction: true}, {Name: "Normal", Default: true}},
+		Types:      []string{"Alerta"},
+	}
+	require.NoError(t, vocabulary.Validate())
+	mockRepo := new(mockEventRepository)
+	service := NewEventService(mockRepo, logrus.New(), WithTaxonomy(vocabulary))
+
+	_, err := service.CreateEvent(context.Background(), entities.Event{Name: "VPN", Type: "Incidente", Description: "d", Status: "Abierto"})
+	require.ErrorIs(t, err, ErrType)
+	var appErr *apperr.Error
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, []apperr.FieldViolation{apperr.Violation("type", "validation.oneof", "Alerta")}, appErr.Fields)
+
+	_, err = service.CreateEvent(context.Background(), entities.Event{Name: "VPN", Type: "Alerta", Description: "d", Status: entities.StatusPending})
+	assert.ErrorIs(t, err, ErrStatus)
+	_, err = service.CreateEvent(context.Background(), entities.Event{Name: "VPN", Type: "Alerta", Description: "d", Status: "Archivado"})
+	assert.ErrorIs(t, err, ErrInitialStatus)
+
+	got, err := service.GetTaxonomy(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, *vocabulary, got)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateEventKeepsRepositoryErrors(t *testing.T) {
+	mockRepo := new(mockEventRepository)
+	service := NewEventService(mockRepo, logrus.New())
+	outage := errors.New("sin conexión")
+	mockRepo.On("GetEventByID", mock.Anything, "1").Return(entities.Event{}, outage)
+
+	_, err := service.UpdateEvent(context.Background(), entities.Event{ID: "1", Name: "VPN", Type: "Incidente", Description: "d", Status: entities.StatusPending})
+
+	assert.Equal(t, outage, err)
+	assert.Equal(t, apperr.Internal, apperr.CodeOf(err))
+}
+
 func TestGetEventByID(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -155,25 +231,50 @@ func TestGetAllEvents(t *testing.T) {
 
 	testCases := []struct {
 		name          string
-		mockResponse  []entities.Event
+		page          entities.PageRequest
+		mockResponse  entities.EventPage
 		mockError     error
 		expectedError error
 		expectedCount int
 	}{
 		{
 			name:          "Success - Get all events",
-			mockResponse:  mockEvents,
+			mockResponse:  entities.EventPage{Events: mockEvents, NextCursor: "next"},
 			mockError:     nil,
 			expectedError: nil,
 			expectedCount: 2,
 		},
+		{
+			name:          "Success - Forwards page request",
+			page:          entities.PageRequest{Limit: 10, Cursor: "abc"},
+			mockResponse:  entities.EventPage{Events: mockEvents[:1]},
+			mockError:     nil,
+			expectedError: nil,
+			expectedCount: 1,
+		},
 		{
 			name:          "Failure - Repository error",
-			mockResponse:  []entities.Event{},
+			mockResponse:  entities.EventPage{},
 			mockError:     errors.New("database error"),
 			expectedError: errors.New("database error"),
 			expectedCount: 0,
 		},
+		{
+			name:          "Failure - Invalid cursor",
+			page:          entities.PageRequest{Cursor: "???"},
+			mockResponse:  entities.EventPage{},
+			mockError:     repository.ErrInvalidCursor,
+			expectedError: ErrInvalidCursor,
+			expectedCount: 0,
+		},
+		{
+			name:          "Failure - Limit too large",
+			page:          entities.PageRequest{Limit: repository.MaxPageLimit + 1},
+			mockResponse:  entities.EventPage{},
+			mockError:     nil,
+			expectedError: ErrPageLimit,
+			expectedCount: 0,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -183,10 +284,12 @@ func TestGetAllEvents(t *testing.T) {
 			logger := logrus.New()
 			service := NewEventService(mockRepo, logger)
 
-			mockRepo.On("GetAllEvents", mock.Anything).Return(tc.mockResponse, tc.mockError)
+			if tc.expectedError != ErrPageLimit {
+				mockRepo.On("ListEvents", mock.Anything, entities.EventQuery{Page: tc.page}).Return(tc.mockResponse, tc.mockError)
+			}
 
 			// Execute
-			result, err := service.GetAllEvents(context.Background())
+			result, err := service.GetAllEvents(context.Background(), tc.page)
 
 			// Assert
 			if tc.expectedError != nil {
@@ -194,7 +297,174 @@ func TestGetAllEvents(t *testing.T) {
 				assert.Equal(t, tc.expectedError.Error(), err.Error())
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tc.expectedCount, len(result))
+				assert.Equal(t, tc.expectedCount, len(result.Events))
+				assert.Equal(t, tc.mockResponse.NextCursor, result.NextCursor)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestListEvents(t *testing.T) {
+	needsAction := true
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	testCases := []struct {
+		name          string
+		query         entities.EventQuery
+		callsRepo     bool
+		mockError     error
+		expectedError error
+	}{
+		{
+			name: "Success - Combined filters",
+			query: entities.EventQuery{
+				Status:      "Revisado",
+				Category:    "Requiere gestión",
+				Type:        "Incidente",
+				NeedsAction: &needsAction,
+				From:        from,
+				To:          to,
+				Name:        "vpn",
+				SortBy:      entities.SortByName,
+				SortOrder:   entities.SortAsc,
+				Page:        entities.PageRequest{Limit: 10},
+			},
+			callsRepo: true,
+		},
+		{
+			name:          "Failure - Invalid status",
+			query:         entities.EventQuery{Status: "Archivado"},
+			expectedError: ErrStatus,
+		},
+		{
+			name:          "Failure - Invalid category",
+			query:         entities.EventQuery{Category: "Otra"},
+			expectedError: ErrTypeCategory,
+		},
+		{
+			name:          "Failure - Invalid sort field",
+			query:         entities.EventQuery{SortBy: "description"},
+			expectedError: ErrSort,
+		},
+		{
+			name:          "Failure - Invalid sort order",
+			query:         entities.EventQuery{SortOrder: "up"},
+			expectedError: ErrSort,
+		},
+		{
+			name:          "Failure - Inverted date range",
+			query:         entities.EventQuery{From: to, To: from},
+			expectedError: ErrDateRange,
+		},
+		{
+			name:          "Failure - Invalid cursor",
+			query:         entities.EventQuery{Page: entities.PageRequest{Cursor: "x"}},
+			callsRepo:     true,
+			mockError:     repository.ErrInvalidCursor,
+			expectedError: ErrInvalidCursor,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(mockEventRepository)
+			logger := logrus.New()
+			service := NewEventService(mockRepo, logger)
+
+			if tc.callsRepo {
+				mockRepo.On("ListEvents", mock.Anything, tc.query).Return(entities.EventPage{}, tc.mockError)
+			}
+
+			_, err := service.ListEvents(context.Background(), tc.query)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSearchEvents(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+	hits := []entities.SearchHit{{Event: entities.Event{ID: "1", Name: "Caída de VPN"}, Score: 5, Highlights: map[string]string{"name": "Caída de <em>VPN</em>"}}}
+
+	testCases := []struct {
+		name          string
+		query         entities.SearchQuery
+		callsRepo     bool
+		mockError     error
+		expectedError error
+	}{
+		{
+			name: "Success - Text with filters",
+			query: entities.SearchQuery{
+				Text:     "vpn",
+				Status:   "Revisado",
+				Category: "Requiere gestión",
+				From:     from,
+				To:       to,
+				Page:     entities.PageRequest{Limit: 10},
+			},
+			callsRepo: true,
+		},
+		{
+			name:          "Failure - Empty text",
+			query:         entities.SearchQuery{Text: "  "},
+			expectedError: ErrSearchText,
+		},
+		{
+			name:          "Failure - Text too long",
+			query:         entities.SearchQuery{Text: strings.Repeat("vpn ", 100)},
+			expectedError: ErrSearchText,
+		},
+		{
+			name:          "Failure - Invalid status",
+			query:         entities.SearchQuery{Text: "vpn", Status: "Archivado"},
+			expectedError: ErrStatus,
+		},
+		{
+			name:          "Failure - Inverted date range",
+			query:         entities.SearchQuery{Text: "vpn", From: to, To: from},
+			expectedError: ErrDateRange,
+		},
+		{
+			name:          "Failure - Invalid page limit",
+			query:         entities.SearchQuery{Text: "vpn", Page: entities.PageRequest{Limit: 1000}},
+			expectedError: ErrPageLimit,
+		},
+		{
+			name:          "Failure - Invalid cursor",
+			query:         entities.SearchQuery{Text: "vpn", Page: entities.PageRequest{Cursor: "x"}},
+			callsRepo:     true,
+			mockError:     repository.ErrInvalidCursor,
+			expectedError: ErrInvalidCursor,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(mockEventRepository)
+			logger := logrus.New()
+			service := NewEventService(mockRepo, logger)
+
+			if tc.callsRepo {
+				mockRepo.On("SearchEvents", mock.Anything, tc.query).Return(entities.SearchPage{Hits: hits}, tc.mockError)
+			}
+
+			page, err := service.SearchEvents(context.Background(), tc.query)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				assert.Empty(t, page.Hits)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, hits, page.Hits)
 			}
 			mockRepo.AssertExpectations(t)
 		})
@@ -221,8 +491,8 @@ func TestGetEventsByStatus(t *testing.T) {
 
 	testCases := []struct {
 		name          string
-		status        string
-		mockResponse  []entities.Event
+		status        entities.Status
+		mockResponse  entities.EventPage
 		mockError     error
 		expectedError error
 		expectedCount int
@@ -230,7 +500,7 @@ func TestGetEventsByStatus(t *testing.T) {
 		{
 			name:          "Success - Get events by valid status",
 			status:        "Pendiente por revisar",
-			mockResponse:  mockEvents,
+			mockResponse:  entities.EventPage{Events: mockEvents},
 			mockError:     nil,
 			expectedError: nil,
 			expectedCount: 2,
@@ -238,9 +508,9 @@ func TestGetEventsByStatus(t *testing.T) {
 		{
 			name:          "Failure - Invalid status",
 			status:        "Invalid Status",
-			mockResponse:  []entities.Event{},
+			mockResponse:  entities.EventPage{},
 			mockError:     nil,
-			expectedError: errors.New("status debe ser 'Pendiente por revisar' o 'Revisado'"),
+			expectedError: ErrStatus,
 			expectedCount: 0,
 		},
 	}
@@ -253,11 +523,11 @@ func TestGetEventsByStatus(t *testing.T) {
 			service := NewEventService(mockRepo, logger)
 
 			if tc.expectedError == nil {
-				mockRepo.On("GetEventsByStatus", mock.Anything, tc.status).Return(tc.mockResponse, tc.mockError)
+				mockRepo.On("ListEvents", mock.Anything, entities.EventQuery{Status: tc.status}).Return(tc.mockResponse, tc.mockError)
 			}
 
 			// Execute
-			result, err := service.GetEventsByStatus(context.Background(), tc.status)
+			result, err := service.GetEventsByStatus(context.Background(), tc.status, entities.PageRequest{})
 
 			// Assert
 			if tc.expectedError != nil {
@@ -265,7 +535,7 @@ func TestGetEventsByStatus(t *testing.T) {
 				assert.Equal(t, tc.expectedError.Error(), err.Error())
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tc.expectedCount, len(result))
+				assert.Equal(t, tc.expectedCount, len(result.Events))
 			}
 			mockRepo.AssertExpectations(t)
 		})
@@ -286,8 +556,8 @@ func TestGetEventsByCategory(t *testing.T) {
 
 	testCases := []struct {
 		name          string
-		category      string
-		mockResponse  []entities.Event
+		category      entities.Category
+		mockResponse  entities.EventPage
 		mockError     error
 		expectedError error
 		expectedCount int
@@ -295,7 +565,7 @@ func TestGetEventsByCategory(t *testing.T) {
 		{
 			name:          "Success - Get events by valid category",
 			category:      "Requiere gestión",
-			mockResponse:  mockEvents,
+			mockResponse:  entities.EventPage{Events: mockEvents},
 			mockError:     nil,
 			expectedError: nil,
 			expectedCount: 1,
@@ -303,7 +573,7 @@ func TestGetEventsByCategory(t *testing.T) {
 		{
 			name:          "Failure - Invalid category",
 			category:      "Invalid Category",
-			mockResponse:  []entities.Event{},
+			mockResponse:  entities.EventPage{},
 			mockError:     nil,
 			expectedError: ErrTypeCategory,
 			expectedCount: 0,
@@ -318,19 +588,19 @@ func TestGetEventsByCategory(t *testing.T) {
 			service := NewEventService(mockRepo, logger)
 
 			if tc.expectedError == nil {
-				mockRepo.On("GetEventsByCategory", mock.Anything, tc.category).Return(tc.mockResponse, tc.mockError)
+				mockRepo.On("ListEvents", mock.Anything, entities.EventQuery{Category: tc.category, Status: "Revisado"}).Return(tc.mockResponse, tc.mockError)
 			}
 
 			// Execute
-			result, err := service.GetEventsByCategory(context.Background(), tc.category)
+			result, err := service.GetEventsByCategory(context.Background(), tc.category, entities.PageRequest{})
 
 			// Assert
 			if tc.expectedError != nil {
 				assert.Error(t, err)
-				assert.Equal(t, tc.expectedError, err)
+				assert.ErrorIs(t, err, tc.expectedError)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tc.expectedCount, len(result))
+				assert.Equal(t, tc.expectedCount, len(result.Events))
 			}
 			mockRepo.AssertExpectations(t)
 		})
@@ -352,21 +622,21 @@ func TestGetEventsNeedingAction(t *testing.T) {
 
 	testCases := []struct {
 		name          string
-		mockResponse  []entities.Event
+		mockResponse  entities.EventPage
 		mockError     error
 		expectedError error
 		expectedCount int
 	}{
 		{
 			name:          "Success - Get events needing action",
-			mockResponse:  mockEvents,
+			mockResponse:  entities.EventPage{Events: mockEvents},
 			mockError:     nil,
 			expectedError: nil,
 			expectedCount: 1,
 		},
 		{
 			name:          "Failure - Repository error",
-			mockResponse:  []entities.Event{},
+			mockResponse:  entities.EventPage{},
 			mockError:     errors.New("database error"),
 			expectedError: errors.New("database error"),
 			expectedCount: 0,
@@ -380,10 +650,11 @@ func TestGetEventsNeedingAction(t *testing.T) {
 			logger := logrus.New()
 			service := NewEventService(mockRepo, logger)
 
-			mockRepo.On("GetEventsNeedingAction", mock.Anything).Return(tc.mockResponse, tc.mockError)
+			needsAction := true
+			mockRepo.On("ListEvents", mock.Anything, entities.EventQuery{NeedsAction: &needsAction, Status: "Revisado"}).Return(tc.mockResponse, tc.mockError)
 
 			// Execute
-			result, err := service.GetEventsNeedingAction(context.Background())
+			result, err := service.GetEventsNeedingAction(context.Background(), entities.PageRequest{})
 
 			// Assert
 			if tc.expectedError != nil {
@@ -391,7 +662,7 @@ func TestGetEventsNeedingAction(t *testing.T) {
 				assert.Equal(t, tc.expectedError.Error(), err.Error())
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tc.expectedCount, len(result))
+				assert.Equal(t, tc.expectedCount, len(result.Events))
 			}
 			mockRepo.AssertExpectations(t)
 		})
@@ -564,7 +835,7 @@ func TestDeleteEvent(t *testing.T) {
 			service := NewEventService(mockRepo, logger)
 
 			if tc.eventID != "" {
-				mockRepo.On("DeleteEvent", mock.Anything, tc.eventID).Return(tc.mockError)
+				mockRepo.On("DeleteEvent", mock.Anything, tc.eventID, mock.AnythingOfType("time.Time")).Return(tc.mockError)
 			}
 
 			// Execute
@@ -592,7 +863,7 @@ func TestClassifyEvent(t *testing.T) {
 		getEventError    error
 		updateReturn     entities.Event
 		updateError      error
-		expectedCategory string
+		expectedCategory entities.Category
 		expectedAction   bool
 		expectedError    error
 	}{
@@ -706,7 +977,7 @@ func TestManualClassifyEvent(t *testing.T) {
 	testCases := []struct {
 		name           string
 		eventID        string
-		category       string
+		category       entities.Category
 		getEventReturn entities.Event
 		getEventError  error
 		updateReturn   entities.Event
@@ -824,7 +1095,7 @@ func TestManualClassifyEvent(t *testing.T) {
 			// Assert
 			if tc.expectedError != nil {
 				assert.Error(t, err)
-				assert.Equal(t, tc.expectedError, err)
+				assert.ErrorIs(t, err, tc.expectedError)
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, tc.category, result.Category)
@@ -834,3 +1105,849 @@ func TestManualClassifyEvent(t *testing.T) {
 		})
 	}
 }
+
+type stubClassifier entities.Classification
+
+func (c stubClassifier) Classify(event entities.Event) entities.Classification {
+	return entities.Classification(c)
+}
+
+func TestClassifyEventWithClassifier(t *testing.T) {
+	mockRepo := new(mockEventRepository)
+	classifier := stubClassifier{Rule: "custom", Category: "Requiere gestión", NeedsAction: true}
+	service := NewEventService(mockRepo, logrus.New(), WithClassifier(classifier))
+
+	event := entities.Event{ID: "1", Name: "Test Event", Type: "Reunión", Status: "Revisado"}
+	mockRepo.On("GetEventByID", mock.Anything, "1").Return(event, nil)
+	mockRepo.On("UpdateEvent", mock.Anything, mock.MatchedBy(func(e entities.Event) bool {
+		return e.Category == "Requiere gestión" && e.NeedsAction
+	})).Return(entities.Event{ID: "1", Category: "Requiere gestión", NeedsAction: true}, nil)
+
+	result, err := service.ClassifyEvent(context.Background(), "1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, entities.CategoryNeedsAction, result.Category)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestDryRunClassification(t *testing.T) {
+	mockRepo := new(mockEventRepository)
+	service := NewEventService(mockRepo, logrus.New())
+
+	result, err := service.DryRunClassification(context.Background(), entities.Event{Type: "Incidente"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, entities.Classification{Rule: "requiere-gestion", Category: "Requiere gestión", NeedsAction: true}, result)
+	// No debe tocar el repositorio.
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTransitionEvent(t *testing.T) {
+	pending := entities.Event{ID: "1", Name: "VPN", Type: "Incidente", Description: "d", Status: entities.StatusPending}
+	inReview := pending
+	inReview.Status = entities.StatusInReview
+	closed := pending
+	closed.Status = entities.StatusClosed
+
+	testCases := []struct {
+		name           string
+		ctx            context.Context
+		transition     entities.StatusTransition
+		getEventReturn entities.Event
+		getEventError  error
+		callsUpdate    bool
+		expectedStatus entities.Status
+		expectedActor  string
+		expectedError  error
+	}{
+		{
+			name:           "Success - Pending to in review",
+			transition:     entities.StatusTransition{To: entities.StatusInReview, Actor: "ana", Reason: "triage"},
+			getEventReturn: pending,
+			callsUpdate:    true,
+			expectedStatus: entities.StatusInReview,
+		},
+		{
+			name:           "Success - Closed event is reopened",
+			transition:     entities.StatusTransition{To: entities.StatusReopened, Actor: "ana"},
+			getEventReturn: closed,
+			callsUpdate:    true,
+			expectedStatus: entities.StatusReopened,
+		},
+		{
+			name:           "Failure - Skipping the review",
+			transition:     entities.StatusTransition{To: entities.StatusClosed, Actor: "ana"},
+			getEventReturn: pending,
+			expectedError:  ErrTransition,
+		},
+		{
+			name:          "Failure - Unknown status",
+			transition:    entities.StatusTransition{To: "Archivado", Actor: "ana"},
+			expectedError: ErrStatus,
+		},
+		{
+			name:           "Success - The principal is the actor",
+			ctx:            WithPrincipal(context.Background(), entities.Principal{Subject: "luis"}),
+			transition:     entities.StatusTransition{To: entities.StatusInReview, Actor: "ana"},
+			getEventReturn: pending,
+			callsUpdate:    true,
+			expectedStatus: entities.StatusInReview,
+			expectedActor:  "luis",
+		},
+		{
+			name:           "Success - Authenticated without actor",
+			ctx:            WithPrincipal(context.Background(), entities.Principal{Subject: "luis"}),
+			transition:     entities.StatusTransition{To: entities.StatusInReview},
+			getEventReturn: pending,
+			callsUpdate:    true,
+			expectedStatus: entities.StatusInReview,
+			expectedActor:  "luis",
+		},
+		{
+			name:          "Failure - Missing actor",
+			transition:    entities.StatusTransition{To: entities.StatusInReview},
+			expectedError: ErrActor,
+		},
+		{
+			name:          "Failure - Event not found",
+			transition:    entities.StatusTransition{To: entities.StatusInReview, Actor: "ana"},
+			getEventError: repository.ErrEventNotfound,
+			expectedError: ErrEventNotfound,
+		},
+		{
+			name:           "Success - Reviewed events are classified",
+			transition:     entities.StatusTransition{To: entities.StatusReviewed, Actor: "ana"},
+			getEventReturn: inReview,
+			callsUpdate:    true,
+			expectedStatus: entities.StatusReviewed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(mockEventRepository)
+			service := NewEventService(mockRepo, logrus.New())
+
+			if tc.expectedError != ErrStatus && tc.expectedError != ErrActor {
+				mockRepo.On("GetEventByID", mock.Anything, "1").Return(tc.getEventReturn, tc.getEventError)
+			}
+			if tc.callsUpdate {
+				mockRepo.On("UpdateEvent", mock.Anything, mock.AnythingOfType("entities.Event")).Return(
+					func(ctx context.Context, e entities.Event) entities.Event { return e }, nil)
+			}
+
+			ctx := tc.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			expectedActor := tc.expectedActor
+			if expectedActor == "" {
+				expectedActor = tc.transition.Actor
+			}
+
+			result, err := service.TransitionEvent(ctx, "1", tc.transition)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedStatus, result.Status)
+				if assert.Len(t, result.StatusHistory, 1) {
+					h := result.StatusHistory[0]
+					assert.Equal(t, tc.getEventReturn.Status, h.From)
+					assert.Equal(t, tc.expectedStatus, h.To)
+					assert.Equal(t, expectedActor, h.Actor)
+					assert.Equal(t, tc.transition.Reason, h.Reason)
+					assert.False(t, h.At.IsZero())
+				}
+				if tc.expectedStatus == entities.StatusReviewed {
+					assert.Equal(t, entities.CategoryNeedsAction, result.Category)
+					assert.True(t, result.NeedsAction)
+				}
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateEventWithCustomTaxonomy(t *testing.T) {
+	vocabulary := &taxonomy.Taxonomy{
+		Statuses:   []taxonomy.Status{{Name: "Abierto", Initial: true, Classified: true}},
+		Categories: []taxonomy.Category{{Name: "Urgente", NeedsAction: true}, {Name: "Normal", Default: true}},
+		Types:      []string{"Alerta"},
+	}
+	require.NoError(t, vocabulary.Validate())
+	stored := entities.Event{ID: "1", Name: "VPN", Type: "Alerta", Description: "d", Status: "Abierto", Category: "Normal", Version: 1}
+	unknown := apperr.Violation("category", "validation.oneof", "Urgente, Normal")
+
+	t.Run("PATCH rejects a category outside the taxonomy", func(t *testing.T) {
+		service := NewEventService(new(mockEventRepository), logrus.New(), WithTaxonomy(vocabulary))
+
+		category := entities.CategoryNeedsAction
+		_, err := service.PatchEvent(context.Background(), "1", entities.EventPatch{Category: &category})
+
+		require.ErrorIs(t, err, ErrCategory)
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, []apperr.FieldViolation{unknown}, appErr.Fields)
+	})
+
+	t.Run("PATCH accepts a category of the taxonomy", func(t *testing.T) {
+		mockRepo := new(mockEventRepository)
+		service := NewEventService(mockRepo, logrus.New(), WithTaxonomy(vocabulary))
+		mockRepo.On("GetEventByID", mock.Anything, "1").Return(stored, nil)
+		mockRepo.On("UpdateEvent", mock.Anything, mock.AnythingOfType("entities.Event")).Return(
+			func(ctx context.Context, e entities.Event) entities.Event { return e }, nil)
+
+		category := entities.Category("Urgente")
+		result, err := service.PatchEvent(context.Background(), "1", entities.EventPatch{Category: &category})
+
+		require.NoError(t, err)
+		assert.Equal(t, category, result.Category)
+		assert.True(t, result.NeedsAction)
+	})
+
+	t.Run("PUT keeps the stored category", func(t *testing.T) {
+		mockRepo := new(mockEventRepository)
+		service := NewEventService(mockRepo, logrus.New(), WithTaxonomy(vocabulary))
+		mockRepo.On("GetEventByID", mock.Anything, "1").Return(stored, nil)
+		mockRepo.On("UpdateEvent", mock.Anything, mock.AnythingOfType("entities.Event")).Return(
+			func(ctx context.Context, e entities.Event) entities.Event { return e }, nil)
+
+		changed := stored
+		changed.Category = entities.CategoryNeedsAction
+		result, err := service.UpdateEvent(context.Background(), changed)
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.Category("Normal"), result.Category)
+	})
+
+	t.Run("ManualClassifyEvent rejects a category outside the taxonomy", func(t *testing.T) {
+		service := NewEventService(new(mockEventRepository), logrus.New(), WithTaxonomy(vocabulary))
+
+		_, err := service.ManualClassifyEvent(context.Background(), "1", entities.CategoryNeedsAction)
+
+		require.ErrorIs(t, err, ErrCategory)
+		var appErr *apperr.Error
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, []apperr.FieldViolation{unknown}, appErr.Fields)
+	})
+
+	t.Run("PUT rejects a type outside the taxonomy", func(t *testing.T) {
+		service := NewEventService(new(mockEventRepository), logrus.New(), WithTaxonomy(vocabulary))
+
+		changed := stored
+		changed.Type = "Incidente"
+		_, err := service.UpdateEvent(context.Background(), changed)
+
+		assert.ErrorIs(t, err, ErrType)
+	})
+}
+
+func TestUpdateEventRejectsStatusChange(t *testing.T) {
+	mockRepo := new(mockEventRepository)
+	service := NewEventService(mockRepo, logrus.New())
+
+	current := entities.Event{ID: "1", Name: "VPN", Type: "Incidente", Description: "d", Status: entities.StatusPending}
+	mockRepo.On("GetEventByID", mock.Anything, "1").Return(current, nil)
+
+	changed := current
+	changed.Status = entities.StatusReviewed
+	_, err := service.UpdateEvent(context.Background(), changed)
+
+	assert.Equal(t, ErrStatusChange, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateEventKeepsClassification(t *testing.T) {
+	mockRepo := new(mockEventRepository)
+	service := NewEventService(mockRepo, logrus.New())
+
+	current := entities.Event{ID: "1", Name: "VPN", Type: "Incidente", Description: "d", Status: entities.StatusReviewed, Category: entities.CategoryNoAction}
+	mockRepo.On("GetEventByID", mock.Anything, "1").Return(current, nil)
+	mockRepo.On("UpdateEvent", mock.Anything, mock.AnythingOfType("entities.Event")).Return(
+		func(ctx context.Context, e entities.Event) entities.Event { return e }, nil)
+
+	// Una categoría que no está en la taxonomía no llega al repositorio.
+	changed := current
+	changed.Name = "VPN caída"
+	changed.Category = "Urgente"
+	changed.NeedsAction = true
+	result, err := service.UpdateEvent(context.Background(), changed)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "VPN caída", result.Name)
+	assert.Equal(t, entities.CategoryNoAction, result.Category)
+	assert.False(t, result.NeedsAction)
+	mockRepo.AssertCalled(t, "UpdateEvent", mock.Anything, mock.MatchedBy(func(e entities.Event) bool {
+		return e.Category == entities.CategoryNoAction && !e.NeedsAction
+	}))
+}
+
+func TestAuditTrail(t *testing.T) {
+	ctx := WithTransport(WithActor(context.Background(), "ana"), entities.TransportHTTP)
+	reviewed := entities.Event{ID: "1", Name: "VPN", Type: "Incidente", Description: "d", Status: entities.StatusReviewed}
+
+	t.Run("Create records every field", func(t *testing.T) {
+		mockRepo := new(mockEventRepository)
+		mockAudit := new(mockAuditRepository)
+		service := NewEventService(mockRepo, logrus.New(), WithAuditLog(mockAudit))
+
+		mockRepo.On("CreateEvent", mock.Anything, mock.AnythingOfType("entities.Event")).Return(reviewed, nil)
+		mockAudit.On("AppendEntry", mock.Anything, mock.MatchedBy(func(e entities.AuditEntry) bool {
+			return e.EventID == "1" && e.Action == entities.AuditCreate && e.Actor == "ana" &&
+				e.Transport == entities.TransportHTTP && len(e.Changes) == 7 &&
+				e.Changes[0] == entities.FieldChange{Field: "name", Old: nil, New: "VPN"}
+		})).Return(entities.AuditEntry{}, nil)
+
+		_, err := service.CreateEvent(ctx, entities.Event{Name: "VPN", Type: "Incidente", Description: "d", Status: entities.StatusReviewed})
+
+		assert.NoError(t, err)
+		mockAudit.AssertExpectations(t)
+	})
+
+	t.Run("Manual classify records only the changed fields", func(t *testing.T) {
+		mockRepo := new(mockEventRepository)
+		mockAudit := new(mockAuditRepository)
+		service := NewEventService(mockRepo, logrus.New(), WithAuditLog(mockAudit))
+
+		mockRepo.On("GetEventByID", mock.Anything, "1").Return(reviewed, nil)
+		mockRepo.On("UpdateEvent", mock.Anything, mock.AnythingOfType("entities.Event")).Return(
+			func(ctx context.Context, e entities.Event) entities.Event { return e }, nil)
+		mockAudit.On("AppendEntry", mock.Anything, mock.MatchedBy(func(e entities.AuditEntry) bool {
+			return e.Action == entities.AuditManualClassify && assert.ObjectsAreEqual([]entities.FieldChange{
+				{Field: "category", Old: "", New: "Sin gestión"},
+			}, e.Changes)
+		})).Return(entities.AuditEntry{}, nil)
+
+		_, err := service.ManualClassifyEvent(ctx, "1", "Sin gestión")
+
+		assert.NoError(t, err)
+		mockAudit.AssertExpectations(t)
+	})
+
+	t.Run("Delete records the deletion mark", func(t *testing.T) {
+		mockRepo := new(mockEventRepository)
+		mockAudit := new(mockAuditRepository)
+		service := NewEventService(mockRepo, logrus.New(), WithAuditLog(mockAudit))
+
+		mockRepo.On("GetEventByID", mock.Anything, "1").Return(reviewed, nil)
+		mockRepo.On("DeleteEvent", mock.Anything, "1", mock.AnythingOfType("time.Time")).Return(nil)
+		mockAudit.On("AppendEntry", mock.Anything, mock.MatchedBy(func(e entities.AuditEntry) bool {
+			if e.EventID != "1" || e.Action != entities.AuditDelete || len(e.Changes) != 1 {
+				return false
+			}
+			_, isTime := e.Changes[0].New.(time.Time)
+			return e.Changes[0].Field == "deleted_at" && e.Changes[0].Old == nil && isTime
+		})).Return(entities.AuditEntry{}, nil)
+
+		assert.NoError(t, service.DeleteEvent(ctx, "1"))
+		mockAudit.AssertExpectations(t)
+	})
+
+	t.Run("Failed operations are not recorded", func(t *testing.T) {
+		mockRepo := new(mockEventRepository)
+		mockAudit := new(mockAuditRepository)
+		service := NewEventService(mockRepo, logrus.New(), WithAuditLog(mockAudit))
+
+		mockRepo.On("GetEventByID", mock.Anything, "1").Return(entities.Event{}, repository.ErrEventNotfound)
+		mockRepo.On("DeleteEvent", mock.Anything, "1", mock.AnythingOfType("time.Time")).Return(repository.ErrNotasks)
+
+		assert.Equal(t, repository.ErrNotasks, service.DeleteEvent(ctx, "1"))
+		mockAudit.AssertNotCalled(t, "AppendEntry", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGetEventHistory(t *testing.T) {
+	entries := []entities.AuditEntry{{EventID: "1", Action: entities.AuditCreate}}
+
+	testCases := []struct {
+		name          string
+		entries       []entities.AuditEntry
+		eventError    error
+		expectedError error
+	}{
+		{name: "Success - History of an event", entries: entries},
+		{name: "Success - Event without changes yet", entries: []entities.AuditEntry{}},
+		{name: "Failure - Unknown event", entries: []entities.AuditEntry{}, eventError: repository.ErrEventNotfound, expectedError: ErrEventNotfound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(mockEventRepository)
+			mockAudit := new(mockAuditRepository)
+			service := NewEventService(mockRepo, logrus.New(), WithAuditLog(mockAudit))
+
+			mockAudit.On("ListEntries", mock.Anything, "1").Return(tc.entries, nil)
+			if len(tc.entries) == 0 {
+				mockRepo.On("GetEventByID", mock.Anything, "1").Return(entities.Event{ID: "1"}, tc.eventError)
+			}
+
+			result, err := service.GetEventHistory(context.Background(), "1")
+
+			if tc.expectedError != nil {
+				assert.Equal(t, tc.expectedError, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.entries, result)
+			}
+			mockRepo.AssertExpectations(t)
+			mockAudit.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Failure - History disabled", func(t *testing.T) {
+		service := NewEventService(new(mockEventRepository), logrus.New())
+		_, err := service.GetEventHistory(context.Background(), "1")
+		assert.Equal(t, ErrHistoryDisabled, err)
+	})
+}
+
+func TestRestoreEvent(t *testing.T) {
+	deletedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	deleted := entities.Event{ID: "1", Name: "VPN", Status: entities.StatusPending, DeletedAt: &deletedAt}
+
+	testCases := []struct {
+		name          string
+		mockReturn    entities.Event
+		mockError     error
+		expectedError error
+	}{
+		{name: "Success - Restore a deleted event", mockReturn: deleted},
+		{name: "Failure - Event not found", mockError: repository.ErrEventNotfound, expectedError: ErrEventNotfound},
+		{name: "Failure - Event is not deleted", mockError: repository.ErrNotDeleted, expectedError: ErrNotDeleted},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(mockEventRepository)
+			mockAudit := new(mockAuditRepository)
+			service := NewEventService(mockRepo, logrus.New(), WithAuditLog(mockAudit))
+
+			mockRepo.On("RestoreEvent", mock.Anything, "1").Return(tc.mockReturn, tc.mockError)
+			if tc.expectedError == nil {
+				mockAudit.On("AppendEntry", mock.Anything, mock.MatchedBy(func(e entities.AuditEntry) bool {
+					return e.Action == entities.AuditRestore && assert.ObjectsAreEqual([]entities.FieldChange{
+						{Field: "deleted_at", Old: deletedAt, New: nil},
+					}, e.Changes)
+				})).Return(entities.AuditEntry{}, nil)
+			}
+
+			result, err := service.RestoreEvent(context.Background(), "1")
+
+			if tc.expectedError != nil {
+				assert.Equal(t, tc.expectedError, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Nil(t, result.DeletedAt)
+			}
+			mockRepo.AssertExpectations(t)
+			mockAudit.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPurger(t *testing.T) {
+	mockRepo := new(mockEventRepository)
+	purger := NewPurger(mockRepo, 24*time.Hour, logrus.New())
+	now := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	mockRepo.On("PurgeDeleted", mock.Anything, now.Add(-24*time.Hour)).Return(int64(3), nil)
+
+	purged, err := purger.Purge(context.Background(), now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), purged)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateEventVersion(t *testing.T) {
+	current := entities.Event{ID: "1", Name: "VPN", Type: "Incidente", Description: "d", Status: entities.StatusPending, Version: 3}
+
+	testCases := []struct {
+		name          string
+		version       int64
+		callsUpdate   bool
+		updateError   error
+		expectedError error
+	}{
+		{name: "Success - Matching version", version: 3, callsUpdate: true},
+		{name: "Success - Without version", version: 0, callsUpdate: true},
+		{name: "Failure - Stale version", version: 2, expectedError: ErrVersionConflict},
+		{name: "Failure - Concurrent write", version: 3, callsUpdate: true, updateError: repository.ErrVersionConflict, expectedError: ErrVersionConflict},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(mockEventRepository)
+			service := NewEventService(mockRepo, logrus.New())
+
+			mockRepo.On("GetEventByID", mock.Anything, "1").Return(current, nil)
+			if tc.callsUpdate {
+				mockRepo.On("UpdateEvent", mock.Anything, mock.MatchedBy(func(e entities.Event) bool {
+					return e.Version == current.Version
+				})).Return(entities.Event{ID: "1", Version: current.Version + 1}, tc.updateError)
+			}
+
+			update := current
+			update.Name = "VPN caída"
+			update.Version = tc.version
+			result, err := service.UpdateEvent(context.Background(), update)
+
+			if tc.expectedError != nil {
+				assert.Equal(t, tc.expectedError, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(4), result.Version)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestClassifyEventConflict(t *testing.T) {
+	mockRepo := new(mockEventRepository)
+	service := NewEventService(mockRepo, logrus.New())
+
+	mockRepo.On("GetEventByID", mock.Anything, "1").Return(entities.Event{ID: "1", Type: "Incidente", Status: entities.StatusReviewed, Version: 2}, nil)
+	mockRepo.On("UpdateEvent", mock.Anything, mock.AnythingOfType("entities.Event")).Return(entities.Event{}, repository.ErrVersionConflict)
+
+	_, err := service.ClassifyEvent(context.Background(), "1")
+
+	assert.Equal(t, ErrVersionConflict, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestPatchEvent(t *testing.T) {
+	str := func(s string) *string { return &s }
+	status := func(s entities.Status) *entities.Status { return &s }
+	category := func(c entities.Category) *entities.Category { return &c }
+	pending := entities.Event{ID: "1", Name: "VPN", Type: "Reunión", Description: "d", Status: entities.StatusPending, Version: 2,
+		Date: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	reviewed := pending
+	reviewed.Status = entities.StatusReviewed
+	reviewed.Category = "Sin gestión"
+	inReview := pending
+	inReview.Status = entities.StatusInReview
+
+	testCases := []struct {
+		name          string
+		current       entities.Event
+		patch         entities.EventPatch
+		skipsRepo     bool
+		skipsUpdate   bool
+		check         func(t *testing.T, e entities.Event)
+		expectedError error
+	}{
+		{
+			name:    "Success - Only the supplied fields change",
+			current: pending,
+			patch:   entities.EventPatch{Description: str("nueva")},
+			check: func(t *testing.T, e entities.Event) {
+				assert.Equal(t, "nueva", e.Description)
+				assert.Equal(t, "VPN", e.Name)
+				assert.True(t, pending.Date.Equal(e.Date))
+				assert.Empty(t, e.Category)
+			},
+		},
+		{
+			name:    "Success - Type change reclassifies a reviewed event",
+			current: reviewed,
+			patch:   entities.EventPatch{Type: str("Incidente")},
+			check: func(t *testing.T, e entities.Event) {
+				assert.Equal(t, entities.CategoryNeedsAction, e.Category)
+				assert.True(t, e.NeedsAction)
+			},
+		},
+		{
+			name:    "Success - Status change is recorded and classified",
+			current: inReview,
+			patch:   entities.EventPatch{Status: status(entities.StatusReviewed), Type: str("Incidente")},
+			check: func(t *testing.T, e entities.Event) {
+				assert.Equal(t, entities.StatusReviewed, e.Status)
+				assert.Equal(t, entities.CategoryNeedsAction, e.Category)
+				if assert.Len(t, e.StatusHistory, 1) {
+					assert.Equal(t, "ana", e.StatusHistory[0].Actor)
+				}
+			},
+		},
+		{
+			name:    "Success - Explicit category wins over classification",
+			current: reviewed,
+			patch:   entities.EventPatch{Type: str("Incidente"), Category: category(entities.CategoryNoAction)},
+			check: func(t *testing.T, e entities.Event) {
+				assert.Equal(t, entities.CategoryNoAction, e.Category)
+				assert.False(t, e.NeedsAction)
+			},
+		},
+		{
+			name:        "Success - Empty patch does not write",
+			current:     pending,
+			patch:       entities.EventPatch{Name: str("VPN")},
+			skipsUpdate: true,
+			check: func(t *testing.T, e entities.Event) {
+				assert.Equal(t, int64(2), e.Version)
+			},
+		},
+		{
+			name:          "Failure - Required field removed",
+			patch:         entities.EventPatch{Name: str("")},
+			skipsRepo:     true,
+			expectedError: ErrValidation,
+		},
+		{
+			name:          "Failure - Invalid transition",
+			current:       pending,
+			patch:         entities.EventPatch{Status: status(entities.StatusClosed)},
+			skipsUpdate:   true,
+			expectedError: ErrTransition,
+		},
+		{
+			name:          "Failure - Category on a pending event",
+			current:       pending,
+			patch:         entities.EventPatch{Category: category(entities.CategoryNoAction)},
+			skipsUpdate:   true,
+			expectedError: ErrEventRevi,
+		},
+		{
+			name:          "Failure - Stale version",
+			current:       pending,
+			patch:         entities.EventPatch{Name: str("x"), Version: 1},
+			skipsUpdate:   true,
+			expectedError: ErrVersionConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(mockEventRepository)
+			service := NewEventService(mockRepo, logrus.New())
+
+			if !tc.skipsRepo {
+				mockRepo.On("GetEventByID", mock.Anything, "1").Return(tc.current, nil)
+			}
+			if !tc.skipsRepo && !tc.skipsUpdate {
+				mockRepo.On("UpdateEvent", mock.Anything, mock.MatchedBy(func(e entities.Event) bool {
+					return e.Version == tc.current.Version
+				})).Return(func(ctx context.Context, e entities.Event) entities.Event {
+					e.Version++
+					return e
+				}, nil)
+			}
+
+			result, err := service.PatchEvent(WithActor(context.Background(), "ana"), "1", tc.patch)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				tc.check(t, result)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateEvents(t *testing.T) {
+	valid := entities.Event{Name: "VPN", Type: "Incidente", Description: "d", Status: entities.StatusPending}
+	invalidStatus := valid
+	invalidStatus.Status = entities.StatusClosed
+	saved := []entities.Event{{ID: "a", Version: 1}, {ID: "b", Version: 1}}
+	errDuplicate := errors.New("duplicado")
+
+	testCases := []struct {
+		name             string
+		events           []entities.Event
+		callsCreate      bool
+		createError      error
+		expectedErrors   []error
+		expectedIDs      []string
+		expectedBatchErr error
+	}{
+		{
+			name:           "Success - Invalid items are reported apart",
+			events:         []entities.Event{valid, {Name: "sin tipo"}, invalidStatus, valid},
+			callsCreate:    true,
+			expectedErrors: []error{nil, ErrValidation, ErrInitialStatus, nil},
+			expectedIDs:    []string{"a", "", "", "b"},
+		},
+		{
+			name:           "Success - Repository rejects one item",
+			events:         []entities.Event{valid, valid},
+			callsCreate:    true,
+			createError:    repository.BulkError{1: errDuplicate},
+			expectedErrors: []error{nil, errDuplicate},
+			expectedIDs:    []string{"a", ""},
+		},
+		{
+			name:           "Success - No valid items",
+			events:         []entities.Event{{Name: "sin tipo"}},
+			expectedErrors: []error{ErrValidation},
+			expectedIDs:    []string{""},
+		},
+		{name: "Failure - Empty batch", expectedBatchErr: ErrEmptyBatch},
+		{name: "Failure - Too many items", events: make([]entities.Event, MaxBatchSize+1), expectedBatchErr: ErrBatchSize},
+		{name: "Failure - Whole batch fails", events: []entities.Event{valid}, callsCreate: true, createError: errors.New("sin conexión"), expectedBatchErr: errors.New("sin conexión")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(mockEventRepository)
+			mockAudit := new(mockAuditRepository)
+			service := NewEventService(mockRepo, logrus.New(), WithAuditLog(mockAudit))
+
+			if tc.callsCreate {
+				mockRepo.On("CreateEvents", mock.Anything, mock.MatchedBy(func(events []entities.Event) bool {
+					for _, e := range events {
+						if e.Date.IsZero() {
+							return false
+						}
+					}
+					return true
+				})).Return(saved, tc.createError)
+				mockAudit.On("AppendEntry", mock.Anything, mock.AnythingOfType("entities.AuditEntry")).Return(entities.AuditEntry{}, nil)
+			}
+
+			results, err := service.CreateEvents(context.Background(), tc.events)
+
+			if tc.expectedBatchErr != nil {
+				assert.Equal(t, tc.expectedBatchErr, err)
+				assert.Nil(t, results)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, results, len(tc.events))
+			audited := 0
+			for i, result := range results {
+				assert.Equal(t, i, result.Index)
+				if tc.expectedErrors[i] == nil {
+					assert.NoError(t, result.Err)
+				} else {
+					assert.ErrorIs(t, result.Err, tc.expectedErrors[i])
+				}
+				assert.Equal(t, tc.expectedIDs[i], result.ID)
+				if result.Err == nil {
+					audited++
+				}
+			}
+			mockRepo.AssertExpectations(t)
+			mockAudit.AssertNumberOfCalls(t, "AppendEntry", audited)
+		})
+	}
+}
+
+func TestUpdateEvents(t *testing.T) {
+	first := entities.Event{ID: "1", Name: "VPN", Type: "Incidente", Description: "d", Status: entities.StatusPending, Version: 1}
+	second := entities.Event{ID: "2", Name: "Reunión", Type: "Reunión", Description: "d", Status: entities.StatusPending, Version: 4}
+
+	mockRepo := new(mockEventRepository)
+	service := NewEventService(mockRepo, logrus.New())
+
+	mockRepo.On("GetEventByID", mock.Anything, "1").Return(first, nil)
+	mockRepo.On("GetEventByID", mock.Anything, "2").Return(second, nil)
+	mockRepo.On("GetEventByID", mock.Anything, "3").Return(entities.Event{}, repository.ErrEventNotfound)
+	mockRepo.On("UpdateEvents", mock.Anything, mock.MatchedBy(func(events []entities.Event) bool {
+		return len(events) == 2 && events[0].ID == "1" && events[1].ID == "2"
+	})).Return([]entities.Event{{ID: "1", Version: 2}, {ID: "2", Version: 4}}, repository.BulkError{1: repository.ErrVersionConflict})
+
+	renamed := first
+	renamed.Name = "VPN caída"
+	moved := second
+	moved.Status = entities.StatusReviewed
+	results, err := service.UpdateEvents(context.Background(), []entities.Event{
+		renamed,
+		second,
+		{ID: "3", Name: "x", Type: "Incidente", Description: "d", Status: entities.StatusPending},
+		moved,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, int64(2), results[0].Version)
+	assert.Equal(t, ErrVersionConflict, results[1].Err)
+	assert.Equal(t, ErrEventNotfound, results[2].Err)
+	assert.Equal(t, ErrStatusChange, results[3].Err)
+	assert.Equal(t, ErrStatusChange.Error(), results[3].Error)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestClassifyEvents(t *testing.T) {
+	reviewed := entities.Event{ID: "1", Name: "VPN", Type: "Incidente", Description: "d", Status: entities.StatusReviewed, Version: 1}
+	pending := entities.Event{ID: "2", Name: "VPN", Type: "Incidente", Description: "d", Status: entities.StatusPending, Version: 1}
+
+	mockRepo := new(mockEventRepository)
+	service := NewEventService(mockRepo, logrus.New())
+
+	mockRepo.On("GetEventByID", mock.Anything, "1").Return(reviewed, nil)
+	mockRepo.On("GetEventByID", mock.Anything, "2").Return(pending, nil)
+	mockRepo.On("UpdateEvents", mock.Anything, mock.MatchedBy(func(events []entities.Event) bool {
+		return len(events) == 1 && events[0].Category == "Requiere gestión" && events[0].NeedsAction
+	})).Return([]entities.Event{{ID: "1", Version: 2}}, nil)
+
+	results, err := service.ClassifyEvents(context.Background(), []string{"1", "2"})
+
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, entities.BulkResult{Index: 0, ID: "1", Version: 2}, results[0])
+	assert.Equal(t, ErrEventRevi, results[1].Err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestWatchEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mockRepo := new(mockEventRepository)
+	service := NewEventService(mockRepo, logrus.New(), WithPublisher(changes.NewBroker(8, logrus.New())))
+
+	_, err := NewEventService(mockRepo, logrus.New()).WatchEvents(ctx, entities.ChangeFilter{})
+	assert.Equal(t, ErrWatchDisabled, err)
+	_, err = service.WatchEvents(ctx, entities.ChangeFilter{Status: "Archivado"})
+	assert.ErrorIs(t, err, ErrStatus)
+	_, err = service.WatchEvents(ctx, entities.ChangeFilter{Category: "Otra"})
+	assert.ErrorIs(t, err, ErrTypeCategory)
+
+	feed, err := service.WatchEvents(ctx, entities.ChangeFilter{Type: "Incidente"})
+	require.NoError(t, err)
+	otherTenant, err := service.WatchEvents(tenant.WithID(ctx, "acme"), entities.ChangeFilter{})
+	require.NoError(t, err)
+
+	event := entities.Event{ID: "1", Name: "VPN", Type: "Incidente", Description: "d", Status: entities.StatusReviewed, Version: 1}
+	mockRepo.On("CreateEvent", mock.Anything, mock.AnythingOfType("entities.Event")).Return(event, nil)
+	mockRepo.On("GetEventByID", mock.Anything, "1").Return(event, nil)
+	mockRepo.On("UpdateEvent", mock.Anything, mock.AnythingOfType("entities.Event")).Return(func(_ context.Context, e entities.Event) entities.Event {
+		e.Version++
+		return e
+	}, nil)
+	mockRepo.On("DeleteEvent", mock.Anything, "1", mock.AnythingOfType("time.Time")).Return(nil)
+
+	_, err = service.CreateEvent(ctx, entities.Event{Name: "VPN", Type: "Incidente", Description: "d", Status: entities.StatusReviewed})
+	require.NoError(t, err)
+	_, err = service.ClassifyEvent(ctx, "1")
+	require.NoError(t, err)
+	require.NoError(t, service.DeleteEvent(ctx, "1"))
+
+	created := <-feed
+	assert.Equal(t, entities.ChangeCreated, created.Type)
+	assert.Nil(t, created.Previous)
+	classified := <-feed
+	assert.Equal(t, entities.ChangeClassified, classified.Type)
+	assert.Equal(t, entities.CategoryNeedsAction, classified.Event.Category)
+	assert.Equal(t, int64(2), classified.Event.Version)
+	deleted := <-feed
+	assert.Equal(t, entities.ChangeDeleted, deleted.Type)
+	assert.NotNil(t, deleted.Event.DeletedAt)
+	assert.Equal(t, "VPN", deleted.Event.Name)
+	assert.Equal(t, int64(2), deleted.Event.Version)
+
+	cancel()
+	for range feed {
+	}
+	// Los cambios ya se publicaron al leer feed: si acme recibió alguno,
+	// sigue en el búfer.
+	var leaked int
+	for range otherTenant {
+		leaked++
+	}
+	assert.Zero(t, leaked, "los cambios del tenant por defecto no llegan a acme")
+}
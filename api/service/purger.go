@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"prueba_tecnica/api/logging"
+	"prueba_tecnica/api/repository"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Purger borra definitivamente los eventos que llevan más de retention
+// eliminados.
+type Purger struct {
+	repo      repository.EventRepository
+	retention time.Duration
+	logger    logrus.FieldLogger
+}
+
+func NewPurger(repo repository.EventRepository, retention time.Duration, logger logrus.FieldLogger) *Purger {
+	return &Purger{
+		repo:      repo,
+		retention: retention,
+		logger:    logger,
+	}
+}
+
+// Purge borra los eventos eliminados antes de now - retention.
+func (p *Purger) Purge(ctx context.Context, now time.Time) (int64, error) {
+	purged, err := p.repo.PurgeDeleted(ctx, now.Add(-p.retention))
+	if err != nil {
+		logging.Layer(p.logger, "purger", "Purge").WithError(err).Error("operación fallida")
+		return 0, err
+	}
+	if purged > 0 {
+		logging.Layer(p.logger, "purger", "Purge").WithField("count", purged).Info("eventos purgados")
+	}
+	return purged, nil
+}
+
+// Run purga cada interval hasta que ctx se cancele.
+func (p *Purger) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			_, _ = p.Purge(ctx, now)
+		}
+	}
+}
@@ -0,0 +1,266 @@
+package service
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/taxonomy"
+	"prueba_tecnica/api/tracing"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NewTracedEventService envuelve next y abre una span por cada método, como
+// hija de la span del transporte. Se aplica sobre los permisos para que las
+// llamadas rechazadas también queden en la traza.
+func NewTracedEventService(next EventService, tracer trace.Tracer) EventService {
+	return &tracedEventService{next: next, tracer: tracer}
+}
+
+type tracedEventService struct {
+	next   EventService
+	tracer trace.Tracer
+}
+
+func (s *tracedEventService) CreateEvent(ctx context.Context, event entities.Event) (entities.Event, error) {
+	ctx, span := s.tracer.Start(ctx, "EventService.CreateEvent")
+	result, err := s.next.CreateEvent(ctx, event)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (s *tracedEventService) GetEventByID(ctx context.Context, id string) (entities.Event, error) {
+	ctx, span := s.tracer.Start(ctx, "EventService.GetEventByID")
+	result, err := s.next.GetEventByID(ctx, id)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (s *tracedEventService) ListEvents(ctx context.Context, query entities.EventQuery) (entities.EventPage, error) {
+	ctx, span := s.tracer.Start(ctx, "EventService.ListEvents")
+	result, err := s.next.ListEvents(ctx, query)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (s *tracedEventService) SearchEvents(ctx context.Context, query entities.SearchQuery) (entities.SearchPage, error) {
+	ctx, span := s.tracer.Start(ctx, "EventService.SearchEvents")
+	result, err := s.next.SearchEvents(ctx, query)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (s *tracedEventService) GetAllEvents(ctx context.Context, page entities.PageRequest) (entities.EventPage, error) {
+	ctx, span := s.tracer.Start(ctx, "EventService.GetAllEvents")
+	result, err := s.next.GetAllEvents(ctx, page)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (s *tracedEventService) GetEventsByStatus(ctx context.Context, status entities.Status, page entities.PageRequest) (entities.EventPage, error) {
+	ctx, span := s.tracer.Start(ctx, "EventService.GetEventsByStatus")
+	result, err := s.next.GetEventsByStatus(ctx, status, page)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (s *tracedEventService) GetEventsByCategory(ctx context.Context, category entities.Category, page entities.PageRequest) (entities.EventPage, error) {
+	ctx, span := s.tracer.Start(ctx, "EventService.GetEventsByCategory")
+	result, err := s.next.GetEventsByCategory(ctx, category, page)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (s *tracedEventService) GetEventsNeedingAction(ctx context.Context, page entities.PageRequest) (entities.EventPage, error) {
+	ctx, span := s.tracer.Start(ctx, "EventService.GetEventsNeedingAction")
+	result, err := s.next.GetEventsNeedingAction(ctx, page)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (s *tracedEventService) UpdateEvent(ctx context.Context, event entities.Event) (entities.Event, error) {
+	ctx, span := s.tracer.Start(ctx, "EventService.UpdateEvent")
+	result, err := s.next.UpdateEvent(ctx, event)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (s *tracedEventService) DeleteEvent(ctx context.Context, id string) error {
+	ctx, span := s.tracer.Start(ctx, "EventService.DeleteEvent")
+	err := s.next.DeleteEvent(ctx, id)
+	tracing.End(span, err)
+	return err
+}
+
+func (s *tracedEventService) ClassifyEvent(ctx context.Context, id string) (entities.Event, error) {
+	ctx, span := s.tracer.Start(ctx, "EventService.ClassifyEvent")
+	result, err := s.next.ClassifyEvent(ctx, id)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (s *tracedEventService) ManualClassifyEvent(ctx context.Context, id string, category entities.Category) (entities.Event, error) {
+	ctx, span := s.tracer.Start(ctx, "EventService.ManualClassifyEvent")
+	result, err := s.next.ManualClassifyEvent(ctx, id, category)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (s *tracedEventService) DryRunClassification(ctx context.Context, event entities.Event) (entities.Classification, error) {
+	ctx, span := s.tracer.Start(ctx, "EventService.DryRunClassification")
+	result, err := s.next.DryRunClassification(ctx, event)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (s *tracedEventService) TransitionEvent(ctx context.Context, id string, transition entities.StatusTransition) (entities.Event, error) {
+	ctx, span := s.tracer.Start(ctx, "EventService.TransitionEvent")
+	result, err := s.next.TransitionEvent(ctx, id, transition)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (s *tracedEventService) GetEventHistory(ctx context.Context, id string) ([]entities.AuditEntry, error) {
+	ctx, span := s.tracer.Start(ctx, "EventService.GetEventHistory")
+	result, err := s.next.GetEventHistory(ctx, id)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (s *tracedEventService) RestoreEvent(ctx context.Context, id string) (entities.Event, error) {
+	ctx, span := s.tracer.Start(ctx, "EventService.RestoreEvent")
+	result, err := s.next.RestoreEvent(ctx, id)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (s *tracedEventService) PatchEvent(ctx context.Context, id string, patch entities.EventPatch) (entities.Event, error) {
+	ctx, span := s.tracer.Start(ctx, "EventService.PatchEvent")
+	result, err := s.next.PatchEvent(ctx, id, patch)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (s *tracedEventService) CreateEvents(ctx context.Context, events []entities.Event) ([]entities.BulkResult, error) {
+	ctx, span := s.tracer.Start(ctx, "EventService.CreateEvents")
+	result, err := s.next.CreateEvents(ctx, events)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (s *tracedEventService) UpdateEvents(ctx context.Context, events []entities.Event) ([]entities.BulkResult, error) {
+	ctx, span := s.tracer.Start(ctx, "EventService.UpdateEvents")
+	result, err := s.next.UpdateEvents(ctx, events)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (s *tracedEventService) ClassifyEvents(ctx context.Context, ids []string) ([]entities.BulkResult, error) {
+	ctx, span := s.tracer.Start(ctx, "EventService.ClassifyEvents")
+	result, err := s.next.ClassifyEvents(ctx, ids)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (s *tracedEventService) WatchEvents(ctx context.Context, filter entities.ChangeFilter) (<-chan entities.EventChange, error) {
+	ctx, span := s.tracer.Start(ctx, "EventService.WatchEvents")
+	result, err := s.next.WatchEvents(ctx, filter)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (s *tracedEventService) GetTaxonomy(ctx context.Context) (taxonomy.Taxonomy, error) {
+	ctx, span := s.tracer.Start(ctx, "EventService.GetTaxonomy")
+	result, err := s.next.GetTaxonomy(ctx)
+	tracing.End(span, err)
+	return result, err
+}
+
+// NewTracedWebhookService es NewTracedEventService para WebhookService.
+func NewTracedWebhookService(next WebhookService, tracer trace.Tracer) WebhookService {
+	return &tracedWebhookService{next: next, tracer: tracer}
+}
+
+type tracedWebhookService struct {
+	next   WebhookService
+	tracer trace.Tracer
+}
+
+func (s *tracedWebhookService) CreateWebhook(ctx context.Context, webhook entities.Webhook) (entities.Webhook, error) {
+	ctx, span := s.tracer.Start(ctx, "WebhookService.CreateWebhook")
+	result, err := s.next.CreateWebhook(ctx, webhook)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (s *tracedWebhookService) GetWebhook(ctx context.Context, id string) (entities.Webhook, error) {
+	ctx, span := s.tracer.Start(ctx, "WebhookService.GetWebhook")
+	result, err := s.next.GetWebhook(ctx, id)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (s *tracedWebhookService) ListWebhooks(ctx context.Context) ([]entities.Webhook, error) {
+	ctx, span := s.tracer.Start(ctx, "WebhookService.ListWebhooks")
+	result, err := s.next.ListWebhooks(ctx)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (s *tracedWebhookService) UpdateWebhook(ctx context.Context, webhook entities.Webhook) (entities.Webhook, error) {
+	ctx, span := s.tracer.Start(ctx, "WebhookService.UpdateWebhook")
+	result, err := s.next.UpdateWebhook(ctx, webhook)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (s *tracedWebhookService) DeleteWebhook(ctx context.Context, id string) error {
+	ctx, span := s.tracer.Start(ctx, "WebhookService.DeleteWebhook")
+	err := s.next.DeleteWebhook(ctx, id)
+	tracing.End(span, err)
+	return err
+}
+
+func (s *tracedWebhookService) ListDeliveries(ctx context.Context, id string, limit int) ([]entities.WebhookDelivery, error) {
+	ctx, span := s.tracer.Start(ctx, "WebhookService.ListDeliveries")
+	result, err := s.next.ListDeliveries(ctx, id, limit)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (s *tracedWebhookService) ListDeadLetters(ctx context.Context, id string, limit int) ([]entities.WebhookDelivery, error) {
+	ctx, span := s.tracer.Start(ctx, "WebhookService.ListDeadLetters")
+	result, err := s.next.ListDeadLetters(ctx, id, limit)
+	tracing.End(span, err)
+	return result, err
+}
+
+// NewTracedAPIKeyService es NewTracedEventService para APIKeyService.
+func NewTracedAPIKeyService(next APIKeyService, tracer trace.Tracer) APIKeyService {
+	return &tracedAPIKeyService{next: next, tracer: tracer}
+}
+
+type tracedAPIKeyService struct {
+	next   APIKeyService
+	tracer trace.Tracer
+}
+
+func (s *tracedAPIKeyService) CreateAPIKey(ctx context.Context, key entities.APIKey) (entities.APIKey, error) {
+	ctx, span := s.tracer.Start(ctx, "APIKeyService.CreateAPIKey")
+	result, err := s.next.CreateAPIKey(ctx, key)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (s *tracedAPIKeyService) ListAPIKeys(ctx context.Context) ([]entities.APIKey, error) {
+	ctx, span := s.tracer.Start(ctx, "APIKeyService.ListAPIKeys")
+	result, err := s.next.ListAPIKeys(ctx)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (s *tracedAPIKeyService) RevokeAPIKey(ctx context.Context, id string) (entities.APIKey, error) {
+	ctx, span := s.tracer.Start(ctx, "APIKeyService.RevokeAPIKey")
+	result, err := s.next.RevokeAPIKey(ctx, id)
+	tracing.End(span, err)
+	return result, err
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+	"prueba_tecnica/api/repository"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracedEventService(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	logger := logrus.New()
+	service := NewTracedEventService(NewAuthorizedEventService(NewEventService(repository.NewMemoryEventRepository(logger), logger), logger), provider.Tracer("test"))
+
+	// Sin principal la autorización rechaza la llamada y la span lo registra.
+	err := service.DeleteEvent(context.Background(), "1")
+	assert.ErrorIs(t, err, ErrForbidden)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "EventService.DeleteEvent", spans[0].Name())
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+}
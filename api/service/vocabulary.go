@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"prueba_tecnica/api/apperr"
+	"prueba_tecnica/api/taxonomy"
+	"strings"
+)
+
+// GetTaxonomy devuelve el vocabulario con el que el servicio valida los
+// eventos.
+func (s *eventService) GetTaxonomy(ctx context.Context) (taxonomy.Taxonomy, error) {
+	return *s.taxonomy, nil
+}
+
+// Los errores de vocabulario indican en el campo field los valores que acepta
+// la taxonomía vigente.
+
+func (s *eventService) errStatus(field string) error {
+	return ErrStatus.WithFields(oneOf(field, s.taxonomy.StatusNames()))
+}
+
+func (s *eventService) errInitialStatus() error {
+	return ErrInitialStatus.WithFields(oneOf("status", s.taxonomy.InitialStatusNames()))
+}
+
+func (s *eventService) errCategory(kind *apperr.Error, field string) error {
+	return kind.WithFields(oneOf(field, s.taxonomy.CategoryNames()))
+}
+
+func (s *eventService) errType() error {
+	return ErrType.WithFields(oneOf("type", s.taxonomy.Types))
+}
+
+func oneOf(field string, values []string) apperr.FieldViolation {
+	return apperr.Violation(field, "validation.oneof", strings.Join(values, ", "))
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type mockWebhookRepository struct {
+	mock.Mock
+}
+
+func (m *mockWebhookRepository) CreateWebhook(ctx context.Context, webhook entities.Webhook) (entities.Webhook, error) {
+	args := m.Called(ctx, webhook)
+	if fn, ok := args.Get(0).(func(context.Context, entities.Webhook) entities.Webhook); ok {
+		return fn(ctx, webhook), args.Error(1)
+	}
+	return args.Get(0).(entities.Webhook), args.Error(1)
+}
+
+func (m *mockWebhookRepository) GetWebhook(ctx context.Context, id string) (entities.Webhook, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(entities.Webhook), args.Error(1)
+}
+
+func (m *mockWebhookRepository) ListWebhooks(ctx context.Context) ([]entities.Webhook, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]entities.Webhook), args.Error(1)
+}
+
+func (m *mockWebhookRepository) UpdateWebhook(ctx context.Context, webhook entities.Webhook) (entities.Webhook, error) {
+	args := m.Called(ctx, webhook)
+	return args.Get(0).(entities.Webhook), args.Error(1)
+}
+
+func (m *mockWebhookRepository) DeleteWebhook(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *mockWebhookRepository) SaveDelivery(ctx context.Context, delivery entities.WebhookDelivery) error {
+	args := m.Called(ctx, delivery)
+	return args.Error(0)
+}
+
+func (m *mockWebhookRepository) ListDeliveries(ctx context.Context, webhookID string, limit int) ([]entities.WebhookDelivery, error) {
+	args := m.Called(ctx, webhookID, limit)
+	return args.Get(0).([]entities.WebhookDelivery), args.Error(1)
+}
+
+func (m *mockWebhookRepository) AddDeadLetter(ctx context.Context, delivery entities.WebhookDelivery) error {
+	args := m.Called(ctx, delivery)
+	return args.Error(0)
+}
+
+func (m *mockWebhookRepository) ListDeadLetters(ctx context.Context, webhookID string, limit int) ([]entities.WebhookDelivery, error) {
+	args := m.Called(ctx, webhookID, limit)
+	return args.Get(0).([]entities.WebhookDelivery), args.Error(1)
+}
@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"prueba_tecnica/api/apperr"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/logging"
+	"prueba_tecnica/api/repository"
+	"prueba_tecnica/api/tenant"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/sirupsen/logrus"
+)
+
+// WebhookService administra las suscripciones de webhooks. El envío lo hace
+// webhooks.Dispatcher. Cada webhook pertenece al tenant que lo creó y los de
+// otros tenants no se ven.
+type WebhookService interface {
+	// CreateWebhook crea un webhook activo. Si no trae Secret se genera uno;
+	// es la única vez que se devuelve.
+	CreateWebhook(ctx context.Context, webhook entities.Webhook) (entities.Webhook, error)
+	GetWebhook(ctx context.Context, id string) (entities.Webhook, error)
+	ListWebhooks(ctx context.Context) ([]entities.Webhook, error)
+	// UpdateWebhook reemplaza url, triggers y active. El secreto solo cambia
+	// si se envía uno nuevo.
+	UpdateWebhook(ctx context.Context, webhook entities.Webhook) (entities.Webhook, error)
+	DeleteWebhook(ctx context.Context, id string) error
+	ListDeliveries(ctx context.Context, id string, limit int) ([]entities.WebhookDelivery, error)
+	ListDeadLetters(ctx context.Context, id string, limit int) ([]entities.WebhookDelivery, error)
+}
+
+// DefaultDeliveryLimit es la cantidad de envíos que se listan si no se indica
+// otra.
+const DefaultDeliveryLimit = 50
+
+type webhookService struct {
+	repo     repository.WebhookRepository
+	logger   logrus.FieldLogger
+	validate *validator.Validate
+}
+
+func NewWebhookService(repo repository.WebhookRepository, logger logrus.FieldLogger) WebhookService {
+	return &webhookService{
+		repo:     repo,
+		logger:   logger,
+		validate: newValidator(),
+	}
+}
+
+func (s *webhookService) CreateWebhook(ctx context.Context, webhook entities.Webhook) (entities.Webhook, error) {
+	if err := s.validate.Struct(webhook); err != nil {
+		logging.For(ctx, s.logger, "webhook_service", "CreateWebhook").WithError(err).Error("operación fallida")
+		return entities.Webhook{}, apperr.Invalid(ErrWebhook, err)
+	}
+	if webhook.Secret == "" {
+		secret, err := newSecret()
+		if err != nil {
+			logging.For(ctx, s.logger, "webhook_service", "CreateWebhook").WithError(err).Error("operación fallida")
+			return entities.Webhook{}, err
+		}
+		webhook.Secret = secret
+	}
+	webhook.TenantID = tenant.FromContext(ctx)
+	webhook.Active = true
+	webhook.CreatedAt = time.Now()
+
+	created, err := s.repo.CreateWebhook(ctx, webhook)
+	if err != nil {
+		logging.For(ctx, s.logger, "webhook_service", "CreateWebhook").WithError(err).Error("operación fallida")
+		return entities.Webhook{}, err
+	}
+	return created, nil
+}
+
+func (s *webhookService) GetWebhook(ctx context.Context, id string) (entities.Webhook, error) {
+	webhook, err := s.owned(ctx, id)
+	if err != nil {
+		logging.For(ctx, s.logger, "webhook_service", "GetWebhook").WithError(err).Error("operación fallida")
+		return entities.Webhook{}, err
+	}
+	webhook.Secret = ""
+	return webhook, nil
+}
+
+func (s *webhookService) ListWebhooks(ctx context.Context) ([]entities.Webhook, error) {
+	webhooks, err := s.repo.ListWebhooks(ctx)
+	if err != nil {
+		logging.For(ctx, s.logger, "webhook_service", "ListWebhooks").WithError(err).Error("operación fallida")
+		return nil, err
+	}
+	visible := make([]entities.Webhook, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		if tenant.Of(webhook.TenantID) == tenant.FromContext(ctx) {
+			webhook.Secret = ""
+			visible = append(visible, webhook)
+		}
+	}
+	return visible, nil
+}
+
+func (s *webhookService) UpdateWebhook(ctx context.Context, webhook entities.Webhook) (entities.Webhook, error) {
+	if err := s.validate.Struct(webhook); err != nil {
+		logging.For(ctx, s.logger, "webhook_service", "UpdateWebhook").WithError(err).Error("operación fallida")
+		return entities.Webhook{}, apperr.Invalid(ErrWebhook, err)
+	}
+	current, err := s.owned(ctx, webhook.ID)
+	if err != nil {
+		logging.For(ctx, s.logger, "webhook_service", "UpdateWebhook").WithError(err).Error("operación fallida")
+		return entities.Webhook{}, err
+	}
+	if webhook.Secret == "" {
+		webhook.Secret = current.Secret
+	}
+
+	updated, err := s.repo.UpdateWebhook(ctx, webhook)
+	if err != nil {
+		logging.For(ctx, s.logger, "webhook_service", "UpdateWebhook").WithError(err).Error("operación fallida")
+		return entities.Webhook{}, err
+	}
+	updated.Secret = ""
+	return updated, nil
+}
+
+func (s *webhookService) DeleteWebhook(ctx context.Context, id string) error {
+	if _, err := s.owned(ctx, id); err != nil {
+		logging.For(ctx, s.logger, "webhook_service", "DeleteWebhook").WithError(err).Error("operación fallida")
+		return err
+	}
+	if err := s.repo.DeleteWebhook(ctx, id); err != nil {
+		logging.For(ctx, s.logger, "webhook_service", "DeleteWebhook").WithError(err).Error("operación fallida")
+		return err
+	}
+	return nil
+}
+
+// ListDeliveries devuelve los últimos envíos de un webhook, incluso si ya se
+// eliminó.
+func (s *webhookService) ListDeliveries(ctx context.Context, id string, limit int) ([]entities.WebhookDelivery, error) {
+	limit, err := deliveryLimit(limit)
+	if err != nil {
+		logging.For(ctx, s.logger, "webhook_service", "ListDeliveries").WithError(err).Error("operación fallida")
+		return nil, err
+	}
+	deliveries, err := s.repo.ListDeliveries(ctx, id, limit)
+	if err != nil {
+		logging.For(ctx, s.logger, "webhook_service", "ListDeliveries").WithError(err).Error("operación fallida")
+		return nil, err
+	}
+	return ownDeliveries(ctx, deliveries), nil
+}
+
+func (s *webhookService) ListDeadLetters(ctx context.Context, id string, limit int) ([]entities.WebhookDelivery, error) {
+	limit, err := deliveryLimit(limit)
+	if err != nil {
+		logging.For(ctx, s.logger, "webhook_service", "ListDeadLetters").WithError(err).Error("operación fallida")
+		return nil, err
+	}
+	deliveries, err := s.repo.ListDeadLetters(ctx, id, limit)
+	if err != nil {
+		logging.For(ctx, s.logger, "webhook_service", "ListDeadLetters").WithError(err).Error("operación fallida")
+		return nil, err
+	}
+	return ownDeliveries(ctx, deliveries), nil
+}
+
+// owned busca el webhook id y responde que no existe si es de otro tenant.
+func (s *webhookService) owned(ctx context.Context, id string) (entities.Webhook, error) {
+	webhook, err := s.repo.GetWebhook(ctx, id)
+	if err != nil {
+		return entities.Webhook{}, err
+	}
+	if tenant.Of(webhook.TenantID) != tenant.FromContext(ctx) {
+		return entities.Webhook{}, repository.ErrWebhookNotFound
+	}
+	return webhook, nil
+}
+
+// ownDeliveries descarta los envíos de otros tenants. Los envíos se siguen
+// listando después de eliminar el webhook, así que no alcanza con owned.
+func ownDeliveries(ctx context.Context, deliveries []entities.WebhookDelivery) []entities.WebhookDelivery {
+	own := make([]entities.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		if tenant.Of(delivery.TenantID) == tenant.FromContext(ctx) {
+			own = append(own, delivery)
+		}
+	}
+	return own
+}
+
+func deliveryLimit(limit int) (int, error) {
+	switch {
+	case limit == 0:
+		return DefaultDeliveryLimit, nil
+	case limit < 0 || limit > repository.MaxPageLimit:
+		return 0, ErrPageLimit
+	}
+	return limit, nil
+}
+
+// newSecret genera un secreto aleatorio de 32 bytes en hexadecimal.
+func newSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/repository"
+	"prueba_tecnica/api/tenant"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateWebhook(t *testing.T) {
+	testCases := []struct {
+		name          string
+		webhook       entities.Webhook
+		callsCreate   bool
+		expectedError error
+	}{
+		{name: "Success - Generated secret", webhook: entities.Webhook{URL: "https://example.com/hook", Triggers: []string{entities.TriggerNeedsAction}}, callsCreate: true},
+		{name: "Success - Given secret", webhook: entities.Webhook{URL: "http://localhost:9000", Triggers: []string{entities.TriggerDelete}, Secret: "mine"}, callsCreate: true},
+		{name: "Failure - Invalid URL", webhook: entities.Webhook{URL: "ftp://example.com", Triggers: []string{entities.TriggerDelete}}, expectedError: ErrWebhook},
+		{name: "Failure - No triggers", webhook: entities.Webhook{URL: "https://example.com/hook"}, expectedError: ErrWebhook},
+		{name: "Failure - Unknown trigger", webhook: entities.Webhook{URL: "https://example.com/hook", Triggers: []string{"create"}}, expectedError: ErrWebhook},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(mockWebhookRepository)
+			service := NewWebhookService(mockRepo, logrus.New())
+
+			if tc.callsCreate {
+				mockRepo.On("CreateWebhook", mock.Anything, mock.MatchedBy(func(w entities.Webhook) bool {
+					return w.Active && w.Secret != "" && !w.CreatedAt.IsZero()
+				})).Return(func(_ context.Context, w entities.Webhook) entities.Webhook {
+					w.ID = "w1"
+					return w
+				}, nil)
+			}
+
+			created, err := service.CreateWebhook(context.Background(), tc.webhook)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "w1", created.ID)
+				if tc.webhook.Secret != "" {
+					assert.Equal(t, tc.webhook.Secret, created.Secret)
+				} else {
+					assert.Len(t, created.Secret, 64)
+				}
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWebhookSecretIsHidden(t *testing.T) {
+	mockRepo := new(mockWebhookRepository)
+	service := NewWebhookService(mockRepo, logrus.New())
+	stored := entities.Webhook{ID: "w1", URL: "https://example.com/hook", Triggers: []string{entities.TriggerDelete}, Secret: "s3cr3t", Active: true}
+
+	mockRepo.On("GetWebhook", mock.Anything, "w1").Return(stored, nil)
+	mockRepo.On("GetWebhook", mock.Anything, "w2").Return(entities.Webhook{}, repository.ErrWebhookNotFound)
+	mockRepo.On("ListWebhooks", mock.Anything).Return([]entities.Webhook{stored}, nil)
+	mockRepo.On("UpdateWebhook", mock.Anything, mock.MatchedBy(func(w entities.Webhook) bool {
+		// Sin secreto nuevo se conserva el guardado.
+		return w.Secret == "s3cr3t" && !w.Active
+	})).Return(stored, nil)
+
+	found, err := service.GetWebhook(context.Background(), "w1")
+	require.NoError(t, err)
+	assert.Empty(t, found.Secret)
+
+	all, err := service.ListWebhooks(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, all[0].Secret)
+
+	update := stored
+	update.Secret = ""
+	update.Active = false
+	updated, err := service.UpdateWebhook(context.Background(), update)
+	require.NoError(t, err)
+	assert.Empty(t, updated.Secret)
+
+	_, err = service.GetWebhook(context.Background(), "w2")
+	assert.Equal(t, ErrWebhookNotFound, err)
+	update.ID = "w2"
+	_, err = service.UpdateWebhook(context.Background(), update)
+	assert.Equal(t, ErrWebhookNotFound, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestListDeliveries(t *testing.T) {
+	mockRepo := new(mockWebhookRepository)
+	service := NewWebhookService(mockRepo, logrus.New())
+	deliveries := []entities.WebhookDelivery{{ID: "d1", WebhookID: "w1"}}
+
+	mockRepo.On("ListDeliveries", mock.Anything, "w1", DefaultDeliveryLimit).Return(deliveries, nil)
+	mockRepo.On("ListDeadLetters", mock.Anything, "w1", 10).Return(deliveries, nil)
+
+	result, err := service.ListDeliveries(context.Background(), "w1", 0)
+	require.NoError(t, err)
+	assert.Equal(t, deliveries, result)
+
+	result, err = service.ListDeadLetters(context.Background(), "w1", 10)
+	require.NoError(t, err)
+	assert.Equal(t, deliveries, result)
+
+	_, err = service.ListDeliveries(context.Background(), "w1", 501)
+	assert.Equal(t, ErrPageLimit, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestWebhooksAreScopedToTenant(t *testing.T) {
+	repo := repository.NewMemoryWebhookRepository()
+	service := NewWebhookService(repo, logrus.New())
+	acme := tenant.WithID(context.Background(), "acme")
+	globex := tenant.WithID(context.Background(), "globex")
+
+	created, err := service.CreateWebhook(acme, entities.Webhook{URL: "https://acme.example.com/hook", Triggers: []string{entities.TriggerDelete}})
+	require.NoError(t, err)
+	assert.Equal(t, "acme", created.TenantID)
+
+	_, err = service.GetWebhook(globex, created.ID)
+	assert.Equal(t, ErrWebhookNotFound, err)
+	hooks, err := service.ListWebhooks(globex)
+	require.NoError(t, err)
+	assert.Empty(t, hooks)
+	created.URL = "https://evil.example.com"
+	_, err = service.UpdateWebhook(globex, created)
+	assert.Equal(t, ErrWebhookNotFound, err)
+	assert.Equal(t, ErrWebhookNotFound, service.DeleteWebhook(globex, created.ID))
+
+	require.NoError(t, repo.SaveDelivery(acme, entities.WebhookDelivery{ID: "d1", WebhookID: created.ID, TenantID: "acme"}))
+	deliveries, err := service.ListDeliveries(globex, created.ID, 0)
+	require.NoError(t, err)
+	assert.Empty(t, deliveries)
+	deliveries, err = service.ListDeliveries(acme, created.ID, 0)
+	require.NoError(t, err)
+	assert.Len(t, deliveries, 1)
+
+	hooks, err = service.ListWebhooks(acme)
+	require.NoError(t, err)
+	require.Len(t, hooks, 1)
+	assert.Equal(t, "https://acme.example.com/hook", hooks[0].URL)
+}
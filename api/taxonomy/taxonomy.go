@@ -0,0 +1,281 @@
+// Package taxonomy define el vocabulario del dominio: los estados de un
+// evento y sus transiciones, las categorías de clasificación y los tipos de
+// evento. Se carga de un archivo YAML o JSON; sin archivo se usa Default.
+package taxonomy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"prueba_tecnica/api/entities"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Status es un estado del ciclo de vida. Initial indica si un evento se puede
+// crear en ese estado y Next son los estados a los que puede pasar.
+// Classified marca el único estado en el que los eventos se clasifican.
+type Status struct {
+	Name       entities.Status   `json:"name" yaml:"name"`
+	Initial    bool              `json:"initial" yaml:"initial"`
+	Classified bool              `json:"classified,omitempty" yaml:"classified,omitempty"`
+	Next       []entities.Status `json:"next" yaml:"next"`
+}
+
+// Category es una categoría de clasificación. NeedsAction es el valor de
+// needs_action de los eventos con esa categoría; Default es la que se asigna
+// cuando ninguna regla de clasificación coincide.
+type Category struct {
+	Name        entities.Category `json:"name" yaml:"name"`
+	NeedsAction bool              `json:"needs_action" yaml:"needs_action"`
+	Default     bool              `json:"default,omitempty" yaml:"default,omitempty"`
+}
+
+// Taxonomy es el vocabulario completo. Con OpenTypes se aceptan tipos de
+// evento que no están en Types, que entonces solo sirve como sugerencia.
+type Taxonomy struct {
+	Statuses   []Status   `json:"statuses" yaml:"statuses"`
+	Categories []Category `json:"categories" yaml:"categories"`
+	Types      []string   `json:"types" yaml:"types"`
+	OpenTypes  bool       `json:"open_types" yaml:"open_types"`
+}
+
+// Default es la taxonomía original del servicio.
+func Default() *Taxonomy {
+	return &Taxonomy{
+		Statuses: []Status{
+			{Name: entities.StatusPending, Initial: true, Next: []entities.Status{entities.StatusInReview}},
+			{Name: entities.StatusInReview, Next: []entities.Status{entities.StatusReviewed, entities.StatusPending}},
+			{Name: entities.StatusReviewed, Initial: true, Classified: true, Next: []entities.Status{entities.StatusClosed, entities.StatusReopened}},
+			{Name: entities.StatusClosed, Next: []entities.Status{entities.StatusReopened}},
+			{Name: entities.StatusReopened, Next: []entities.Status{entities.StatusInReview}},
+		},
+		Categories: []Category{
+			{Name: entities.CategoryNeedsAction, NeedsAction: true},
+			{Name: entities.CategoryNoAction, Default: true},
+		},
+		Types: []string{
+			"Incidente", "Problema", "Emergencia", "Error", "Critico",
+			"Reunión", "Informe", "Actualización", "Notificación", "Consulta",
+			"Conferencia", "Taller",
+		},
+		OpenTypes: true,
+	}
+}
+
+// Load lee una taxonomía de un archivo YAML o JSON (según la extensión) y la
+// valida.
+func Load(path string) (*Taxonomy, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var t Taxonomy
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		err = json.Unmarshal(b, &t)
+	default:
+		err = yaml.Unmarshal(b, &t)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if err := t.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &t, nil
+}
+
+// Validate revisa que la taxonomía sea coherente: nombres únicos, al menos un
+// estado inicial, un solo estado clasificado, transiciones a estados que
+// existen y una sola categoría por defecto.
+func (t *Taxonomy) Validate() error {
+	if len(t.Statuses) == 0 {
+		return errors.New("la taxonomía no tiene estados")
+	}
+	statuses := make(map[entities.Status]bool, len(t.Statuses))
+	initial, classified := false, 0
+	for _, s := range t.Statuses {
+		if s.Name == "" {
+			return errors.New("estado sin nombre")
+		}
+		if statuses[s.Name] {
+			return fmt.Errorf("estado %q repetido", s.Name)
+		}
+		statuses[s.Name] = true
+		initial = initial || s.Initial
+		if s.Classified {
+			classified++
+		}
+	}
+	if !initial {
+		return errors.New("la taxonomía no tiene estados iniciales")
+	}
+	if classified != 1 {
+		return fmt.Errorf("debe haber exactamente un estado clasificado, hay %d", classified)
+	}
+	for _, s := range t.Statuses {
+		for _, next := range s.Next {
+			if !statuses[next] {
+				return fmt.Errorf("estado %q: transición a un estado que no existe %q", s.Name, next)
+			}
+		}
+	}
+
+	if len(t.Categories) == 0 {
+		return errors.New("la taxonomía no tiene categorías")
+	}
+	categories := make(map[entities.Category]bool, len(t.Categories))
+	defaults := 0
+	for _, c := range t.Categories {
+		if c.Name == "" {
+			return errors.New("categoría sin nombre")
+		}
+		if categories[c.Name] {
+			return fmt.Errorf("categoría %q repetida", c.Name)
+		}
+		categories[c.Name] = true
+		if c.Default {
+			defaults++
+		}
+	}
+	if defaults != 1 {
+		return fmt.Errorf("debe haber exactamente una categoría por defecto, hay %d", defaults)
+	}
+
+	types := make(map[string]bool, len(t.Types))
+	for _, name := range t.Types {
+		if name == "" {
+			return errors.New("tipo de evento sin nombre")
+		}
+		if types[name] {
+			return fmt.Errorf("tipo de evento %q repetido", name)
+		}
+		types[name] = true
+	}
+	if !t.OpenTypes && len(t.Types) == 0 {
+		return errors.New("la taxonomía no tiene tipos de evento y open_types es false")
+	}
+	return nil
+}
+
+func (t *Taxonomy) status(name entities.Status) (Status, bool) {
+	for _, s := range t.Statuses {
+		if s.Name == name {
+			return s, true
+		}
+	}
+	return Status{}, false
+}
+
+func (t *Taxonomy) category(name entities.Category) (Category, bool) {
+	for _, c := range t.Categories {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	return Category{}, false
+}
+
+// ValidStatus indica si status es un estado de la taxonomía.
+func (t *Taxonomy) ValidStatus(status entities.Status) bool {
+	_, ok := t.status(status)
+	return ok
+}
+
+// IsInitial indica si un evento se puede crear en status.
+func (t *Taxonomy) IsInitial(status entities.Status) bool {
+	s, ok := t.status(status)
+	return ok && s.Initial
+}
+
+// CanTransition indica si un evento puede pasar de from a to.
+func (t *Taxonomy) CanTransition(from, to entities.Status) bool {
+	s, _ := t.status(from)
+	for _, next := range s.Next {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// ClassifiedStatus es el estado en el que los eventos se clasifican.
+func (t *Taxonomy) ClassifiedStatus() entities.Status {
+	for _, s := range t.Statuses {
+		if s.Classified {
+			return s.Name
+		}
+	}
+	return ""
+}
+
+// ValidCategory indica si category es una categoría de la taxonomía.
+func (t *Taxonomy) ValidCategory(category entities.Category) bool {
+	_, ok := t.category(category)
+	return ok
+}
+
+// NeedsAction indica si los eventos con category requieren gestión.
+func (t *Taxonomy) NeedsAction(category entities.Category) bool {
+	c, _ := t.category(category)
+	return c.NeedsAction
+}
+
+// DefaultCategory es la categoría de los eventos que no coinciden con
+// ninguna regla de clasificación.
+func (t *Taxonomy) DefaultCategory() entities.Category {
+	for _, c := range t.Categories {
+		if c.Default {
+			return c.Name
+		}
+	}
+	return ""
+}
+
+// ValidType indica si se aceptan eventos de tipo name.
+func (t *Taxonomy) ValidType(name string) bool {
+	if t.OpenTypes {
+		return name != ""
+	}
+	for _, known := range t.Types {
+		if known == name {
+			return true
+		}
+	}
+	return false
+}
+
+// StatusNames devuelve los nombres de los estados, en el orden de la
+// taxonomía.
+func (t *Taxonomy) StatusNames() []string {
+	names := make([]string, len(t.Statuses))
+	for i, s := range t.Statuses {
+		names[i] = string(s.Name)
+	}
+	return names
+}
+
+// InitialStatusNames devuelve los nombres de los estados iniciales.
+func (t *Taxonomy) InitialStatusNames() []string {
+	var names []string
+	for _, s := range t.Statuses {
+		if s.Initial {
+			names = append(names, string(s.Name))
+		}
+	}
+	return names
+}
+
+// CategoryNames devuelve los nombres de las categorías.
+func (t *Taxonomy) CategoryNames() []string {
+	names := make([]string, len(t.Categories))
+	for i, c := range t.Categories {
+		names[i] = string(c.Name)
+	}
+	return names
+}
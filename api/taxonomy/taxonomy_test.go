@@ -0,0 +1,141 @@
+package taxonomy
+
+import (
+	"os"
+	"path/filepath"
+	"prueba_tecnica/api/entities"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefault(t *testing.T) {
+	tx := Default()
+	require.NoError(t, tx.Validate())
+
+	assert.True(t, tx.IsInitial(entities.StatusPending))
+	assert.True(t, tx.IsInitial(entities.StatusReviewed))
+	assert.False(t, tx.IsInitial(entities.StatusClosed))
+	assert.Equal(t, entities.StatusReviewed, tx.ClassifiedStatus())
+	assert.Equal(t, entities.CategoryNoAction, tx.DefaultCategory())
+	assert.True(t, tx.NeedsAction(entities.CategoryNeedsAction))
+	assert.False(t, tx.NeedsAction(entities.CategoryNoAction))
+
+	assert.True(t, tx.CanTransition(entities.StatusPending, entities.StatusInReview))
+	assert.True(t, tx.CanTransition(entities.StatusClosed, entities.StatusReopened))
+	assert.False(t, tx.CanTransition(entities.StatusPending, entities.StatusClosed))
+	assert.False(t, tx.CanTransition("Archivado", entities.StatusPending))
+}
+
+func TestLoad(t *testing.T) {
+	fromFile, err := Load("../../config/taxonomy.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, Default(), fromFile)
+
+	dir := t.TempDir()
+	jsonPath := filepath.Join(dir, "taxonomy.json")
+	require.NoError(t, os.WriteFile(jsonPath, []byte(`{
+		"statuses": [{"name": "Abierto", "initial": true, "classified": true, "next": ["Archivado"]}, {"name": "Archivado"}],
+		"categories": [{"name": "Urgente", "needs_action": true}, {"name": "Normal", "default": true}],
+		"types": ["Alerta"]
+	}`), 0o644))
+	custom, err := Load(jsonPath)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Abierto", "Archivado"}, custom.StatusNames())
+	assert.Equal(t, []string{"Abierto"}, custom.InitialStatusNames())
+	assert.Equal(t, []string{"Urgente", "Normal"}, custom.CategoryNames())
+	assert.True(t, custom.ValidType("Alerta"))
+	assert.False(t, custom.ValidType("Incidente"))
+
+	_, err = Load(filepath.Join(dir, "missing.yaml"))
+	assert.Error(t, err)
+
+	invalidPath := filepath.Join(dir, "invalid.yaml")
+	require.NoError(t, os.WriteFile(invalidPath, []byte("statuses: []\n"), 0o644))
+	_, err = Load(invalidPath)
+	assert.ErrorContains(t, err, "no tiene estados")
+}
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		modify   func(*Taxonomy)
+		expected string
+	}{
+		{
+			name:     "Repeated status",
+			modify:   func(tx *Taxonomy) { tx.Statuses = append(tx.Statuses, Status{Name: entities.StatusClosed}) },
+			expected: "repetido",
+		},
+		{
+			name: "No initial status",
+			modify: func(tx *Taxonomy) {
+				for i := range tx.Statuses {
+					tx.Statuses[i].Initial = false
+				}
+			},
+			expected: "no tiene estados iniciales",
+		},
+		{
+			name:     "Two classified statuses",
+			modify:   func(tx *Taxonomy) { tx.Statuses[0].Classified = true },
+			expected: "exactamente un estado clasificado",
+		},
+		{
+			name:     "Transition to an unknown status",
+			modify:   func(tx *Taxonomy) { tx.Statuses[0].Next = append(tx.Statuses[0].Next, "Archivado") },
+			expected: "no existe",
+		},
+		{
+			name:     "No default category",
+			modify:   func(tx *Taxonomy) { tx.Categories[1].Default = false },
+			expected: "exactamente una categoría por defecto",
+		},
+		{
+			name:     "Repeated type",
+			modify:   func(tx *Taxonomy) { tx.Types = append(tx.Types, "Incidente") },
+			expected: "repetido",
+		},
+		{
+			name: "Closed types without types",
+			modify: func(tx *Taxonomy) {
+				tx.Types = nil
+				tx.OpenTypes = false
+			},
+			expected: "no tiene tipos de evento",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx := Default()
+			tc.modify(tx)
+			assert.ErrorContains(t, tx.Validate(), tc.expected)
+		})
+	}
+}
+
+func TestRegisterValidations(t *testing.T) {
+	tx := Default()
+	tx.OpenTypes = false
+	v := validator.New()
+	require.NoError(t, tx.RegisterValidations(v))
+
+	type request struct {
+		Status   entities.Status   `validate:"status"`
+		Category entities.Category `validate:"category"`
+		Type     string            `validate:"event_type"`
+	}
+	assert.NoError(t, v.Struct(request{Status: entities.StatusReviewed, Category: entities.CategoryNoAction, Type: "Taller"}))
+
+	err := v.Struct(request{Status: "Archivado", Category: "Otra", Type: "Otro"})
+	var errs validator.ValidationErrors
+	require.ErrorAs(t, err, &errs)
+	tags := []string{}
+	for _, fe := range errs {
+		tags = append(tags, fe.Tag())
+	}
+	assert.Equal(t, []string{StatusTag, CategoryTag, EventTypeTag}, tags)
+}
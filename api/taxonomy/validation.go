@@ -0,0 +1,41 @@
+package taxonomy
+
+import (
+	"prueba_tecnica/api/entities"
+	"reflect"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Etiquetas de validación que registra RegisterValidations.
+const (
+	StatusTag    = "status"
+	CategoryTag  = "category"
+	EventTypeTag = "event_type"
+)
+
+// RegisterValidations registra en v las etiquetas status, category y
+// event_type, que aceptan los valores de t. Funcionan sobre campos de tipo
+// string, entities.Status o entities.Category.
+func (t *Taxonomy) RegisterValidations(v *validator.Validate) error {
+	if err := v.RegisterValidation(StatusTag, func(fl validator.FieldLevel) bool {
+		return t.ValidStatus(entities.Status(stringValue(fl.Field())))
+	}); err != nil {
+		return err
+	}
+	if err := v.RegisterValidation(CategoryTag, func(fl validator.FieldLevel) bool {
+		return t.ValidCategory(entities.Category(stringValue(fl.Field())))
+	}); err != nil {
+		return err
+	}
+	return v.RegisterValidation(EventTypeTag, func(fl validator.FieldLevel) bool {
+		return t.ValidType(stringValue(fl.Field()))
+	})
+}
+
+func stringValue(field reflect.Value) string {
+	if field.Kind() != reflect.String {
+		return ""
+	}
+	return field.String()
+}
@@ -0,0 +1,51 @@
+// Package tenant guarda en el contexto a qué unidad de negocio pertenece una
+// petición. Los repositorios lo leen para que cada tenant solo vea sus datos.
+package tenant
+
+import (
+	"context"
+	"regexp"
+)
+
+// Default es el tenant de las peticiones que no indican ninguno y de los
+// datos guardados antes de que existieran los tenants.
+const Default = "default"
+
+// Header y MetadataKey son el encabezado HTTP y la clave de metadata gRPC con
+// los que se elige el tenant.
+const (
+	Header      = "X-Tenant-ID"
+	MetadataKey = "x-tenant-id"
+)
+
+// validID limita los ids a lo que se puede usar en el nombre de una base de
+// MongoDB.
+var validID = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,47}$`)
+
+type contextKey struct{}
+
+// Valid indica si id se puede usar como tenant.
+func Valid(id string) bool {
+	return validID.MatchString(id)
+}
+
+// WithID guarda en ctx el tenant de la petición.
+func WithID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext devuelve el tenant guardado con WithID o Default.
+func FromContext(ctx context.Context) string {
+	if id, ok := ctx.Value(contextKey{}).(string); ok && id != "" {
+		return id
+	}
+	return Default
+}
+
+// Of normaliza el tenant guardado en un documento: vacío es Default.
+func Of(id string) string {
+	if id == "" {
+		return Default
+	}
+	return id
+}
@@ -0,0 +1,94 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/event"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// commandKey identifica un comando en curso: el driver numera los pedidos por
+// conexión.
+type commandKey struct {
+	connectionID string
+	requestID    int64
+}
+
+// mongoTracer abre una span por comando de MongoDB en Started y la cierra en
+// Succeeded o Failed. El texto del comando no se guarda en la span porque
+// puede llevar datos de los eventos.
+type mongoTracer struct {
+	tracer trace.Tracer
+	mu     sync.Mutex
+	spans  map[commandKey]trace.Span
+}
+
+// MongoMonitor crea las spans de los comandos de MongoDB como hijas de la
+// span del contexto de la operación. Se pasa a options.Client().SetMonitor.
+func MongoMonitor(provider trace.TracerProvider) *event.CommandMonitor {
+	m := &mongoTracer{
+		tracer: provider.Tracer(InstrumentationName + "/mongo"),
+		spans:  map[commandKey]trace.Span{},
+	}
+	return &event.CommandMonitor{
+		Started:   m.started,
+		Succeeded: m.succeeded,
+		Failed:    m.failed,
+	}
+}
+
+func (m *mongoTracer) started(ctx context.Context, e *event.CommandStartedEvent) {
+	attrs := []attribute.KeyValue{
+		semconv.DBSystemMongoDB,
+		semconv.DBNamespace(e.DatabaseName),
+		semconv.DBOperationName(e.CommandName),
+	}
+	name := e.CommandName
+	if collection := commandCollection(e.Command); collection != "" {
+		attrs = append(attrs, semconv.DBCollectionName(collection))
+		name += " " + collection
+	}
+	_, span := m.tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+
+	m.mu.Lock()
+	m.spans[commandKey{connectionID: e.ConnectionID, requestID: e.RequestID}] = span
+	m.mu.Unlock()
+}
+
+func (m *mongoTracer) succeeded(_ context.Context, e *event.CommandSucceededEvent) {
+	if span, ok := m.take(e.ConnectionID, e.RequestID); ok {
+		End(span, nil)
+	}
+}
+
+func (m *mongoTracer) failed(_ context.Context, e *event.CommandFailedEvent) {
+	if span, ok := m.take(e.ConnectionID, e.RequestID); ok {
+		End(span, errors.New(e.Failure))
+	}
+}
+
+func (m *mongoTracer) take(connectionID string, requestID int64) (trace.Span, bool) {
+	key := commandKey{connectionID: connectionID, requestID: requestID}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	span, ok := m.spans[key]
+	delete(m.spans, key)
+	return span, ok
+}
+
+// commandCollection devuelve la colección de comandos como find o insert,
+// que la llevan como valor del primer campo. Los comandos sin colección,
+// como ping, devuelven "".
+func commandCollection(command bson.Raw) string {
+	elements, err := command.Elements()
+	if err != nil || len(elements) == 0 {
+		return ""
+	}
+	collection, _ := elements[0].Value().StringValueOK()
+	return collection
+}
@@ -0,0 +1,64 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/event"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func command(t *testing.T, doc bson.D) bson.Raw {
+	raw, err := bson.Marshal(doc)
+	require.NoError(t, err)
+	return raw
+}
+
+func TestMongoMonitor(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	monitor := MongoMonitor(provider)
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "EventRepository.GetEventByID")
+	monitor.Started(ctx, &event.CommandStartedEvent{
+		Command:      command(t, bson.D{{Key: "find", Value: "events"}, {Key: "filter", Value: bson.D{{Key: "description", Value: "secreto"}}}}),
+		DatabaseName: "events_db",
+		CommandName:  "find",
+		RequestID:    1,
+		ConnectionID: "conn-1",
+	})
+	monitor.Started(ctx, &event.CommandStartedEvent{
+		Command:      command(t, bson.D{{Key: "ping", Value: 1}}),
+		DatabaseName: "admin",
+		CommandName:  "ping",
+		RequestID:    1,
+		ConnectionID: "conn-2",
+	})
+	monitor.Failed(ctx, &event.CommandFailedEvent{CommandFinishedEvent: event.CommandFinishedEvent{RequestID: 1, ConnectionID: "conn-2"}, Failure: "connection reset"})
+	monitor.Succeeded(ctx, &event.CommandSucceededEvent{CommandFinishedEvent: event.CommandFinishedEvent{RequestID: 1, ConnectionID: "conn-1"}})
+	// Un fin sin inicio, como los de comandos anteriores al monitor, se ignora.
+	monitor.Succeeded(ctx, &event.CommandSucceededEvent{CommandFinishedEvent: event.CommandFinishedEvent{RequestID: 7, ConnectionID: "conn-1"}})
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+
+	ping, find := spans[0], spans[1]
+	assert.Equal(t, "ping", ping.Name())
+	assert.Equal(t, codes.Error, ping.Status().Code)
+	assert.Equal(t, "connection reset", ping.Status().Description)
+
+	assert.Equal(t, "find events", find.Name())
+	assert.Equal(t, codes.Unset, find.Status().Code)
+	assert.Equal(t, parent.SpanContext().SpanID(), find.Parent().SpanID())
+	assert.Contains(t, find.Attributes(), attribute.String("db.collection.name", "events"))
+	for _, attr := range find.Attributes() {
+		assert.NotContains(t, attr.Value.Emit(), "secreto")
+	}
+}
@@ -0,0 +1,72 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"prueba_tecnica/api/auth"
+	"prueba_tecnica/api/logging"
+	"prueba_tecnica/api/service"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// AuthUnaryInterceptor exige un JWT en el metadata authorization ("Bearer
+// <token>") o una API key en x-api-key y guarda el principal en el contexto.
+// Responde Unauthenticated si faltan o no son válidas.
+func AuthUnaryInterceptor(authenticator auth.Authenticator, logger logrus.FieldLogger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authenticate(ctx, authenticator, logger, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// AuthStreamInterceptor es el equivalente de AuthUnaryInterceptor para los
+// RPC con streaming; se valida una sola vez al abrir el stream.
+func AuthStreamInterceptor(authenticator auth.Authenticator, logger logrus.FieldLogger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), authenticator, logger, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func authenticate(ctx context.Context, authenticator auth.Authenticator, logger logrus.FieldLogger, method string) (context.Context, error) {
+	if publicMethod(method) {
+		return ctx, nil
+	}
+	var credentials auth.Credentials
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			token, err := auth.BearerToken(values[0])
+			if err != nil {
+				logging.For(ctx, logger, "auth_transportgrpc", method).WithError(err).Warn("credenciales rechazadas")
+				return nil, statusError(ctx, auth.ErrInvalidCredentials)
+			}
+			credentials.Token = token
+		}
+		if values := md.Get("x-api-key"); len(values) > 0 {
+			credentials.APIKey = values[0]
+		}
+	}
+
+	principal, err := authenticator.Authenticate(ctx, credentials)
+	switch {
+	case errors.Is(err, auth.ErrNoCredentials):
+		logging.For(ctx, logger, "auth_transportgrpc", method).WithError(err).Warn("credenciales rechazadas")
+		return nil, statusError(ctx, auth.ErrNoCredentials)
+	case errors.Is(err, auth.ErrInvalidCredentials):
+		logging.For(ctx, logger, "auth_transportgrpc", method).WithError(err).Warn("credenciales rechazadas")
+		return nil, statusError(ctx, auth.ErrInvalidCredentials)
+	case err != nil:
+		logging.For(ctx, logger, "auth_transportgrpc", method).WithError(err).Error("operación fallida")
+		return nil, statusError(ctx, err)
+	}
+	return service.WithPrincipal(ctx, principal), nil
+}
@@ -0,0 +1,72 @@
+package transport
+
+import (
+	"context"
+	"prueba_tecnica/api/apperr"
+	"prueba_tecnica/api/i18n"
+	"prueba_tecnica/api/logging"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain identifica a esta API en el ErrorInfo de los errores.
+const errorDomain = "prueba_tecnica.events"
+
+// localeOf devuelve el idioma del metadato accept-language, con el mismo
+// formato que el encabezado HTTP. Sin metadato los mensajes van en español.
+func localeOf(ctx context.Context) i18n.Locale {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return i18n.Parse(strings.Join(md.Get("accept-language"), ","))
+}
+
+// logProblem deja err en el log: como error si es una falla del servidor y
+// como advertencia si es un error del cliente.
+func logProblem(ctx context.Context, logger logrus.FieldLogger, method string, err error) {
+	entry := logging.For(ctx, logger, "grpc_handler", method).WithError(err)
+	if apperr.CodeOf(err) == apperr.Internal {
+		entry.Error("operación fallida")
+		return
+	}
+	entry.Warn("operación rechazada")
+}
+
+// statusError convierte err en un status gRPC con el código de la tabla de
+// apperr y el mensaje en el idioma de la llamada. El status lleva un
+// ErrorInfo cuyo Reason es el código del error en mayúsculas y, si hay campos
+// inválidos, un BadRequest con cada uno. El detalle de los errores internos
+// solo va al log.
+func statusError(ctx context.Context, err error) error {
+	e := apperr.From(err)
+	locale := localeOf(ctx)
+	message := e.Localize(locale)
+	if e.Code == apperr.Internal {
+		message = e.LocalizeMessage(locale)
+	}
+	st := status.New(e.Code.GRPCCode(), message)
+
+	info := &errdetails.ErrorInfo{
+		Reason:   strings.ToUpper(string(e.Code)),
+		Domain:   errorDomain,
+		Metadata: map[string]string{"message_key": e.Key},
+	}
+	var detailed *status.Status
+	if fields := e.LocalizeFields(locale); len(fields) > 0 {
+		violations := make([]*errdetails.BadRequest_FieldViolation, len(fields))
+		for i, field := range fields {
+			violations[i] = &errdetails.BadRequest_FieldViolation{Field: field.Field, Description: field.Description}
+		}
+		detailed, err = st.WithDetails(info, &errdetails.BadRequest{FieldViolations: violations})
+	} else {
+		detailed, err = st.WithDetails(info)
+	}
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+var errUpdateMask = apperr.New(apperr.InvalidArgument, "request.update_mask")
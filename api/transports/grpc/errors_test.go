@@ -0,0 +1,100 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"prueba_tecnica/api/apperr"
+	"prueba_tecnica/api/service"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestStatusError(t *testing.T) {
+	testCases := []struct {
+		name            string
+		err             error
+		expectedCode    codes.Code
+		expectedReason  string
+		expectedMessage string
+		expectedFields  int
+	}{
+		{
+			name:            "Not found",
+			err:             service.ErrEventNotfound,
+			expectedCode:    codes.NotFound,
+			expectedReason:  "NOT_FOUND",
+			expectedMessage: service.ErrEventNotfound.Message,
+		},
+		{
+			name:            "Version conflict",
+			err:             service.ErrVersionConflict,
+			expectedCode:    codes.Aborted,
+			expectedReason:  "CONFLICT",
+			expectedMessage: service.ErrVersionConflict.Message,
+		},
+		{
+			name:            "Field details",
+			err:             service.ErrValidation.WithFields(apperr.FieldViolation{Field: "name", Description: "es requerido"}),
+			expectedCode:    codes.InvalidArgument,
+			expectedReason:  "INVALID_ARGUMENT",
+			expectedMessage: service.ErrValidation.Message,
+			expectedFields:  1,
+		},
+		{
+			name:            "Internal error does not leak",
+			err:             errors.New("mongo:27017 connection refused"),
+			expectedCode:    codes.Internal,
+			expectedReason:  "INTERNAL",
+			expectedMessage: "error interno del servidor",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			st, ok := status.FromError(statusError(context.Background(), tc.err))
+			require.True(t, ok)
+
+			assert.Equal(t, tc.expectedCode, st.Code())
+			assert.Equal(t, tc.expectedMessage, st.Message())
+			var info *errdetails.ErrorInfo
+			var fields int
+			for _, detail := range st.Details() {
+				switch d := detail.(type) {
+				case *errdetails.ErrorInfo:
+					info = d
+				case *errdetails.BadRequest:
+					fields = len(d.FieldViolations)
+				}
+			}
+			require.NotNil(t, info)
+			assert.Equal(t, tc.expectedReason, info.Reason)
+			assert.Equal(t, errorDomain, info.Domain)
+			assert.Equal(t, apperr.From(tc.err).Key, info.Metadata["message_key"])
+			assert.Equal(t, tc.expectedFields, fields)
+		})
+	}
+}
+
+func TestStatusErrorUsesCallLocale(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("accept-language", "en-US,en;q=0.9"))
+	err := service.ErrValidation.WithFields(apperr.Violation("type", "validation.required"))
+
+	st, ok := status.FromError(statusError(ctx, err))
+	require.True(t, ok)
+
+	assert.Equal(t, "Invalid request structure, fill in all the fields", st.Message())
+	var badRequest *errdetails.BadRequest
+	for _, detail := range st.Details() {
+		if d, ok := detail.(*errdetails.BadRequest); ok {
+			badRequest = d
+		}
+	}
+	require.NotNil(t, badRequest)
+	assert.Equal(t, "is required", badRequest.FieldViolations[0].Description)
+}
@@ -2,14 +2,25 @@ package transport
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"prueba_tecnica/api/apperr"
 	"prueba_tecnica/api/endpoints"
 	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/i18n"
+	"prueba_tecnica/api/logging"
 	pb "prueba_tecnica/api/pb/event"
+	"prueba_tecnica/api/service"
+	"prueba_tecnica/api/taxonomy"
 	"time"
 
 	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -26,13 +37,48 @@ func NewEventHandler(endpoints endpoints.EventEndpoints, logger logrus.FieldLogg
 	}
 }
 
-// Convertir Event de protobuf a entities.Event
+// AuditUnaryInterceptor guarda en el contexto el transporte y el actor, que se
+// toma del metadata x-actor, para el historial de auditoría.
+func AuditUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(auditContext(ctx), req)
+	}
+}
+
+// AuditStreamInterceptor es el equivalente de AuditUnaryInterceptor para los
+// RPC con streaming.
+func AuditStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &contextStream{ServerStream: ss, ctx: auditContext(ss.Context())})
+	}
+}
+
+func auditContext(ctx context.Context) context.Context {
+	ctx = service.WithTransport(ctx, entities.TransportGRPC)
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if actor := md.Get("x-actor"); len(actor) > 0 && actor[0] != "" {
+			ctx = service.WithActor(ctx, actor[0])
+		}
+	}
+	return ctx
+}
+
+// contextStream reemplaza el contexto de un stream.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+// Convertir Event de protobuf a entities.Event. Sin date la fecha queda en
+// cero: al actualizar, el servicio conserva la guardada.
 func protoToEntity(protoEvent *pb.Event) entities.Event {
 	var date time.Time
 	if protoEvent.Date != nil {
 		date = protoEvent.Date.AsTime()
-	} else {
-		date = time.Now()
 	}
 
 	return entities.Event{
@@ -40,172 +86,576 @@ func protoToEntity(protoEvent *pb.Event) entities.Event {
 		Name:        protoEvent.Title,
 		Description: protoEvent.Description,
 		Type:        protoEvent.Type,
-		Status:      protoEvent.Status,
-		Category:    protoEvent.Category,
+		Status:      entities.Status(protoEvent.Status),
+		Category:    entities.Category(protoEvent.Category),
 		Date:        date,
 		NeedsAction: protoEvent.NeedsAction,
+		Version:     protoEvent.Version,
 	}
 }
 
 // Convertir de entities.Event a protobuf Event
 func entityToProto(event entities.Event) *pb.Event {
+	var history []*pb.StatusTransition
+	for _, t := range event.StatusHistory {
+		history = append(history, &pb.StatusTransition{
+			From:   string(t.From),
+			To:     string(t.To),
+			Actor:  t.Actor,
+			Reason: t.Reason,
+			At:     timestamppb.New(t.At),
+		})
+	}
+
+	var deletedAt *timestamppb.Timestamp
+	if event.DeletedAt != nil {
+		deletedAt = timestamppb.New(*event.DeletedAt)
+	}
+
 	return &pb.Event{
-		Id:          event.ID,
-		Title:       event.Name,
-		Description: event.Description,
-		Type:        event.Type,
-		Status:      event.Status,
-		Category:    event.Category,
-		Date:        timestamppb.New(event.Date),
-		NeedsAction: event.NeedsAction,
+		Id:            event.ID,
+		Title:         event.Name,
+		Description:   event.Description,
+		Type:          event.Type,
+		Status:        string(event.Status),
+		Category:      string(event.Category),
+		Date:          timestamppb.New(event.Date),
+		NeedsAction:   event.NeedsAction,
+		StatusHistory: history,
+		DeletedAt:     deletedAt,
+		Version:       event.Version,
+		TenantId:      event.TenantID,
+	}
+}
+
+func historyToProto(entries []entities.AuditEntry) *pb.EventHistory {
+	history := &pb.EventHistory{Entries: make([]*pb.AuditEntry, len(entries))}
+	for i, entry := range entries {
+		changes := make([]*pb.FieldChange, len(entry.Changes))
+		for j, change := range entry.Changes {
+			changes[j] = &pb.FieldChange{
+				Field: change.Field,
+				Old:   changeValue(change.Old),
+				New:   changeValue(change.New),
+			}
+		}
+		history.Entries[i] = &pb.AuditEntry{
+			Id:        entry.ID,
+			EventId:   entry.EventID,
+			Action:    entry.Action,
+			Actor:     entry.Actor,
+			Transport: entry.Transport,
+			Changes:   changes,
+			At:        timestamppb.New(entry.At),
+		}
+	}
+	return history
+}
+
+// changeValue convierte el valor de un campo del historial; las fechas se
+// envían como texto RFC 3339.
+func changeValue(v any) *structpb.Value {
+	switch t := v.(type) {
+	case time.Time:
+		v = t.Format(time.RFC3339Nano)
+	case primitive.DateTime:
+		v = t.Time().UTC().Format(time.RFC3339Nano)
+	}
+	value, err := structpb.NewValue(v)
+	if err != nil {
+		return structpb.NewStringValue(fmt.Sprint(v))
+	}
+	return value
+}
+
+func protoToPage(pageSize int32, pageToken string) entities.PageRequest {
+	return entities.PageRequest{
+		Limit:  int(pageSize),
+		Cursor: pageToken,
+	}
+}
+
+func protoToQuery(req *pb.ListEventsRequest) entities.EventQuery {
+	query := entities.EventQuery{
+		Status:         entities.Status(req.Status),
+		Category:       entities.Category(req.Category),
+		Type:           req.Type,
+		NeedsAction:    req.NeedsAction,
+		Name:           req.Name,
+		Description:    req.Description,
+		SortBy:         req.SortBy,
+		SortOrder:      req.SortOrder,
+		IncludeDeleted: req.IncludeDeleted,
+		Page:           protoToPage(req.PageSize, req.PageToken),
+	}
+	if req.From != nil {
+		query.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		query.To = req.To.AsTime()
+	}
+	return query
+}
+
+func protoToSearch(req *pb.SearchEventsRequest) entities.SearchQuery {
+	query := entities.SearchQuery{
+		Text:     req.Query,
+		Status:   entities.Status(req.Status),
+		Category: entities.Category(req.Category),
+		Page:     protoToPage(req.PageSize, req.PageToken),
+	}
+	if req.From != nil {
+		query.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		query.To = req.To.AsTime()
+	}
+	return query
+}
+
+func searchToProto(page entities.SearchPage) *pb.SearchResults {
+	hits := make([]*pb.SearchHit, len(page.Hits))
+	for i, hit := range page.Hits {
+		hits[i] = &pb.SearchHit{
+			Event:      entityToProto(hit.Event),
+			Score:      hit.Score,
+			Highlights: hit.Highlights,
+		}
+	}
+
+	return &pb.SearchResults{
+		Hits:          hits,
+		NextPageToken: page.NextCursor,
+	}
+}
+
+func pageToProto(page entities.EventPage) *pb.EventList {
+	protoEvents := make([]*pb.Event, len(page.Events))
+	for i, event := range page.Events {
+		protoEvents[i] = entityToProto(event)
+	}
+
+	return &pb.EventList{
+		Events:        protoEvents,
+		NextPageToken: page.NextCursor,
 	}
 }
 
 // Implementaciones de los métodos del servicio gRPC
 func (h *EventHandler) CreateEvent(ctx context.Context, req *pb.Event) (*pb.EventResponse, error) {
-	h.logger.Infoln("Layer: grpc_handler", "Method: CreateEvent", "Request received")
+	logging.For(ctx, h.logger, "grpc_handler", "CreateEvent").Debug("llamada recibida")
 
 	entityEvent := protoToEntity(req)
 	event, err := h.endpoints.CreateEvent(ctx, entityEvent)
 	if err != nil {
-		h.logger.Errorln("Layer: grpc_handler", "Method: CreateEvent", "Error:", err)
-		return nil, status.Errorf(codes.InvalidArgument, "failed to create event: %v", err)
+		logProblem(ctx, h.logger, "CreateEvent", err)
+		return nil, statusError(ctx, err)
 	}
 
 	return &pb.EventResponse{
 		Id:      event.ID,
-		Message: "Event created successfully",
+		Message: i18n.T(localeOf(ctx), "event.created"),
 	}, nil
 }
 
+// CreateEvents guarda los eventos del stream en lotes de hasta
+// service.MaxBatchSize, a medida que llegan.
+func (h *EventHandler) CreateEvents(stream pb.EventService_CreateEventsServer) error {
+	logging.For(stream.Context(), h.logger, "grpc_handler", "CreateEvents").Debug("llamada recibida")
+
+	ctx := stream.Context()
+	locale := localeOf(ctx)
+	response := &pb.BulkResponse{}
+	batch := make([]entities.Event, 0, service.MaxBatchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		results, err := h.endpoints.CreateEvents(ctx, batch)
+		if err != nil {
+			logProblem(ctx, h.logger, "CreateEvents", err)
+			return statusError(ctx, err)
+		}
+		offset := len(response.Results)
+		for _, result := range results {
+			response.Results = append(response.Results, bulkResultToProto(result, offset, locale))
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			logProblem(ctx, h.logger, "CreateEvents", err)
+			return err
+		}
+		batch = append(batch, protoToEntity(req))
+		if len(batch) == service.MaxBatchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+
+	for _, result := range response.Results {
+		if result.Code == int32(codes.OK) {
+			response.Succeeded++
+		} else {
+			response.Failed++
+		}
+	}
+	return stream.SendAndClose(response)
+}
+
+func bulkResultToProto(result entities.BulkResult, offset int, locale i18n.Locale) *pb.BulkResult {
+	proto := &pb.BulkResult{
+		Index:   int32(result.Index + offset),
+		Id:      result.ID,
+		Version: result.Version,
+		Error:   result.Error,
+		Code:    int32(bulkErrorCode(result.Err)),
+	}
+	if result.Err != nil {
+		proto.Error = apperr.Localize(result.Err, locale)
+	}
+	return proto
+}
+
+// bulkErrorCode es el código gRPC del error de un elemento de un lote.
+func bulkErrorCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	return apperr.CodeOf(err).GRPCCode()
+}
+
 func (h *EventHandler) GetEventByID(ctx context.Context, req *pb.EventID) (*pb.Event, error) {
-	h.logger.Infoln("Layer: grpc_handler", "Method: GetEventByID", "Request received for ID:", req.Id)
+	logging.For(ctx, h.logger, "grpc_handler", "GetEventByID").WithField(logging.FieldEventID, req.Id).Debug("llamada recibida")
 
 	event, err := h.endpoints.GetEventByID(ctx, req.Id)
 	if err != nil {
-		h.logger.Errorln("Layer: grpc_handler", "Method: GetEventByID", "Error:", err)
-		return nil, status.Errorf(codes.NotFound, "event not found: %v", err)
+		logProblem(ctx, h.logger, "GetEventByID", err)
+		return nil, statusError(ctx, err)
 	}
 
 	return entityToProto(event), nil
 }
 
-func (h *EventHandler) GetAllEvents(ctx context.Context, req *pb.Empty) (*pb.EventList, error) {
-	h.logger.Infoln("Layer: grpc_handler", "Method: GetAllEvents", "Request received")
+func (h *EventHandler) ListEvents(ctx context.Context, req *pb.ListEventsRequest) (*pb.EventList, error) {
+	logging.For(ctx, h.logger, "grpc_handler", "ListEvents").Debug("llamada recibida")
 
-	events, err := h.endpoints.GetAllEvents(ctx)
+	page, err := h.endpoints.ListEvents(ctx, protoToQuery(req))
 	if err != nil {
-		h.logger.Errorln("Layer: grpc_handler", "Method: GetAllEvents", "Error:", err)
-		return nil, status.Errorf(codes.Internal, "failed to get events: %v", err)
+		logProblem(ctx, h.logger, "ListEvents", err)
+		return nil, statusError(ctx, err)
 	}
 
-	protoEvents := make([]*pb.Event, len(events))
-	for i, event := range events {
-		protoEvents[i] = entityToProto(event)
+	return pageToProto(page), nil
+}
+
+func (h *EventHandler) SearchEvents(ctx context.Context, req *pb.SearchEventsRequest) (*pb.SearchResults, error) {
+	logging.For(ctx, h.logger, "grpc_handler", "SearchEvents").Debug("llamada recibida")
+
+	page, err := h.endpoints.SearchEvents(ctx, protoToSearch(req))
+	if err != nil {
+		logProblem(ctx, h.logger, "SearchEvents", err)
+		return nil, statusError(ctx, err)
 	}
 
-	return &pb.EventList{Events: protoEvents}, nil
+	return searchToProto(page), nil
 }
 
-func (h *EventHandler) GetEventsByStatus(ctx context.Context, req *pb.StatusRequest) (*pb.EventList, error) {
-	h.logger.Infoln("Layer: grpc_handler", "Method: GetEventsByStatus", "Request received for status:", req.Status)
+func (h *EventHandler) GetAllEvents(ctx context.Context, req *pb.PageRequest) (*pb.EventList, error) {
+	logging.For(ctx, h.logger, "grpc_handler", "GetAllEvents").Debug("llamada recibida")
 
-	events, err := h.endpoints.GetEventsByStatus(ctx, req.Status)
+	page, err := h.endpoints.GetAllEvents(ctx, protoToPage(req.PageSize, req.PageToken))
 	if err != nil {
-		h.logger.Errorln("Layer: grpc_handler", "Method: GetEventsByStatus", "Error:", err)
-		return nil, status.Errorf(codes.InvalidArgument, "failed to get events by status: %v", err)
+		logProblem(ctx, h.logger, "GetAllEvents", err)
+		return nil, statusError(ctx, err)
 	}
 
-	protoEvents := make([]*pb.Event, len(events))
-	for i, event := range events {
-		protoEvents[i] = entityToProto(event)
+	return pageToProto(page), nil
+}
+
+func (h *EventHandler) GetEventsByStatus(ctx context.Context, req *pb.StatusRequest) (*pb.EventList, error) {
+	logging.For(ctx, h.logger, "grpc_handler", "GetEventsByStatus").WithField("status", req.Status).Debug("llamada recibida")
+
+	page, err := h.endpoints.GetEventsByStatus(ctx, entities.Status(req.Status), protoToPage(req.PageSize, req.PageToken))
+	if err != nil {
+		logProblem(ctx, h.logger, "GetEventsByStatus", err)
+		return nil, statusError(ctx, err)
 	}
 
-	return &pb.EventList{Events: protoEvents}, nil
+	return pageToProto(page), nil
 }
 
 func (h *EventHandler) GetEventsByCategory(ctx context.Context, req *pb.CategoryRequest) (*pb.EventList, error) {
-	h.logger.Infoln("Layer: grpc_handler", "Method: GetEventsByCategory", "Request received for category:", req.Category)
+	logging.For(ctx, h.logger, "grpc_handler", "GetEventsByCategory").WithField("category", req.Category).Debug("llamada recibida")
 
-	events, err := h.endpoints.GetEventsByCategory(ctx, req.Category)
+	page, err := h.endpoints.GetEventsByCategory(ctx, entities.Category(req.Category), protoToPage(req.PageSize, req.PageToken))
 	if err != nil {
-		h.logger.Errorln("Layer: grpc_handler", "Method: GetEventsByCategory", "Error:", err)
-		return nil, status.Errorf(codes.InvalidArgument, "failed to get events by category: %v", err)
+		logProblem(ctx, h.logger, "GetEventsByCategory", err)
+		return nil, statusError(ctx, err)
 	}
 
-	protoEvents := make([]*pb.Event, len(events))
-	for i, event := range events {
-		protoEvents[i] = entityToProto(event)
-	}
-
-	return &pb.EventList{Events: protoEvents}, nil
+	return pageToProto(page), nil
 }
 
-func (h *EventHandler) GetEventsNeedingAction(ctx context.Context, req *pb.Empty) (*pb.EventList, error) {
-	h.logger.Infoln("Layer: grpc_handler", "Method: GetEventsNeedingAction", "Request received")
+func (h *EventHandler) GetEventsNeedingAction(ctx context.Context, req *pb.PageRequest) (*pb.EventList, error) {
+	logging.For(ctx, h.logger, "grpc_handler", "GetEventsNeedingAction").Debug("llamada recibida")
 
-	events, err := h.endpoints.GetEventsNeedingAction(ctx)
+	page, err := h.endpoints.GetEventsNeedingAction(ctx, protoToPage(req.PageSize, req.PageToken))
 	if err != nil {
-		h.logger.Errorln("Layer: grpc_handler", "Method: GetEventsNeedingAction", "Error:", err)
-		return nil, status.Errorf(codes.Internal, "failed to get events needing action: %v", err)
-	}
-
-	protoEvents := make([]*pb.Event, len(events))
-	for i, event := range events {
-		protoEvents[i] = entityToProto(event)
+		logProblem(ctx, h.logger, "GetEventsNeedingAction", err)
+		return nil, statusError(ctx, err)
 	}
 
-	return &pb.EventList{Events: protoEvents}, nil
+	return pageToProto(page), nil
 }
 
 func (h *EventHandler) UpdateEvent(ctx context.Context, req *pb.Event) (*pb.Event, error) {
-	h.logger.Infoln("Layer: grpc_handler", "Method: UpdateEvent", "Request received for ID:", req.Id)
+	logging.For(ctx, h.logger, "grpc_handler", "UpdateEvent").WithField(logging.FieldEventID, req.Id).Debug("llamada recibida")
 
 	entityEvent := protoToEntity(req)
 	event, err := h.endpoints.UpdateEvent(ctx, entityEvent)
 	if err != nil {
-		h.logger.Errorln("Layer: grpc_handler", "Method: UpdateEvent", "Error:", err)
-		return nil, status.Errorf(codes.InvalidArgument, "failed to update event: %v", err)
+		logProblem(ctx, h.logger, "UpdateEvent", err)
+		return nil, statusError(ctx, err)
 	}
 
 	return entityToProto(event), nil
 }
 
+func (h *EventHandler) PatchEvent(ctx context.Context, req *pb.PatchEventRequest) (*pb.Event, error) {
+	logging.For(ctx, h.logger, "grpc_handler", "PatchEvent").WithField(logging.FieldEventID, req.GetEvent().GetId()).Debug("llamada recibida")
+
+	patch, err := maskToPatch(req.GetEvent(), req.GetUpdateMask().GetPaths())
+	if err != nil {
+		logProblem(ctx, h.logger, "PatchEvent", err)
+		return nil, statusError(ctx, err)
+	}
+
+	event, err := h.endpoints.PatchEvent(ctx, req.GetEvent().GetId(), patch)
+	if err != nil {
+		logProblem(ctx, h.logger, "PatchEvent", err)
+		return nil, statusError(ctx, err)
+	}
+
+	return entityToProto(event), nil
+}
+
+// maskToPatch toma de event solo los campos listados en paths. Los errores
+// son errUpdateMask con el campo rechazado.
+func maskToPatch(event *pb.Event, paths []string) (entities.EventPatch, error) {
+	patch := entities.EventPatch{Version: event.GetVersion()}
+	if event == nil {
+		return patch, errUpdateMask.WithFields(apperr.Violation("event", "validation.required"))
+	}
+	if len(paths) == 0 {
+		return patch, errUpdateMask.WithFields(apperr.Violation("update_mask", "validation.not_empty"))
+	}
+	for _, path := range paths {
+		switch path {
+		case "title":
+			patch.Name = &event.Title
+		case "description":
+			patch.Description = &event.Description
+		case "type":
+			patch.Type = &event.Type
+		case "status":
+			patch.Status = (*entities.Status)(&event.Status)
+		case "category":
+			patch.Category = (*entities.Category)(&event.Category)
+		case "needs_action":
+			patch.NeedsAction = &event.NeedsAction
+		case "date":
+			if event.GetDate() == nil {
+				return patch, errUpdateMask.WithFields(apperr.Violation("date", "validation.not_nullable"))
+			}
+			date := event.Date.AsTime()
+			patch.Date = &date
+		default:
+			return patch, errUpdateMask.WithFields(apperr.Violation(path, "validation.read_only"))
+		}
+	}
+	return patch, nil
+}
+
 func (h *EventHandler) DeleteEvent(ctx context.Context, req *pb.EventID) (*pb.DeleteResponse, error) {
-	h.logger.Infoln("Layer: grpc_handler", "Method: DeleteEvent", "Request received for ID:", req.Id)
+	logging.For(ctx, h.logger, "grpc_handler", "DeleteEvent").WithField(logging.FieldEventID, req.Id).Debug("llamada recibida")
 
 	err := h.endpoints.DeleteEvent(ctx, req.Id)
 	if err != nil {
-		h.logger.Errorln("Layer: grpc_handler", "Method: DeleteEvent", "Error:", err)
-		return nil, status.Errorf(codes.InvalidArgument, "failed to delete event: %v", err)
+		logProblem(ctx, h.logger, "DeleteEvent", err)
+		return nil, statusError(ctx, err)
 	}
 
 	return &pb.DeleteResponse{
 		Success: true,
-		Message: "Event deleted successfully",
+		Message: i18n.T(localeOf(ctx), "event.deleted"),
 	}, nil
 }
 
+func (h *EventHandler) RestoreEvent(ctx context.Context, req *pb.EventID) (*pb.Event, error) {
+	logging.For(ctx, h.logger, "grpc_handler", "RestoreEvent").WithField(logging.FieldEventID, req.Id).Debug("llamada recibida")
+
+	event, err := h.endpoints.RestoreEvent(ctx, req.Id)
+	if err != nil {
+		logProblem(ctx, h.logger, "RestoreEvent", err)
+		return nil, statusError(ctx, err)
+	}
+
+	return entityToProto(event), nil
+}
+
 func (h *EventHandler) ClassifyEvent(ctx context.Context, req *pb.EventID) (*pb.Event, error) {
-	h.logger.Infoln("Layer: grpc_handler", "Method: ClassifyEvent", "Request received for ID:", req.Id)
+	logging.For(ctx, h.logger, "grpc_handler", "ClassifyEvent").WithField(logging.FieldEventID, req.Id).Debug("llamada recibida")
 
 	event, err := h.endpoints.ClassifyEvent(ctx, req.Id)
 	if err != nil {
-		h.logger.Errorln("Layer: grpc_handler", "Method: ClassifyEvent", "Error:", err)
-		return nil, status.Errorf(codes.InvalidArgument, "failed to classify event: %v", err)
+		logProblem(ctx, h.logger, "ClassifyEvent", err)
+		return nil, statusError(ctx, err)
 	}
 
 	return entityToProto(event), nil
 }
 
 func (h *EventHandler) ManualClassifyEvent(ctx context.Context, req *pb.ManualClassifyRequest) (*pb.Event, error) {
-	h.logger.Infoln("Layer: grpc_handler", "Method: ManualClassifyEvent", "Request received for ID:", req.Id)
+	logging.For(ctx, h.logger, "grpc_handler", "ManualClassifyEvent").WithField(logging.FieldEventID, req.Id).Debug("llamada recibida")
+
+	event, err := h.endpoints.ManualClassifyEvent(ctx, req.Id, entities.Category(req.Category))
+	if err != nil {
+		logProblem(ctx, h.logger, "ManualClassifyEvent", err)
+		return nil, statusError(ctx, err)
+	}
+
+	return entityToProto(event), nil
+}
+
+func (h *EventHandler) DryRunClassification(ctx context.Context, req *pb.Event) (*pb.ClassificationResult, error) {
+	logging.For(ctx, h.logger, "grpc_handler", "DryRunClassification").Debug("llamada recibida")
+
+	classification, err := h.endpoints.DryRunClassification(ctx, protoToEntity(req))
+	if err != nil {
+		logProblem(ctx, h.logger, "DryRunClassification", err)
+		return nil, statusError(ctx, err)
+	}
+
+	return &pb.ClassificationResult{
+		Rule:        classification.Rule,
+		Category:    string(classification.Category),
+		NeedsAction: classification.NeedsAction,
+	}, nil
+}
+
+func (h *EventHandler) TransitionEvent(ctx context.Context, req *pb.TransitionRequest) (*pb.Event, error) {
+	logging.For(ctx, h.logger, "grpc_handler", "TransitionEvent").WithField(logging.FieldEventID, req.Id).Debug("llamada recibida")
 
-	event, err := h.endpoints.ManualClassifyEvent(ctx, req.Id, req.Category)
+	event, err := h.endpoints.TransitionEvent(ctx, req.Id, entities.StatusTransition{
+		To:     entities.Status(req.To),
+		Actor:  req.Actor,
+		Reason: req.Reason,
+	})
 	if err != nil {
-		h.logger.Errorln("Layer: grpc_handler", "Method: ManualClassifyEvent", "Error:", err)
-		return nil, status.Errorf(codes.InvalidArgument, "failed to manually classify event: %v", err)
+		logProblem(ctx, h.logger, "TransitionEvent", err)
+		return nil, statusError(ctx, err)
 	}
 
 	return entityToProto(event), nil
 }
+
+func (h *EventHandler) GetEventHistory(ctx context.Context, req *pb.EventID) (*pb.EventHistory, error) {
+	logging.For(ctx, h.logger, "grpc_handler", "GetEventHistory").WithField(logging.FieldEventID, req.Id).Debug("llamada recibida")
+
+	entries, err := h.endpoints.GetEventHistory(ctx, req.Id)
+	if err != nil {
+		logProblem(ctx, h.logger, "GetEventHistory", err)
+		return nil, statusError(ctx, err)
+	}
+
+	return historyToProto(entries), nil
+}
+
+func (h *EventHandler) WatchEvents(req *pb.WatchRequest, stream pb.EventService_WatchEventsServer) error {
+	logging.For(stream.Context(), h.logger, "grpc_handler", "WatchEvents").Debug("llamada recibida")
+
+	ctx := stream.Context()
+	filter := entities.ChangeFilter{
+		Status:      entities.Status(req.Status),
+		Category:    entities.Category(req.Category),
+		Type:        req.Type,
+		NeedsAction: req.NeedsAction,
+	}
+	feed, err := h.endpoints.WatchEvents(ctx, filter)
+	if err != nil {
+		logProblem(ctx, h.logger, "WatchEvents", err)
+		return statusError(ctx, err)
+	}
+
+	for {
+		select {
+		case change, ok := <-feed:
+			if !ok && ctx.Err() != nil {
+				return status.FromContextError(ctx.Err()).Err()
+			}
+			if !ok {
+				// El servidor se está apagando o el cliente se quedó atrás.
+				logging.For(ctx, h.logger, "grpc_handler", "WatchEvents").Info("feed cerrado")
+				return status.Error(codes.Unavailable, i18n.T(localeOf(ctx), "watch.closed"))
+			}
+			err := stream.Send(&pb.EventChange{
+				Type:  change.Type,
+				Event: entityToProto(change.Event),
+				At:    timestamppb.New(change.At),
+			})
+			if err != nil {
+				logProblem(ctx, h.logger, "WatchEvents", err)
+				return err
+			}
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+}
+
+func (h *EventHandler) GetTaxonomy(ctx context.Context, req *pb.Empty) (*pb.Taxonomy, error) {
+	logging.For(ctx, h.logger, "grpc_handler", "GetTaxonomy").Debug("llamada recibida")
+
+	t, err := h.endpoints.GetTaxonomy(ctx)
+	if err != nil {
+		logProblem(ctx, h.logger, "GetTaxonomy", err)
+		return nil, statusError(ctx, err)
+	}
+	return taxonomyToProto(t), nil
+}
+
+func taxonomyToProto(t taxonomy.Taxonomy) *pb.Taxonomy {
+	out := &pb.Taxonomy{Types: t.Types, OpenTypes: t.OpenTypes}
+	for _, s := range t.Statuses {
+		next := make([]string, len(s.Next))
+		for i, n := range s.Next {
+			next[i] = string(n)
+		}
+		out.Statuses = append(out.Statuses, &pb.TaxonomyStatus{
+			Name:       string(s.Name),
+			Initial:    s.Initial,
+			Classified: s.Classified,
+			Next:       next,
+		})
+	}
+	for _, c := range t.Categories {
+		out.Categories = append(out.Categories, &pb.TaxonomyCategory{
+			Name:        string(c.Name),
+			NeedsAction: c.NeedsAction,
+			IsDefault:   c.Default,
+		})
+	}
+	return out
+}
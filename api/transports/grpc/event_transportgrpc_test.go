@@ -0,0 +1,70 @@
+package transport
+
+import (
+	"context"
+	"prueba_tecnica/api/apperr"
+	"prueba_tecnica/api/endpoints"
+	"prueba_tecnica/api/entities"
+	pb "prueba_tecnica/api/pb/event"
+	"prueba_tecnica/api/repository"
+	"prueba_tecnica/api/service"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateEventKeepsDate(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	repo := repository.NewMemoryEventRepository(logger)
+	handler := NewEventHandler(endpoints.NewEventEndpoints(service.NewEventService(repo, logger)), logger)
+	ctx := context.Background()
+
+	date := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	created, err := repo.CreateEvent(ctx, entities.Event{Name: "VPN", Type: "Incidente", Description: "Caída", Status: entities.StatusPending})
+	require.NoError(t, err)
+	created.Date = date
+	_, err = repo.UpdateEvent(ctx, created)
+	require.NoError(t, err)
+
+	_, err = handler.UpdateEvent(ctx, &pb.Event{Id: created.ID, Title: "VPN caída", Type: "Incidente", Description: "Caída", Status: string(entities.StatusPending)})
+	require.NoError(t, err)
+
+	stored, err := repo.GetEventByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "VPN caída", stored.Name)
+	assert.True(t, date.Equal(stored.Date), stored.Date)
+}
+
+func TestMaskToPatch(t *testing.T) {
+	event := &pb.Event{Title: "VPN", NeedsAction: true, Version: 2}
+
+	patch, err := maskToPatch(event, []string{"title", "needs_action"})
+	require.NoError(t, err)
+	assert.Equal(t, "VPN", *patch.Name)
+	assert.True(t, *patch.NeedsAction)
+	assert.Equal(t, int64(2), patch.Version)
+	assert.Nil(t, patch.Description)
+
+	testCases := []struct {
+		name     string
+		event    *pb.Event
+		paths    []string
+		expected apperr.FieldViolation
+	}{
+		{name: "Missing event", paths: []string{"title"}, expected: apperr.Violation("event", "validation.required")},
+		{name: "Empty mask", event: event, expected: apperr.Violation("update_mask", "validation.not_empty")},
+		{name: "Date without value", event: event, paths: []string{"date"}, expected: apperr.Violation("date", "validation.not_nullable")},
+		{name: "Read only field", event: event, paths: []string{"version"}, expected: apperr.Violation("version", "validation.read_only")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := maskToPatch(tc.event, tc.paths)
+
+			assert.Equal(t, errUpdateMask.WithFields(tc.expected), err)
+		})
+	}
+}
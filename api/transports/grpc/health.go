@@ -0,0 +1,92 @@
+package transport
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"prueba_tecnica/api/health"
+	"prueba_tecnica/api/logging"
+	pb "prueba_tecnica/api/pb/event"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// healthWatchInterval es cada cuánto Watch vuelve a correr las
+// comprobaciones para avisar de los cambios.
+const healthWatchInterval = 5 * time.Second
+
+// publicMethod indica si el RPC se atiende sin credenciales ni tenant, como
+// las sondas de grpc.health.v1.
+func publicMethod(method string) bool {
+	return strings.HasPrefix(method, "/"+healthpb.Health_ServiceDesc.ServiceName+"/")
+}
+
+type healthHandler struct {
+	healthpb.UnimplementedHealthServer
+	checker  *health.Checker
+	logger   logrus.FieldLogger
+	interval time.Duration
+}
+
+// NewHealthHandler implementa grpc.health.v1 con las comprobaciones de
+// checker. Responde por el servidor completo (service vacío) y por
+// event.EventService.
+func NewHealthHandler(checker *health.Checker, logger logrus.FieldLogger) healthpb.HealthServer {
+	return &healthHandler{checker: checker, logger: logger, interval: healthWatchInterval}
+}
+
+func (h *healthHandler) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	if !knownService(req.GetService()) {
+		return nil, status.Errorf(codes.NotFound, "servicio desconocido: %s", req.GetService())
+	}
+	return &healthpb.HealthCheckResponse{Status: h.status(ctx)}, nil
+}
+
+// Watch envía el estado actual y después cada cambio. Termina cuando el
+// servidor empieza a detenerse, para no retrasar el apagado.
+func (h *healthHandler) Watch(req *healthpb.HealthCheckRequest, stream healthpb.Health_WatchServer) error {
+	ctx := stream.Context()
+	if !knownService(req.GetService()) {
+		return stream.Send(&healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVICE_UNKNOWN})
+	}
+
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+
+	last := healthpb.HealthCheckResponse_UNKNOWN
+	for {
+		if current := h.status(ctx); current != last {
+			if err := stream.Send(&healthpb.HealthCheckResponse{Status: current}); err != nil {
+				logging.For(ctx, h.logger, "health_transportgrpc", "Watch").WithError(err).Error("operación fallida")
+				return err
+			}
+			last = current
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-h.checker.Draining():
+			if last != healthpb.HealthCheckResponse_NOT_SERVING {
+				return stream.Send(&healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_NOT_SERVING})
+			}
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h *healthHandler) status(ctx context.Context) healthpb.HealthCheckResponse_ServingStatus {
+	if report := h.checker.Ready(ctx); !report.Up() {
+		logging.Layer(h.logger, "health_transportgrpc", "status").WithFields(logrus.Fields{"errors": report.Errors, "checks": report.Checks}).Warn("el servicio no está listo")
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	return healthpb.HealthCheckResponse_SERVING
+}
+
+func knownService(name string) bool {
+	return name == "" || name == pb.EventService_ServiceDesc.ServiceName
+}
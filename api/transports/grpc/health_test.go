@@ -0,0 +1,102 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"prueba_tecnica/api/auth"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/health"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+type rejectAll struct{}
+
+func (rejectAll) Authenticate(ctx context.Context, credentials auth.Credentials) (entities.Principal, error) {
+	return entities.Principal{}, auth.ErrNoCredentials
+}
+
+// watchStream guarda las respuestas que envía Watch.
+type watchStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan healthpb.HealthCheckResponse_ServingStatus
+}
+
+func (s *watchStream) Context() context.Context { return s.ctx }
+
+func (s *watchStream) Send(resp *healthpb.HealthCheckResponse) error {
+	s.sent <- resp.GetStatus()
+	return nil
+}
+
+func TestHealthCheck(t *testing.T) {
+	var mongoErr error
+	checker := health.NewChecker(time.Second)
+	checker.Add("mongo", func(ctx context.Context) error { return mongoErr })
+	handler := NewHealthHandler(checker, logrus.New())
+	ctx := context.Background()
+
+	for _, service := range []string{"", "event.EventService"} {
+		resp, err := handler.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+		require.NoError(t, err)
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.GetStatus(), service)
+	}
+
+	mongoErr = errors.New("sin conexión")
+	resp, err := handler.Check(ctx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, resp.GetStatus())
+
+	_, err = handler.Check(ctx, &healthpb.HealthCheckRequest{Service: "otro.Servicio"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestHealthWatch(t *testing.T) {
+	var mongoErr error
+	checker := health.NewChecker(time.Second)
+	checker.Add("mongo", func(ctx context.Context) error { return mongoErr })
+	handler := &healthHandler{checker: checker, logger: logrus.New(), interval: time.Millisecond}
+
+	stream := &watchStream{ctx: context.Background(), sent: make(chan healthpb.HealthCheckResponse_ServingStatus, 8)}
+	done := make(chan error, 1)
+	go func() { done <- handler.Watch(&healthpb.HealthCheckRequest{}, stream) }()
+
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, <-stream.sent)
+	checker.Drain()
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, <-stream.sent)
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("Watch debería terminar al drenar")
+	}
+
+	unknown := &watchStream{ctx: context.Background(), sent: make(chan healthpb.HealthCheckResponse_ServingStatus, 1)}
+	require.NoError(t, handler.Watch(&healthpb.HealthCheckRequest{Service: "otro.Servicio"}, unknown))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVICE_UNKNOWN, <-unknown.sent)
+}
+
+func TestHealthIsPublic(t *testing.T) {
+	intercept := AuthUnaryInterceptor(rejectAll{}, logrus.New())
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+
+	resp, err := intercept(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: healthpb.Health_Check_FullMethodName}, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+
+	_, err = intercept(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/event.EventService/GetEventByID"}, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	resp, err = TenantUnaryInterceptor(logrus.New())(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: healthpb.Health_Check_FullMethodName}, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+}
@@ -0,0 +1,76 @@
+package transport
+
+import (
+	"context"
+	"prueba_tecnica/api/logging"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// LoggingUnaryInterceptor toma el request ID del metadata x-request-id o
+// genera uno, lo devuelve en los headers de la respuesta y guarda en el
+// contexto un logger con él. Al terminar registra la llamada con su código y
+// duración.
+func LoggingUnaryInterceptor(logger logrus.FieldLogger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		ctx, requestID := startCall(ctx, logger, info.FullMethod)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(logging.RequestIDMetadataKey, requestID))
+		resp, err := handler(ctx, req)
+		logCall(ctx, logger, info.FullMethod, err, time.Since(start))
+		return resp, err
+	}
+}
+
+// LoggingStreamInterceptor es el equivalente de LoggingUnaryInterceptor para
+// los RPC con streaming; la duración es la del stream completo.
+func LoggingStreamInterceptor(logger logrus.FieldLogger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		ctx, requestID := startCall(ss.Context(), logger, info.FullMethod)
+		_ = ss.SetHeader(metadata.Pairs(logging.RequestIDMetadataKey, requestID))
+		err := handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+		logCall(ctx, logger, info.FullMethod, err, time.Since(start))
+		return err
+	}
+}
+
+func startCall(ctx context.Context, logger logrus.FieldLogger, method string) (context.Context, string) {
+	var requested string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(logging.RequestIDMetadataKey); len(values) > 0 {
+			requested = values[0]
+		}
+	}
+	requestID := logging.RequestID(requested)
+	return logging.Start(ctx, logger, requestID, logrus.Fields{"transport": "grpc", "rpc": method}), requestID
+}
+
+// logCall registra el fin de una llamada. Las sondas de grpc.health.v1 van en
+// debug para no llenar el log.
+func logCall(ctx context.Context, logger logrus.FieldLogger, method string, err error, elapsed time.Duration) {
+	code := status.Code(err)
+	entry := logging.FromContext(ctx, logger).WithFields(logrus.Fields{"code": code.String(), logging.FieldDuration: logging.Duration(elapsed)})
+	switch {
+	case serverFault(code):
+		entry.Error("llamada atendida")
+	case publicMethod(method):
+		entry.Debug("llamada atendida")
+	default:
+		entry.Info("llamada atendida")
+	}
+}
+
+// serverFault indica si code es una falla del servidor y no del cliente.
+func serverFault(code codes.Code) bool {
+	switch code {
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable, codes.Unimplemented:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+package transport
+
+import (
+	"context"
+	"prueba_tecnica/api/logging"
+	"prueba_tecnica/api/service"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestLoggingUnaryInterceptor(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+	interceptor := LoggingUnaryInterceptor(logger)
+	info := &grpc.UnaryServerInfo{FullMethod: "/event.EventService/GetEventByID"}
+
+	t.Run("Keeps the client request ID", func(t *testing.T) {
+		hook.Reset()
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(logging.RequestIDMetadataKey, "req-1"))
+
+		var seen string
+		_, err := interceptor(ctx, nil, info, func(ctx context.Context, req any) (any, error) {
+			seen = logging.RequestIDFromContext(ctx)
+			return nil, nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "req-1", seen)
+		entry := hook.LastEntry()
+		require.NotNil(t, entry)
+		assert.Equal(t, "req-1", entry.Data[logging.FieldRequestID])
+		assert.Equal(t, "OK", entry.Data["code"])
+		assert.Equal(t, logrus.InfoLevel, entry.Level)
+	})
+
+	t.Run("Generates a request ID", func(t *testing.T) {
+		hook.Reset()
+
+		_, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+			return nil, statusError(ctx, service.ErrEventNotfound)
+		})
+
+		require.Error(t, err)
+		entry := hook.LastEntry()
+		require.NotNil(t, entry)
+		assert.Len(t, entry.Data[logging.FieldRequestID], 32)
+		assert.Equal(t, "NotFound", entry.Data["code"])
+		assert.Equal(t, logrus.InfoLevel, entry.Level)
+	})
+}
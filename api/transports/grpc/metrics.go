@@ -0,0 +1,36 @@
+package transport
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// CallObserver recibe cada llamada gRPC atendida con su código de respuesta.
+type CallObserver interface {
+	ObserveGRPC(method, code string, elapsed time.Duration)
+}
+
+// MetricsUnaryInterceptor mide cada llamada. Va primero en la cadena para
+// contar también las rechazadas por autenticación o tenant.
+func MetricsUnaryInterceptor(observer CallObserver) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		observer.ObserveGRPC(info.FullMethod, status.Code(err).String(), time.Since(start))
+		return resp, err
+	}
+}
+
+// MetricsStreamInterceptor es el equivalente de MetricsUnaryInterceptor para
+// los RPC con streaming; la duración es la del stream completo.
+func MetricsStreamInterceptor(observer CallObserver) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		observer.ObserveGRPC(info.FullMethod, status.Code(err).String(), time.Since(start))
+		return err
+	}
+}
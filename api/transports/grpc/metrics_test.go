@@ -0,0 +1,41 @@
+package transport
+
+import (
+	"context"
+	"prueba_tecnica/api/service"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+type recordingObserver struct {
+	calls []string
+}
+
+func (o *recordingObserver) ObserveGRPC(method, code string, elapsed time.Duration) {
+	o.calls = append(o.calls, method+" "+code)
+}
+
+func TestMetricsInterceptors(t *testing.T) {
+	observer := &recordingObserver{}
+	unary := MetricsUnaryInterceptor(observer)
+	stream := MetricsStreamInterceptor(observer)
+
+	_, _ = unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/event.EventService/GetEventByID"}, func(ctx context.Context, req any) (any, error) {
+		return nil, nil
+	})
+	_, _ = unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/event.EventService/GetEventByID"}, func(ctx context.Context, req any) (any, error) {
+		return nil, statusError(ctx, service.ErrEventNotfound)
+	})
+	_ = stream(nil, nil, &grpc.StreamServerInfo{FullMethod: "/event.EventService/WatchEvents"}, func(srv any, ss grpc.ServerStream) error {
+		return nil
+	})
+
+	assert.Equal(t, []string{
+		"/event.EventService/GetEventByID OK",
+		"/event.EventService/GetEventByID NotFound",
+		"/event.EventService/WatchEvents OK",
+	}, observer.calls)
+}
@@ -0,0 +1,56 @@
+package transport
+
+import (
+	"context"
+	"prueba_tecnica/api/logging"
+	"prueba_tecnica/api/service"
+	"prueba_tecnica/api/tenant"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// TenantUnaryInterceptor guarda en el contexto el tenant de la llamada: el
+// del principal autenticado o el del metadata x-tenant-id. Va después de
+// AuthUnaryInterceptor.
+func TenantUnaryInterceptor(logger logrus.FieldLogger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := resolveTenant(ctx, logger, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// TenantStreamInterceptor es el equivalente de TenantUnaryInterceptor para
+// los RPC con streaming.
+func TenantStreamInterceptor(logger logrus.FieldLogger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := resolveTenant(ss.Context(), logger, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func resolveTenant(ctx context.Context, logger logrus.FieldLogger, method string) (context.Context, error) {
+	if publicMethod(method) {
+		return ctx, nil
+	}
+	var requested string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(tenant.MetadataKey); len(values) > 0 {
+			requested = values[0]
+		}
+	}
+
+	resolved, err := service.ResolveTenant(ctx, requested)
+	if err != nil {
+		logging.For(ctx, logger, "tenant_transportgrpc", method).WithError(err).Warn("tenant rechazado")
+		return nil, statusError(ctx, err)
+	}
+	return logging.With(resolved, logger, logrus.Fields{logging.FieldTenant: tenant.FromContext(resolved)}), nil
+}
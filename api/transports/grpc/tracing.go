@@ -0,0 +1,13 @@
+package transport
+
+import (
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc/filters"
+	"google.golang.org/grpc/stats"
+)
+
+// TracingHandler abre una span por llamada, como hija del trace-context W3C
+// que llegue en la metadata. Las sondas de grpc.health.v1 no se trazan.
+func TracingHandler() stats.Handler {
+	return otelgrpc.NewServerHandler(otelgrpc.WithFilter(filters.Not(filters.HealthCheck())))
+}
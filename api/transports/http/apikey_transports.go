@@ -0,0 +1,92 @@
+package transports
+
+import (
+	"net/http"
+	"prueba_tecnica/api/endpoints"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/logging"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+func NewAPIKeyRouter(router *gin.Engine, endpoints endpoints.APIKeyEndpoints, logger logrus.FieldLogger) {
+	apiKeyGroup := router.Group("/api/v1/api-keys")
+
+	//	@Summary		Crear una API key
+	//	@Description	Genera una API key para subject con los roles indicados. La clave en claro solo se devuelve en esta respuesta; se guarda su SHA-256
+	//	@Tags			API keys
+	//	@Accept			json
+	//	@Produce		json
+	//	@Security		BearerAuth
+	//	@Security		ApiKeyAuth
+	//	@Param			key	body		entities.APIKey		true	"Nombre, subject y roles"
+	//	@Success		201	{object}	entities.APIKey		"API key creada, con la clave en claro"
+	//	@Failure		400	{object}	Problem	"Error en los datos de entrada"
+	//	@Failure		401	{object}	Problem	"Credenciales faltantes o inválidas"
+	//	@Failure		403	{object}	Problem	"Sin permiso para esta operación"
+	//	@Failure		500	{object}	Problem	"Error interno del servidor"
+	//	@Router			/api-keys [post]
+	apiKeyGroup.POST("/", func(c *gin.Context) {
+		var key entities.APIKey
+		if err := c.ShouldBindJSON(&key); err != nil {
+			writeAPIKeyError(c, logger, "Post", malformed(err))
+			return
+		}
+		created, err := endpoints.CreateAPIKey(c.Request.Context(), key)
+		if err != nil {
+			writeAPIKeyError(c, logger, "Post", err)
+			return
+		}
+		logging.For(c.Request.Context(), logger, "apikey_transports", "POST").WithFields(logrus.Fields{"apikey_id": created.ID, "prefix": created.Prefix}).Info("API key creada")
+		c.JSON(http.StatusCreated, created)
+	})
+
+	//	@Summary		Listar API keys
+	//	@Tags			API keys
+	//	@Produce		json
+	//	@Security		BearerAuth
+	//	@Security		ApiKeyAuth
+	//	@Success		200	{array}		entities.APIKey		"API keys, sin la clave en claro"
+	//	@Failure		401	{object}	Problem	"Credenciales faltantes o inválidas"
+	//	@Failure		403	{object}	Problem	"Sin permiso para esta operación"
+	//	@Failure		500	{object}	Problem	"Error interno del servidor"
+	//	@Router			/api-keys [get]
+	apiKeyGroup.GET("/", func(c *gin.Context) {
+		keys, err := endpoints.ListAPIKeys(c.Request.Context())
+		if err != nil {
+			writeAPIKeyError(c, logger, "GET", err)
+			return
+		}
+		c.JSON(http.StatusOK, keys)
+	})
+
+	//	@Summary		Revocar una API key
+	//	@Description	La clave deja de aceptarse de inmediato. Se conserva en el listado con su fecha de revocación
+	//	@Tags			API keys
+	//	@Produce		json
+	//	@Security		BearerAuth
+	//	@Security		ApiKeyAuth
+	//	@Param			id	path		string				true	"ID de la API key"
+	//	@Success		200	{object}	entities.APIKey		"API key revocada"
+	//	@Failure		401	{object}	Problem	"Credenciales faltantes o inválidas"
+	//	@Failure		404	{object}	Problem	"API key no encontrada"
+	//	@Failure		403	{object}	Problem	"Sin permiso para esta operación"
+	//	@Failure		500	{object}	Problem	"Error interno del servidor"
+	//	@Router			/api-keys/{id} [delete]
+	apiKeyGroup.DELETE("/:id", func(c *gin.Context) {
+		key, err := endpoints.RevokeAPIKey(c.Request.Context(), c.Param("id"))
+		if err != nil {
+			writeAPIKeyError(c, logger, "DELETE", err)
+			return
+		}
+		logging.For(c.Request.Context(), logger, "apikey_transports", "DELETE").WithField("apikey_id", key.ID).Info("API key revocada")
+		c.JSON(http.StatusOK, key)
+	})
+}
+
+// writeAPIKeyError deja err en el log y responde con su problem+json.
+func writeAPIKeyError(c *gin.Context, logger logrus.FieldLogger, method string, err error) {
+	logProblem(c, logger, "apikey_transports", method, err)
+	writeProblem(c, err)
+}
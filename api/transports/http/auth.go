@@ -0,0 +1,51 @@
+package transports
+
+import (
+	"errors"
+	"prueba_tecnica/api/auth"
+	"prueba_tecnica/api/logging"
+	"prueba_tecnica/api/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// Authenticate exige un JWT en Authorization: Bearer o una API key en
+// X-API-Key y guarda el principal en el contexto de la petición. Responde 401
+// si faltan o no son válidas.
+func Authenticate(authenticator auth.Authenticator, logger logrus.FieldLogger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token, err := auth.BearerToken(c.GetHeader("Authorization"))
+		if err != nil {
+			unauthorized(c, logger, err)
+			return
+		}
+		credentials := auth.Credentials{Token: token, APIKey: c.GetHeader("X-API-Key")}
+
+		principal, err := authenticator.Authenticate(c.Request.Context(), credentials)
+		if errors.Is(err, auth.ErrNoCredentials) || errors.Is(err, auth.ErrInvalidCredentials) {
+			unauthorized(c, logger, err)
+			return
+		}
+		if err != nil {
+			logging.For(c.Request.Context(), logger, "auth_transports", "Authenticate").WithError(err).Error("operación fallida")
+			writeProblem(c, err)
+			return
+		}
+
+		c.Request = c.Request.WithContext(service.WithPrincipal(c.Request.Context(), principal))
+		c.Next()
+	}
+}
+
+// unauthorized responde 401. El detalle de por qué no se aceptaron las
+// credenciales solo va al log.
+func unauthorized(c *gin.Context, logger logrus.FieldLogger, err error) {
+	logging.For(c.Request.Context(), logger, "auth_transports", "Authenticate").WithField("path", c.Request.URL.Path).WithError(err).Warn("credenciales rechazadas")
+	reported := auth.ErrInvalidCredentials
+	if errors.Is(err, auth.ErrNoCredentials) {
+		reported = auth.ErrNoCredentials
+	}
+	c.Header("WWW-Authenticate", `Bearer realm="events"`)
+	writeProblem(c, reported)
+}
@@ -0,0 +1,123 @@
+package transports
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"prueba_tecnica/api/auth"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/service"
+	"prueba_tecnica/api/tenant"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubAuthenticator acepta el token "good" y las API keys "evk_good" y
+// "evk_acme", esta última atada al tenant acme.
+type stubAuthenticator struct{}
+
+func (stubAuthenticator) Authenticate(ctx context.Context, credentials auth.Credentials) (entities.Principal, error) {
+	switch {
+	case credentials.Token == "good":
+		return entities.Principal{Subject: "ana", Method: entities.AuthMethodJWT}, nil
+	case credentials.APIKey == "evk_good":
+		return entities.Principal{Subject: "svc-ci", Method: entities.AuthMethodAPIKey}, nil
+	case credentials.APIKey == "evk_acme":
+		return entities.Principal{Subject: "svc-acme", Method: entities.AuthMethodAPIKey, Tenant: "acme"}, nil
+	case credentials.Token == "fail":
+		return entities.Principal{}, errors.New("mongo caído")
+	case credentials.Token != "" || credentials.APIKey != "":
+		return entities.Principal{}, auth.ErrInvalidCredentials
+	}
+	return entities.Principal{}, auth.ErrNoCredentials
+}
+
+func TestAuthenticate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/public", func(c *gin.Context) { c.String(http.StatusOK, "ok") })
+	router.Use(Authenticate(stubAuthenticator{}, logrus.New()))
+	router.GET("/private", func(c *gin.Context) {
+		c.String(http.StatusOK, service.ActorFromContext(c.Request.Context()))
+	})
+
+	testCases := []struct {
+		name         string
+		path         string
+		headers      map[string]string
+		expectedCode int
+		expectedBody string
+	}{
+		{name: "Public route", path: "/public", expectedCode: http.StatusOK, expectedBody: "ok"},
+		{name: "Bearer token", path: "/private", headers: map[string]string{"Authorization": "Bearer good"}, expectedCode: http.StatusOK, expectedBody: "ana"},
+		{name: "API key", path: "/private", headers: map[string]string{"X-API-Key": "evk_good"}, expectedCode: http.StatusOK, expectedBody: "svc-ci"},
+		{name: "Principal wins over X-Actor", path: "/private", headers: map[string]string{"X-API-Key": "evk_good", "X-Actor": "mallory"}, expectedCode: http.StatusOK, expectedBody: "svc-ci"},
+		{name: "No credentials", path: "/private", expectedCode: http.StatusUnauthorized},
+		{name: "Invalid token", path: "/private", headers: map[string]string{"Authorization": "Bearer bad"}, expectedCode: http.StatusUnauthorized},
+		{name: "Not a bearer", path: "/private", headers: map[string]string{"Authorization": "Basic dXNlcjpwYXNz"}, expectedCode: http.StatusUnauthorized},
+		{name: "Authenticator failure", path: "/private", headers: map[string]string{"Authorization": "Bearer fail"}, expectedCode: http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			for k, v := range tc.headers {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectedCode, rec.Code)
+			if tc.expectedBody != "" {
+				assert.Equal(t, tc.expectedBody, rec.Body.String())
+			}
+			if tc.expectedCode == http.StatusUnauthorized {
+				assert.NotEmpty(t, rec.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
+
+func TestResolveTenant(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(Authenticate(stubAuthenticator{}, logrus.New()), ResolveTenant(logrus.New()))
+	router.GET("/tenant", func(c *gin.Context) {
+		c.String(http.StatusOK, tenant.FromContext(c.Request.Context()))
+	})
+
+	testCases := []struct {
+		name         string
+		headers      map[string]string
+		expectedCode int
+		expectedBody string
+	}{
+		{name: "Default tenant", headers: map[string]string{"X-API-Key": "evk_good"}, expectedCode: http.StatusOK, expectedBody: tenant.Default},
+		{name: "Header", headers: map[string]string{"X-API-Key": "evk_good", "X-Tenant-ID": "globex"}, expectedCode: http.StatusOK, expectedBody: "globex"},
+		{name: "Principal tenant", headers: map[string]string{"X-API-Key": "evk_acme"}, expectedCode: http.StatusOK, expectedBody: "acme"},
+		{name: "Another tenant", headers: map[string]string{"X-API-Key": "evk_acme", "X-Tenant-ID": "globex"}, expectedCode: http.StatusForbidden},
+		{name: "Invalid tenant", headers: map[string]string{"X-API-Key": "evk_good", "X-Tenant-ID": "Globex Inc"}, expectedCode: http.StatusBadRequest},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/tenant", nil)
+			for k, v := range tc.headers {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectedCode, rec.Code)
+			if tc.expectedBody != "" {
+				assert.Equal(t, tc.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package transports
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"prueba_tecnica/api/apperr"
+	"prueba_tecnica/api/service"
+)
+
+// ndjsonContentType es el Content-Type de un lote con un elemento JSON por
+// línea. Con cualquier otro el lote debe ser un arreglo JSON.
+const ndjsonContentType = "application/x-ndjson"
+
+// decodeBatch lee los elementos de un lote. Deja de leer al pasar
+// service.MaxBatchSize elementos para no cargar lotes enormes en memoria.
+func decodeBatch[T any](body io.Reader, contentType string) ([]T, error) {
+	decoder := json.NewDecoder(body)
+	if contentType != ndjsonContentType {
+		start, err := decoder.Token()
+		if err != nil || start != json.Delim('[') {
+			return nil, errBatch
+		}
+	}
+
+	var items []T
+	for decoder.More() {
+		if len(items) == service.MaxBatchSize {
+			return nil, service.ErrBatchSize
+		}
+		var item T
+		if err := decoder.Decode(&item); err != nil {
+			return nil, errMalformed.WithFields(apperr.Violation(fmt.Sprintf("[%d]", len(items)), "validation.item"))
+		}
+		items = append(items, item)
+	}
+
+	if contentType != ndjsonContentType {
+		if _, err := decoder.Token(); err != nil {
+			return nil, errBatch
+		}
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, errBatchTrail
+	}
+	return items, nil
+}
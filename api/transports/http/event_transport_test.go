@@ -1 +1,120 @@
 package transports
+
+import (
+	"prueba_tecnica/api/apperr"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/service"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeMergePatch(t *testing.T) {
+	str := func(s string) *string { return &s }
+	status := func(s entities.Status) *entities.Status { return &s }
+	category := func(c entities.Category) *entities.Category { return &c }
+	no := false
+	date := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		body          string
+		expected      entities.EventPatch
+		expectedError error
+	}{
+		{
+			name:     "Only supplied fields",
+			body:     `{"name": "VPN", "status": "Revisado"}`,
+			expected: entities.EventPatch{Name: str("VPN"), Status: status(entities.StatusReviewed)},
+		},
+		{
+			name:     "Null removes optional fields",
+			body:     `{"category": null, "needs_action": null}`,
+			expected: entities.EventPatch{Category: category(""), NeedsAction: &no},
+		},
+		{
+			name:     "Date",
+			body:     `{"date": "2025-01-01T12:00:00Z"}`,
+			expected: entities.EventPatch{Date: &date},
+		},
+		{
+			name:     "Empty patch",
+			body:     `{}`,
+			expected: entities.EventPatch{},
+		},
+		{
+			name:          "Null on a required field",
+			body:          `{"name": null}`,
+			expectedError: errMalformed.WithFields(apperr.Violation("name", "validation.not_nullable")),
+		},
+		{
+			name:          "Read only field",
+			body:          `{"version": 3}`,
+			expectedError: errMalformed.WithFields(apperr.Violation("version", "validation.read_only")),
+		},
+		{
+			name:          "Wrong type",
+			body:          `{"needs_action": "si"}`,
+			expectedError: errMalformed.WithFields(apperr.Violation("needs_action", "validation.bool")),
+		},
+		{
+			name:          "Not an object",
+			body:          `["name"]`,
+			expectedError: errMergePatch,
+		},
+		{
+			name:          "Wrong type on a text field",
+			body:          `{"name": 3}`,
+			expectedError: errMalformed.WithFields(apperr.Violation("name", "validation.string")),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			patch, err := decodeMergePatch([]byte(tc.body))
+
+			if tc.expectedError != nil {
+				assert.Equal(t, tc.expectedError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, patch)
+		})
+	}
+}
+
+func TestDecodeBatch(t *testing.T) {
+	testCases := []struct {
+		name          string
+		body          string
+		contentType   string
+		expected      []string
+		expectedError error
+	}{
+		{name: "JSON array", body: ` ["a", "b"] `, contentType: "application/json", expected: []string{"a", "b"}},
+		{name: "Empty array", body: `[]`, contentType: "application/json"},
+		{name: "NDJSON", body: "\"a\"\n\"b\"\n", contentType: ndjsonContentType, expected: []string{"a", "b"}},
+		{name: "Object instead of array", body: `{"ids": ["a"]}`, contentType: "application/json", expectedError: errBatch},
+		{name: "NDJSON without its Content-Type", body: "\"a\"\n\"b\"", contentType: "application/json", expectedError: errBatch},
+		{name: "Wrong item type", body: `["a", 2]`, contentType: "application/json", expectedError: errMalformed.WithFields(apperr.Violation("[1]", "validation.item"))},
+		{name: "Unclosed array", body: `["a"`, contentType: "application/json", expectedError: errMalformed.WithFields(apperr.Violation("[1]", "validation.item"))},
+		{name: "Trailing data", body: `["a"] ["b"]`, contentType: "application/json", expectedError: errBatchTrail},
+		{name: "Too many items", body: strings.Repeat("\"a\"\n", service.MaxBatchSize+1), contentType: ndjsonContentType, expectedError: service.ErrBatchSize},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			items, err := decodeBatch[string](strings.NewReader(tc.body), tc.contentType)
+
+			if tc.expectedError != nil {
+				assert.Equal(t, tc.expectedError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, items)
+		})
+	}
+}
@@ -1,16 +1,25 @@
 package transports
 
 import (
-	"context"
+	"io"
 	"net/http"
+	"prueba_tecnica/api/apperr"
 	"prueba_tecnica/api/endpoints"
 	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/logging"
 	"prueba_tecnica/api/service"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 )
 
+// sseHeartbeat es cada cuánto se envía un comentario por el feed de cambios
+// cuando no hay cambios.
+const sseHeartbeat = 15 * time.Second
+
 // @title			API Prueba tecnica Gestión de Eventos
 // @version		1.0
 // @description	API para la gestión y clasificación de eventos
@@ -18,6 +27,7 @@ import (
 // @BasePath		/api/v1
 func NewEventRouter(router *gin.Engine, endpoints endpoints.EventEndpoints, logger logrus.FieldLogger) {
 	eventGroup := router.Group("/api/v1/events")
+	eventGroup.Use(auditContext())
 
 	//	@Summary		Crear un nuevo evento
 	//	@Description	Crea un nuevo evento en el sistema
@@ -26,36 +36,117 @@ func NewEventRouter(router *gin.Engine, endpoints endpoints.EventEndpoints, logg
 	//	@Produce		json
 	//	@Param			event	body		entities.Event		true	"Datos del Evento"
 	//	@Success		201		{object}	map[string]string	"ID del evento creado"
-	//	@Failure		400		{object}	map[string]string	"Error en los datos de entrada"
-	//	@Failure		500		{object}	map[string]string	"Error interno del servidor"
+	//	@Failure		400		{object}	Problem	"Error en los datos de entrada"
+	//	@Failure		403		{object}	Problem	"Sin permiso para esta operación"
+	//	@Failure		500		{object}	Problem	"Error interno del servidor"
 	//	@Router			/events [post]
 	eventGroup.POST("/", func(c *gin.Context) {
 		var event entities.Event
 		if err := c.ShouldBindJSON(&event); err != nil {
-			logger.Errorln("Layer:event_transports", "Method: Post", "Error:", err)
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			writeEventError(c, logger, "Post", malformed(err))
 			return
 		}
-		transportEvent, err := endpoints.CreateEvent(context.Background(), event)
+		transportEvent, err := endpoints.CreateEvent(c.Request.Context(), event)
+		if err != nil {
+			writeEventError(c, logger, "Post", err)
+			return
+		}
+
+		logging.For(c.Request.Context(), logger, "event_transports", "POST").WithField(logging.FieldEventID, transportEvent.ID).Debug("evento creado")
+		c.JSON(http.StatusCreated, gin.H{"id": transportEvent.ID})
+	})
 
-		if err == service.ErrStatus {
-			logger.Errorln("Layer:event_transports", "Method: Post", "Error:", err)
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	//	@Summary		Crear eventos por lotes
+	//	@Description	Crea hasta 1000 eventos. Cada evento se valida por separado y el resultado indica cuáles se crearon
+	//	@Tags			Eventos
+	//	@Accept			json,application/x-ndjson
+	//	@Produce		json
+	//	@Param			events	body		[]entities.Event		true	"Arreglo JSON o NDJSON de eventos"
+	//	@Success		200		{object}	entities.BulkResponse	"Resultado por evento"
+	//	@Failure		400		{object}	Problem		"Lote inválido"
+	//	@Failure		403		{object}	Problem		"Sin permiso para esta operación"
+	//	@Failure		500		{object}	Problem		"Error interno del servidor"
+	//	@Router			/events/batch [post]
+	eventGroup.POST("/batch", func(c *gin.Context) {
+		events, err := decodeBatch[entities.Event](c.Request.Body, c.ContentType())
+		if err != nil {
+			writeEventError(c, logger, "Post batch", malformed(err))
 			return
 		}
-		if err == service.ErrValidation {
-			logger.Errorln("Layer:event_transports", "Method: Post", "Error:", err)
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		results, err := endpoints.CreateEvents(c.Request.Context(), events)
+		writeBatch(c, logger, "Post batch", results, err)
+	})
+
+	//	@Summary		Actualizar eventos por lotes
+	//	@Description	Actualiza hasta 1000 eventos con las mismas reglas que PUT /events/{id}. La versión de cada evento, si se envía, se usa como If-Match
+	//	@Tags			Eventos
+	//	@Accept			json,application/x-ndjson
+	//	@Produce		json
+	//	@Param			events	body		[]entities.Event		true	"Arreglo JSON o NDJSON de eventos con su id"
+	//	@Success		200		{object}	entities.BulkResponse	"Resultado por evento"
+	//	@Failure		400		{object}	Problem		"Lote inválido"
+	//	@Failure		403		{object}	Problem		"Sin permiso para esta operación"
+	//	@Failure		500		{object}	Problem		"Error interno del servidor"
+	//	@Router			/events/batch [put]
+	eventGroup.PUT("/batch", func(c *gin.Context) {
+		events, err := decodeBatch[entities.Event](c.Request.Body, c.ContentType())
+		if err != nil {
+			writeEventError(c, logger, "PUT batch", malformed(err))
 			return
 		}
+		results, err := endpoints.UpdateEvents(c.Request.Context(), events)
+		writeBatch(c, logger, "PUT batch", results, err)
+	})
+
+	//	@Summary		Clasificar eventos por lotes
+	//	@Description	Clasifica automáticamente hasta 1000 eventos revisados
+	//	@Tags			Clasificación
+	//	@Accept			json,application/x-ndjson
+	//	@Produce		json
+	//	@Param			ids	body		[]string				true	"Arreglo JSON o NDJSON de ids"
+	//	@Success		200	{object}	entities.BulkResponse	"Resultado por evento"
+	//	@Failure		400	{object}	Problem		"Lote inválido"
+	//	@Failure		403	{object}	Problem		"Sin permiso para esta operación"
+	//	@Failure		500	{object}	Problem		"Error interno del servidor"
+	//	@Router			/events/batch/classify [post]
+	eventGroup.POST("/batch/classify", func(c *gin.Context) {
+		ids, err := decodeBatch[string](c.Request.Body, c.ContentType())
 		if err != nil {
-			logger.Errorln("Layer:event_transports", "Method: Post", "Error:", err)
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			writeEventError(c, logger, "Post batch classify", malformed(err))
 			return
 		}
+		results, err := endpoints.ClassifyEvents(c.Request.Context(), ids)
+		writeBatch(c, logger, "Post batch classify", results, err)
+	})
 
-		logger.Infoln("Layer:event_transports", "Method: Post", "Event:", transportEvent.ID)
-		c.JSON(http.StatusCreated, gin.H{"id": transportEvent.ID})
+	//	@Summary		Buscar eventos por texto
+	//	@Description	Busca el texto en el nombre y la descripción de los eventos y los devuelve del más al menos relevante. Las palabras sueltas alcanzan con que aparezca una, las frases entre comillas deben aparecer y las palabras con "-" delante excluyen el evento. Cada resultado trae los campos con coincidencias resaltadas entre <em> y </em>
+	//	@Tags			Eventos
+	//	@Produce		json
+	//	@Param			q			query		string					true	"Texto a buscar (máximo 256 caracteres)"
+	//	@Param			status		query		string					false	"Estado del evento"
+	//	@Param			category	query		string					false	"Categoría del evento"
+	//	@Param			from		query		string					false	"Fecha mínima, inclusiva (RFC 3339)"
+	//	@Param			to			query		string					false	"Fecha máxima, exclusiva (RFC 3339)"
+	//	@Param			limit		query		int						false	"Cantidad máxima de resultados (por defecto 50, máximo 500)"
+	//	@Param			cursor		query		string					false	"Cursor next_cursor de la página anterior"
+	//	@Success		200			{object}	entities.SearchPage	"Resultados por relevancia"
+	//	@Failure		400			{object}	Problem	"Parámetros de búsqueda inválidos"
+	//	@Failure		403			{object}	Problem	"Sin permiso para esta operación"
+	//	@Failure		500			{object}	Problem	"Error interno del servidor"
+	//	@Router			/events/search [get]
+	eventGroup.GET("/search", func(c *gin.Context) {
+		query, ok := bindSearch(c, logger)
+		if !ok {
+			return
+		}
+		results, err := endpoints.SearchEvents(c.Request.Context(), query)
+		if err != nil {
+			writeEventError(c, logger, "GET search", err)
+			return
+		}
+		logging.For(c.Request.Context(), logger, "event_transports", "GET search").WithField("count", len(results.Hits)).Debug("eventos encontrados")
+		c.JSON(http.StatusOK, results)
 	})
 
 	//	@Summary		Obtener un evento por ID
@@ -64,107 +155,239 @@ func NewEventRouter(router *gin.Engine, endpoints endpoints.EventEndpoints, logg
 	//	@Produce		json
 	//	@Param			id	path		string				true	"ID del Evento"
 	//	@Success		200	{object}	entities.Event		"Evento encontrado"
-	//	@Failure		404	{object}	map[string]string	"Evento no encontrado"
-	//	@Failure		500	{object}	map[string]string	"Error interno del servidor"
+	//	@Header			200	{string}	ETag				"Versión del evento, para usar en If-Match"
+	//	@Failure		404	{object}	Problem	"Evento no encontrado"
+	//	@Failure		403	{object}	Problem	"Sin permiso para esta operación"
+	//	@Failure		500	{object}	Problem	"Error interno del servidor"
 	//	@Router			/events/{id} [get]
 	eventGroup.GET("/:id", func(c *gin.Context) {
 		id := c.Param("id")
 		event, err := endpoints.GetEventByID(c.Request.Context(), id)
 		if err != nil {
-			logger.Errorln("Layer:event_transports", "Method: GET", "Error:", err)
-			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			writeEventError(c, logger, "GET", err)
 			return
 		}
-		logger.Infoln("Layer:event_transports", "Method: GET", "Event:", event)
+		logging.For(c.Request.Context(), logger, "event_transports", "GET").WithField(logging.FieldEventID, event.ID).Debug("evento obtenido")
+		setETag(c, event.Version)
 		c.JSON(http.StatusOK, event)
 	})
 
-	//	@Summary		Listar todos los eventos
-	//	@Description	Obtiene una lista de todos los eventos registrados
+	//	@Summary		Listar eventos
+	//	@Description	Obtiene una página de eventos. Los filtros se pueden combinar; sin filtros devuelve todos los eventos del más reciente al más antiguo
 	//	@Tags			Eventos
 	//	@Produce		json
-	//	@Success		200	{array}		entities.Event		"Lista de eventos"
-	//	@Failure		500	{object}	map[string]string	"Error interno del servidor"
+	//	@Param			status			query		string				false	"Estado del evento"
+	//	@Param			category		query		string				false	"Categoría del evento"
+	//	@Param			type			query		string				false	"Tipo del evento"
+	//	@Param			needs_action	query		bool				false	"Si requiere gestión"
+	//	@Param			from			query		string				false	"Fecha mínima, inclusiva (RFC 3339)"
+	//	@Param			to				query		string				false	"Fecha máxima, exclusiva (RFC 3339)"
+	//	@Param			name			query		string				false	"Texto contenido en el nombre"
+	//	@Param			description		query		string				false	"Texto contenido en la descripción"
+	//	@Param			sort			query		string				false	"Campo de ordenamiento (date, name, type, status)"
+	//	@Param			order			query		string				false	"Dirección del ordenamiento (asc, desc)"
+	//	@Param			limit			query		int					false	"Cantidad máxima de eventos (por defecto 50, máximo 500)"
+	//	@Param			include_deleted	query		bool				false	"Incluir los eventos eliminados que aún no se purgan"
+	//	@Param			cursor			query		string				false	"Cursor next_cursor de la página anterior"
+	//	@Success		200				{object}	entities.EventPage	"Página de eventos"
+	//	@Failure		400				{object}	Problem	"Parámetros de consulta inválidos"
+	//	@Failure		403				{object}	Problem	"Sin permiso para esta operación"
+	//	@Failure		500				{object}	Problem	"Error interno del servidor"
 	//	@Router			/events [get]
 	eventGroup.GET("/", func(c *gin.Context) {
-		events, err := endpoints.GetAllEvents(c.Request.Context())
+		query, ok := bindQuery(c, logger)
+		if !ok {
+			return
+		}
+		events, err := endpoints.ListEvents(c.Request.Context(), query)
 		if err != nil {
-			logger.Errorln("Layer:event_transports", "Method: GET", "Error:", err)
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Error al obtener eventos: " + err.Error()})
+			writeEventError(c, logger, "GET", err)
 			return
 		}
-		logger.Infoln("Layer:event_transports", "Method: GET", "Eventos: obtenidos correctamente")
+		logging.For(c.Request.Context(), logger, "event_transports", "GET").Debug("eventos obtenidos")
 		c.JSON(http.StatusOK, events)
 	})
 
+	//	@Summary		Feed de cambios de eventos
+	//	@Description	Envía por Server-Sent Events los eventos creados, actualizados, clasificados, eliminados y restaurados a medida que ocurren. El nombre de cada mensaje es el tipo de cambio. Un evento se envía si cumple los filtros antes o después del cambio
+	//	@Tags			Eventos
+	//	@Produce		text/event-stream
+	//	@Param			status			query		string					false	"Estado del evento"
+	//	@Param			category		query		string					false	"Categoría del evento"
+	//	@Param			type			query		string					false	"Tipo del evento"
+	//	@Param			needs_action	query		bool					false	"Si requiere gestión"
+	//	@Success		200				{object}	entities.EventChange	"Stream de cambios"
+	//	@Failure		400				{object}	Problem		"Parámetros de consulta inválidos"
+	//	@Failure		403				{object}	Problem		"Sin permiso para esta operación"
+	//	@Failure		503				{object}	Problem		"Feed de cambios deshabilitado"
+	//	@Router			/events/stream [get]
+	eventGroup.GET("/stream", func(c *gin.Context) {
+		filter, ok := bindChangeFilter(c, logger)
+		if !ok {
+			return
+		}
+		ctx := c.Request.Context()
+		feed, err := endpoints.WatchEvents(ctx, filter)
+		if err != nil {
+			writeEventError(c, logger, "GET stream", err)
+			return
+		}
+
+		logging.For(c.Request.Context(), logger, "event_transports", "GET stream").Info("suscriptor conectado")
+		c.Header("Cache-Control", "no-cache")
+		c.Header("X-Accel-Buffering", "no")
+		heartbeat := time.NewTicker(sseHeartbeat)
+		defer heartbeat.Stop()
+		c.Stream(func(w io.Writer) bool {
+			select {
+			case change, ok := <-feed:
+				if !ok {
+					return false
+				}
+				c.SSEvent(change.Type, change)
+				return true
+			case <-heartbeat.C:
+				// Un comentario SSE mantiene abierta la conexión en los proxies.
+				_, err := io.WriteString(w, ": ping\n\n")
+				return err == nil
+			case <-ctx.Done():
+				return false
+			}
+		})
+		logging.For(c.Request.Context(), logger, "event_transports", "GET stream").Info("suscriptor desconectado")
+	})
+
 	//	@Summary		Actualizar un evento
-	//	@Description	Actualiza los datos de un evento existente
+	//	@Description	Actualiza los datos de un evento existente. Con If-Match (el ETag de la última lectura) solo se actualiza si nadie lo modificó desde entonces
 	//	@Tags			Eventos
 	//	@Accept			json
 	//	@Produce		json
-	//	@Param			id		path		string				true	"ID del Evento"
-	//	@Param			event	body		entities.Event		true	"Datos actualizados del Evento"
-	//	@Success		200		{object}	entities.Event		"Evento actualizado"
-	//	@Failure		400		{object}	map[string]string	"Error en los datos de entrada"
-	//	@Failure		404		{object}	map[string]string	"Evento no encontrado"
-	//	@Failure		500		{object}	map[string]string	"Error interno del servidor"
+	//	@Param			id			path		string				true	"ID del Evento"
+	//	@Param			If-Match	header		string				false	"ETag de la versión que se quiere modificar"
+	//	@Param			event		body		entities.Event		true	"Datos actualizados del Evento"
+	//	@Success		200			{object}	entities.Event		"Evento actualizado"
+	//	@Header			200			{string}	ETag				"Nueva versión del evento"
+	//	@Failure		400			{object}	Problem	"Error en los datos de entrada"
+	//	@Failure		404			{object}	Problem	"Evento no encontrado"
+	//	@Failure		409			{object}	Problem	"El evento cambió desde la versión indicada"
+	//	@Failure		403		{object}	Problem	"Sin permiso para esta operación"
+	//	@Failure		500		{object}	Problem	"Error interno del servidor"
 	//	@Router			/events/{id} [put]
 	eventGroup.PUT("/:id", func(c *gin.Context) {
 		id := c.Param("id")
 		var event entities.Event
 		if err := c.ShouldBindJSON(&event); err != nil {
-			logger.Errorln("Layer:event_transports", "Method: PUT", "Error:", err)
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Datos del evento inválidos: " + err.Error()})
+			writeEventError(c, logger, "PUT", malformed(err))
 			return
 		}
 		event.ID = id
-		_, err := endpoints.UpdateEvent(c.Request.Context(), event)
-
-		if err == service.ErrValidation {
-			logger.Errorln("Layer:event_transports", "Method: PUT", "Error:", err)
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		if version, ok, err := ifMatchVersion(c); err != nil {
+			writeEventError(c, logger, "PUT", err)
 			return
+		} else if ok {
+			event.Version = version
 		}
-
-		if err == service.ErrStatus {
-			logger.Errorln("Layer:event_transports", "Method: PUT", "Error:", err)
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		updated, err := endpoints.UpdateEvent(c.Request.Context(), event)
+		if err != nil {
+			writeEventError(c, logger, "PUT", err)
 			return
 		}
+		logging.For(c.Request.Context(), logger, "event_transports", "PUT").WithField(logging.FieldEventID, updated.ID).Debug("evento actualizado")
+		setETag(c, updated.Version)
+		c.JSON(http.StatusOK, updated)
+	})
 
-		if err == service.ErrEventNotfound {
-			logger.Errorln("Layer:event_transports", "Method: PUT", "Error:", err)
-			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	//	@Summary		Actualizar parcialmente un evento
+	//	@Description	Aplica un JSON Merge Patch (RFC 7396): solo cambian los campos enviados y category en null quita la categoría. Un cambio de status debe ser una transición válida. Si cambia type o status se vuelve a clasificar el evento
+	//	@Tags			Eventos
+	//	@Accept			application/merge-patch+json
+	//	@Produce		json
+	//	@Param			id			path		string				true	"ID del Evento"
+	//	@Param			If-Match	header		string				false	"ETag de la versión que se quiere modificar"
+	//	@Param			patch		body		entities.EventPatch	true	"Campos a modificar"
+	//	@Success		200			{object}	entities.Event		"Evento actualizado"
+	//	@Header			200			{string}	ETag				"Nueva versión del evento"
+	//	@Failure		400			{object}	Problem	"Error en los datos de entrada"
+	//	@Failure		404			{object}	Problem	"Evento no encontrado"
+	//	@Failure		409			{object}	Problem	"Transición no permitida o evento modificado por otra operación"
+	//	@Failure		415			{object}	Problem	"Content-Type no soportado"
+	//	@Failure		403			{object}	Problem	"Sin permiso para esta operación"
+	//	@Failure		500			{object}	Problem	"Error interno del servidor"
+	//	@Router			/events/{id} [patch]
+	eventGroup.PATCH("/:id", func(c *gin.Context) {
+		id := c.Param("id")
+		if ct := c.ContentType(); ct != "application/merge-patch+json" && ct != "application/json" {
+			writeEventError(c, logger, "PATCH", errMediaType)
+			return
+		}
+		body, err := c.GetRawData()
+		if err != nil {
+			writeEventError(c, logger, "PATCH", malformed(err))
+			return
+		}
+		patch, err := decodeMergePatch(body)
+		if err != nil {
+			writeEventError(c, logger, "PATCH", malformed(err))
+			return
+		}
+		if version, ok, err := ifMatchVersion(c); err != nil {
+			writeEventError(c, logger, "PATCH", err)
 			return
+		} else if ok {
+			patch.Version = version
 		}
 
+		event, err := endpoints.PatchEvent(c.Request.Context(), id, patch)
 		if err != nil {
-			logger.Errorln("Layer:event_transports", "Method: PUT", "Error:", err)
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			writeEventError(c, logger, "PATCH", err)
 			return
 		}
-		logger.Infoln("Layer:event_transports", "Method: PUT", "Eventos: Actualizado correctamente")
+		logging.For(c.Request.Context(), logger, "event_transports", "PATCH").WithField(logging.FieldEventID, event.ID).Debug("evento actualizado parcialmente")
+		setETag(c, event.Version)
 		c.JSON(http.StatusOK, event)
 	})
 
 	//	@Summary		Eliminar un evento
-	//	@Description	Elimina un evento existente del sistema
+	//	@Description	Marca un evento como eliminado. Se puede restaurar hasta que se purgue al vencer el periodo de retención
 	//	@Tags			Eventos
 	//	@Produce		json
 	//	@Param			id	path		string				true	"ID del Evento"
 	//	@Success		200	{object}	map[string]string	"Mensaje de confirmación"
-	//	@Failure		400	{object}	map[string]string	"Error en la solicitud"
-	//	@Failure		500	{object}	map[string]string	"Error interno del servidor"
+	//	@Failure		400	{object}	Problem	"Error en la solicitud"
+	//	@Failure		403	{object}	Problem	"Sin permiso para esta operación"
+	//	@Failure		500	{object}	Problem	"Error interno del servidor"
 	//	@Router			/events/{id} [delete]
 	eventGroup.DELETE("/:id", func(c *gin.Context) {
 		id := c.Param("id")
 		if err := endpoints.DeleteEvent(c.Request.Context(), id); err != nil {
-			logger.Errorln("Layer:event_transports", "Method: DELETE", "Error:", err)
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			writeEventError(c, logger, "DELETE", err)
+			return
+		}
+		logging.For(c.Request.Context(), logger, "event_transports", "DELETE").WithField(logging.FieldEventID, id).Debug("evento eliminado")
+		writeMessage(c, "event.deleted")
+	})
+
+	//	@Summary		Restaurar un evento eliminado
+	//	@Description	Quita la marca de eliminado de un evento que aún no se ha purgado
+	//	@Tags			Eventos
+	//	@Produce		json
+	//	@Param			id	path		string				true	"ID del Evento"
+	//	@Success		200	{object}	entities.Event		"Evento restaurado"
+	//	@Failure		404	{object}	Problem	"Evento no encontrado o ya purgado"
+	//	@Failure		409	{object}	Problem	"El evento no está eliminado"
+	//	@Failure		403	{object}	Problem	"Sin permiso para esta operación"
+	//	@Failure		500	{object}	Problem	"Error interno del servidor"
+	//	@Router			/events/{id}/restore [post]
+	eventGroup.POST("/:id/restore", func(c *gin.Context) {
+		id := c.Param("id")
+		event, err := endpoints.RestoreEvent(c.Request.Context(), id)
+		if err != nil {
+			writeEventError(c, logger, "POST", err)
 			return
 		}
-		logger.Infoln("Layer:event_transports", "Method: DELETE", "Evento: Eliminado correctamente")
-		c.JSON(http.StatusOK, gin.H{"message": "Evento eliminado correctamente"})
+		logging.For(c.Request.Context(), logger, "event_transports", "POST").WithField(logging.FieldEventID, event.ID).Debug("evento restaurado")
+		setETag(c, event.Version)
+		c.JSON(http.StatusOK, event)
 	})
 
 	//	@Summary		Clasificar evento automáticamente
@@ -173,18 +396,19 @@ func NewEventRouter(router *gin.Engine, endpoints endpoints.EventEndpoints, logg
 	//	@Produce		json
 	//	@Param			id	path		string				true	"ID del Evento"
 	//	@Success		200	{object}	map[string]string	"Mensaje de confirmación"
-	//	@Failure		400	{object}	map[string]string	"Error en la solicitud"
-	//	@Failure		500	{object}	map[string]string	"Error interno del servidor"
+	//	@Failure		400	{object}	Problem	"Error en la solicitud"
+	//	@Failure		409	{object}	Problem	"Evento modificado por otra operación"
+	//	@Failure		403	{object}	Problem	"Sin permiso para esta operación"
+	//	@Failure		500	{object}	Problem	"Error interno del servidor"
 	//	@Router			/events/{id}/classify [put]
 	eventGroup.PUT("/:id/classify", func(c *gin.Context) {
 		id := c.Param("id")
 		if _, err := endpoints.ClassifyEvent(c.Request.Context(), id); err != nil {
-			logger.Errorln("Layer:event_transports", "Method: PUT", "Error:", err)
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Error al clasificar evento: " + err.Error()})
+			writeEventError(c, logger, "PUT", err)
 			return
 		}
-		logger.Infoln("Layer:event_transports", "Method: PUT", "Evento:clasificado automáticamente según su tipo")
-		c.JSON(http.StatusOK, gin.H{"message": "Evento clasificado automáticamente según su tipo"})
+		logging.For(c.Request.Context(), logger, "event_transports", "PUT").WithField(logging.FieldEventID, id).Debug("evento clasificado automáticamente")
+		writeMessage(c, "event.classified")
 	})
 
 	//	@Summary		Clasificar evento manualmente
@@ -193,48 +417,110 @@ func NewEventRouter(router *gin.Engine, endpoints endpoints.EventEndpoints, logg
 	//	@Accept			json
 	//	@Produce		json
 	//	@Param			id			path		string				true	"ID del Evento"
-	//	@Param			category	body		string				true	"Categoría ('Requiere gestión' o 'Sin gestión')"
+	//	@Param			category	body		string				true	"Categoría de la taxonomía (GET /taxonomy)"
 	//	@Success		200			{object}	map[string]string	"Mensaje de confirmación"
-	//	@Failure		400			{object}	map[string]string	"Error en la solicitud"
-	//	@Failure		500			{object}	map[string]string	"Error interno del servidor"
+	//	@Failure		400			{object}	Problem	"Error en la solicitud"
+	//	@Failure		409			{object}	Problem	"Evento modificado por otra operación"
+	//	@Failure		403			{object}	Problem	"Sin permiso para esta operación"
+	//	@Failure		500			{object}	Problem	"Error interno del servidor"
 	//	@Router			/events/{id}/manual-classify [put]
 	eventGroup.PUT("/:id/manual-classify", func(c *gin.Context) {
 		id := c.Param("id")
 		var request struct {
-			Category string `json:"category" binding:"required,oneof='Requiere gestión' 'Sin gestión'"`
+			Category entities.Category `json:"category" binding:"required,category"`
 		}
 		if err := c.ShouldBindJSON(&request); err != nil {
-			logger.Errorln("Layer:event_transports", "Method: PUT", "Error:", err)
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Categoría inválida: " + err.Error()})
+			writeEventError(c, logger, "PUT", malformed(err))
 			return
 		}
 		if _, err := endpoints.ManualClassifyEvent(c.Request.Context(), id, request.Category); err != nil {
-			logger.Errorln("Layer:event_transports", "Method: PUT", "Error:", err)
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Error en clasificación manual: " + err.Error()})
+			writeEventError(c, logger, "PUT", err)
+			return
+		}
+		logging.For(c.Request.Context(), logger, "event_transports", "PUT").WithField(logging.FieldEventID, id).Debug("evento clasificado manualmente")
+		writeMessage(c, "event.classified_manually")
+	})
+
+	//	@Summary		Cambiar el estado de un evento
+	//	@Description	Aplica una transición del ciclo de vida definido en la taxonomía (por defecto Pendiente por revisar → En revisión → Revisado → Cerrado/Reabierto) y la guarda en el historial del evento
+	//	@Tags			Eventos
+	//	@Accept			json
+	//	@Produce		json
+	//	@Param			id			path		string				true	"ID del Evento"
+	//	@Param			transition	body		transitionRequest	true	"Estado destino, actor y motivo"
+	//	@Success		200			{object}	entities.Event		"Evento con el nuevo estado"
+	//	@Failure		400			{object}	Problem	"Error en los datos de entrada"
+	//	@Failure		404			{object}	Problem	"Evento no encontrado"
+	//	@Failure		409			{object}	Problem	"Transición no permitida desde el estado actual o evento modificado por otra operación"
+	//	@Failure		403			{object}	Problem	"Sin permiso para esta operación"
+	//	@Failure		500			{object}	Problem	"Error interno del servidor"
+	//	@Router			/events/{id}/transitions [post]
+	eventGroup.POST("/:id/transitions", func(c *gin.Context) {
+		id := c.Param("id")
+		var request transitionRequest
+		if err := c.ShouldBindJSON(&request); err != nil {
+			writeEventError(c, logger, "POST", malformed(err))
+			return
+		}
+		event, err := endpoints.TransitionEvent(c.Request.Context(), id, entities.StatusTransition{
+			To:     request.To,
+			Actor:  request.Actor,
+			Reason: request.Reason,
+		})
+		if err != nil {
+			writeEventError(c, logger, "POST", err)
 			return
 		}
-		logger.Infoln("Layer:event_transports", "Method: PUT", "Evento:clasificado manualmente")
-		c.JSON(http.StatusOK, gin.H{"message": "Evento clasificado manualmente"})
+		logging.For(c.Request.Context(), logger, "event_transports", "POST").WithFields(logrus.Fields{logging.FieldEventID: event.ID, "status": event.Status}).Debug("estado del evento cambiado")
+		setETag(c, event.Version)
+		c.JSON(http.StatusOK, event)
+	})
+
+	//	@Summary		Historial de un evento
+	//	@Description	Devuelve los cambios registrados de un evento (creación, actualización, clasificación, transiciones y eliminación) del más antiguo al más reciente, aunque el evento ya se haya eliminado
+	//	@Tags			Eventos
+	//	@Produce		json
+	//	@Param			id	path		string					true	"ID del Evento"
+	//	@Success		200	{array}		entities.AuditEntry		"Historial del evento"
+	//	@Failure		404	{object}	Problem		"Evento no encontrado"
+	//	@Failure		403	{object}	Problem		"Sin permiso para esta operación"
+	//	@Failure		500	{object}	Problem		"Error interno del servidor"
+	//	@Router			/events/{id}/history [get]
+	eventGroup.GET("/:id/history", func(c *gin.Context) {
+		id := c.Param("id")
+		history, err := endpoints.GetEventHistory(c.Request.Context(), id)
+		if err != nil {
+			writeEventError(c, logger, "GET", err)
+			return
+		}
+		logging.For(c.Request.Context(), logger, "event_transports", "GET").WithField(logging.FieldEventID, id).Debug("historial obtenido")
+		c.JSON(http.StatusOK, history)
 	})
 
 	//	@Summary		Filtrar eventos por estado
 	//	@Description	Obtiene una lista de eventos filtrados por estado
 	//	@Tags			Consultas
 	//	@Produce		json
-	//	@Param			status	path		string				true	"Estado del evento (Pendiente/Revisado)"
-	//	@Success		200		{array}		entities.Event		"Lista de eventos filtrados"
-	//	@Failure		400		{object}	map[string]string	"Error en la solicitud"
-	//	@Failure		500		{object}	map[string]string	"Error interno del servidor"
+	//	@Param			status	path		string				true	"Estado del evento, uno de los de la taxonomía (GET /taxonomy)"
+	//	@Param			limit	query		int					false	"Cantidad máxima de eventos (por defecto 50, máximo 500)"
+	//	@Param			cursor	query		string				false	"Cursor next_cursor de la página anterior"
+	//	@Success		200		{object}	entities.EventPage	"Página de eventos filtrados"
+	//	@Failure		400		{object}	Problem	"Error en la solicitud"
+	//	@Failure		403		{object}	Problem	"Sin permiso para esta operación"
+	//	@Failure		500		{object}	Problem	"Error interno del servidor"
 	//	@Router			/events/status/{status} [get]
 	eventGroup.GET("/status/:status", func(c *gin.Context) {
-		status := c.Param("status")
-		events, err := endpoints.GetEventsByStatus(c.Request.Context(), status)
+		status := entities.Status(c.Param("status"))
+		page, ok := bindPage(c, logger)
+		if !ok {
+			return
+		}
+		events, err := endpoints.GetEventsByStatus(c.Request.Context(), status, page)
 		if err != nil {
-			logger.Errorln("Layer:event_transports", "Method: GET", "Error:", err)
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Error al filtrar por estado: " + err.Error()})
+			writeEventError(c, logger, "GET", err)
 			return
 		}
-		logger.Infoln("Layer:event_transports", "Method: GET", "Eventos por estado obtenidos correctamente")
+		logging.For(c.Request.Context(), logger, "event_transports", "GET").Debug("eventos por estado obtenidos")
 		c.JSON(http.StatusOK, events)
 	})
 
@@ -243,19 +529,25 @@ func NewEventRouter(router *gin.Engine, endpoints endpoints.EventEndpoints, logg
 	//	@Tags			Consultas
 	//	@Produce		json
 	//	@Param			category	path		string				true	"Categoría del evento"
-	//	@Success		200			{array}		entities.Event		"Lista de eventos filtrados"
-	//	@Failure		400			{object}	map[string]string	"Error en la solicitud"
-	//	@Failure		500			{object}	map[string]string	"Error interno del servidor"
+	//	@Param			limit		query		int					false	"Cantidad máxima de eventos (por defecto 50, máximo 500)"
+	//	@Param			cursor		query		string				false	"Cursor next_cursor de la página anterior"
+	//	@Success		200			{object}	entities.EventPage	"Página de eventos filtrados"
+	//	@Failure		400			{object}	Problem	"Error en la solicitud"
+	//	@Failure		403			{object}	Problem	"Sin permiso para esta operación"
+	//	@Failure		500			{object}	Problem	"Error interno del servidor"
 	//	@Router			/events/category/{category} [get]
 	eventGroup.GET("/category/:category", func(c *gin.Context) {
-		category := c.Param("category")
-		events, err := endpoints.GetEventsByCategory(c.Request.Context(), category)
+		category := entities.Category(c.Param("category"))
+		page, ok := bindPage(c, logger)
+		if !ok {
+			return
+		}
+		events, err := endpoints.GetEventsByCategory(c.Request.Context(), category, page)
 		if err != nil {
-			logger.Errorln("Layer:event_transports", "Method: GET", "Error:", err)
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Error al filtrar por categoría: " + err.Error()})
+			writeEventError(c, logger, "GET", err)
 			return
 		}
-		logger.Infoln("Layer:event_transports", "Method: GET", "Eventos por categoria obtenidos correctamente")
+		logging.For(c.Request.Context(), logger, "event_transports", "GET").Debug("eventos por categoría obtenidos")
 		c.JSON(http.StatusOK, events)
 	})
 
@@ -264,17 +556,220 @@ func NewEventRouter(router *gin.Engine, endpoints endpoints.EventEndpoints, logg
 	//	@Description	Obtiene una lista de eventos marcados como que requieren gestión
 	//	@Tags			Consultas
 	//	@Produce		json
-	//	@Success		200	{array}		entities.Event		"Lista de eventos que requieren gestión"
-	//	@Failure		500	{object}	map[string]string	"Error interno del servidor"
+	//	@Param			limit	query		int					false	"Cantidad máxima de eventos (por defecto 50, máximo 500)"
+	//	@Param			cursor	query		string				false	"Cursor next_cursor de la página anterior"
+	//	@Success		200		{object}	entities.EventPage	"Página de eventos que requieren gestión"
+	//	@Failure		400		{object}	Problem	"Parámetros de paginación inválidos"
+	//	@Failure		403		{object}	Problem	"Sin permiso para esta operación"
+	//	@Failure		500		{object}	Problem	"Error interno del servidor"
 	//	@Router			/events/needs [get]
 	eventGroup.GET("/needs", func(c *gin.Context) {
-		events, err := endpoints.GetEventsNeedingAction(c.Request.Context())
+		page, ok := bindPage(c, logger)
+		if !ok {
+			return
+		}
+		events, err := endpoints.GetEventsNeedingAction(c.Request.Context(), page)
 		if err != nil {
-			logger.Errorln("Layer:event_transports", "Method: GET", "Error:", err)
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Error al obtener eventos: " + err.Error()})
+			writeEventError(c, logger, "GET", err)
 			return
 		}
-		logger.Infoln("Layer:event_transports", "Method: GET", "Eventos que requieren gestion obtenidos correctamente")
+		logging.For(c.Request.Context(), logger, "event_transports", "GET").Debug("eventos que requieren gestión obtenidos")
 		c.JSON(http.StatusOK, events)
 	})
+
+	classificationGroup := router.Group("/api/v1/classification")
+
+	//	@Summary		Probar las reglas de clasificación
+	//	@Description	Evalúa las reglas de clasificación vigentes sobre un evento de ejemplo sin guardarlo
+	//	@Tags			Clasificación
+	//	@Accept			json
+	//	@Produce		json
+	//	@Param			event	body		entities.Event			true	"Evento de ejemplo"
+	//	@Success		200		{object}	entities.Classification	"Resultado de la clasificación"
+	//	@Failure		400		{object}	Problem		"Error en los datos de entrada"
+	//	@Failure		403		{object}	Problem		"Sin permiso para esta operación"
+	//	@Router			/classification/dry-run [post]
+	classificationGroup.POST("/dry-run", func(c *gin.Context) {
+		var event entities.Event
+		if err := c.ShouldBindJSON(&event); err != nil {
+			writeEventError(c, logger, "POST", malformed(err))
+			return
+		}
+		classification, err := endpoints.DryRunClassification(c.Request.Context(), event)
+		if err != nil {
+			writeEventError(c, logger, "POST", err)
+			return
+		}
+		logging.For(c.Request.Context(), logger, "event_transports", "POST").WithField("rule", classification.Rule).Debug("clasificación de prueba")
+		c.JSON(http.StatusOK, classification)
+	})
+}
+
+// writeBatch responde con el resultado de una operación por lotes. Los fallos
+// de elementos sueltos van en el cuerpo; el código HTTP solo refleja errores
+// del lote completo.
+func writeBatch(c *gin.Context, logger logrus.FieldLogger, method string, results []entities.BulkResult, err error) {
+	if err != nil {
+		writeEventError(c, logger, method, err)
+		return
+	}
+
+	locale := localeOf(c)
+	for i := range results {
+		if results[i].Err != nil {
+			results[i].Error = apperr.Localize(results[i].Err, locale)
+		}
+	}
+	response := entities.NewBulkResponse(results)
+	logging.For(c.Request.Context(), logger, "event_transports", method).WithFields(logrus.Fields{"succeeded": response.Succeeded, "failed": response.Failed}).Info("lote procesado")
+	c.JSON(http.StatusOK, response)
+}
+
+// writeEventError deja err en el log y responde con su problem+json.
+func writeEventError(c *gin.Context, logger logrus.FieldLogger, method string, err error) {
+	logProblem(c, logger, "event_transports", method, err)
+	writeProblem(c, err)
+}
+
+// setETag publica la versión del evento como ETag.
+func setETag(c *gin.Context, version int64) {
+	c.Header("ETag", strconv.Quote(strconv.FormatInt(version, 10)))
+}
+
+// ifMatchVersion lee la versión del encabezado If-Match. ok es false si no
+// viene o es "*".
+func ifMatchVersion(c *gin.Context) (version int64, ok bool, err error) {
+	v := strings.TrimPrefix(c.GetHeader("If-Match"), "W/")
+	if v == "" || v == "*" {
+		return 0, false, nil
+	}
+	version, err = strconv.ParseInt(strings.Trim(v, `"`), 10, 64)
+	if err != nil {
+		return 0, false, errIfMatch
+	}
+	return version, true, nil
+}
+
+// auditContext guarda en el contexto de la petición el transporte y el actor,
+// que se toma del encabezado X-Actor, para el historial de auditoría.
+func auditContext() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := service.WithTransport(c.Request.Context(), entities.TransportHTTP)
+		if actor := c.GetHeader("X-Actor"); actor != "" {
+			ctx = service.WithActor(ctx, actor)
+		}
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
+// transitionRequest es el cuerpo de POST /events/{id}/transitions. Con
+// autenticación se ignora Actor: la transición queda a nombre del principal.
+type transitionRequest struct {
+	To     entities.Status `json:"to" binding:"required,status"`
+	Actor  string          `json:"actor"`
+	Reason string          `json:"reason"`
+}
+
+// bindPage lee los parámetros limit y cursor; si son inválidos responde 400.
+func bindPage(c *gin.Context, logger logrus.FieldLogger) (entities.PageRequest, bool) {
+	page := entities.PageRequest{Cursor: c.Query("cursor")}
+	if limit := c.Query("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil {
+			writeEventError(c, logger, "GET", service.ErrPageLimit.WithCause(err))
+			return page, false
+		}
+		page.Limit = n
+	}
+	return page, true
+}
+
+// bindChangeFilter arma el filtro del feed de cambios; si es inválido responde 400.
+func bindChangeFilter(c *gin.Context, logger logrus.FieldLogger) (entities.ChangeFilter, bool) {
+	filter := entities.ChangeFilter{
+		Status:   entities.Status(c.Query("status")),
+		Category: entities.Category(c.Query("category")),
+		Type:     c.Query("type"),
+	}
+	if v := c.Query("needs_action"); v != "" {
+		needsAction, err := strconv.ParseBool(v)
+		if err != nil {
+			writeEventError(c, logger, "GET stream", invalidParam("needs_action", "validation.bool"))
+			return filter, false
+		}
+		filter.NeedsAction = &needsAction
+	}
+	return filter, true
+}
+
+// bindQuery arma un EventQuery con los parámetros de la URL; si alguno es inválido responde 400.
+func bindQuery(c *gin.Context, logger logrus.FieldLogger) (entities.EventQuery, bool) {
+	page, ok := bindPage(c, logger)
+	if !ok {
+		return entities.EventQuery{}, false
+	}
+	query := entities.EventQuery{
+		Status:      entities.Status(c.Query("status")),
+		Category:    entities.Category(c.Query("category")),
+		Type:        c.Query("type"),
+		Name:        c.Query("name"),
+		Description: c.Query("description"),
+		SortBy:      c.Query("sort"),
+		SortOrder:   c.Query("order"),
+		Page:        page,
+	}
+
+	if v := c.Query("needs_action"); v != "" {
+		needsAction, err := strconv.ParseBool(v)
+		if err != nil {
+			writeEventError(c, logger, "GET", invalidParam("needs_action", "validation.bool"))
+			return query, false
+		}
+		query.NeedsAction = &needsAction
+	}
+
+	if v := c.Query("include_deleted"); v != "" {
+		includeDeleted, err := strconv.ParseBool(v)
+		if err != nil {
+			writeEventError(c, logger, "GET", invalidParam("include_deleted", "validation.bool"))
+			return query, false
+		}
+		query.IncludeDeleted = includeDeleted
+	}
+
+	return query, bindDateRange(c, logger, "GET", &query.From, &query.To)
+}
+
+// bindSearch lee la búsqueda de GET /events/search. Los filtros se llaman
+// igual que en el listado.
+func bindSearch(c *gin.Context, logger logrus.FieldLogger) (entities.SearchQuery, bool) {
+	page, ok := bindPage(c, logger)
+	if !ok {
+		return entities.SearchQuery{}, false
+	}
+	query := entities.SearchQuery{
+		Text:     c.Query("q"),
+		Status:   entities.Status(c.Query("status")),
+		Category: entities.Category(c.Query("category")),
+		Page:     page,
+	}
+	return query, bindDateRange(c, logger, "GET search", &query.From, &query.To)
+}
+
+// bindDateRange lee los parámetros from y to en formato RFC 3339.
+func bindDateRange(c *gin.Context, logger logrus.FieldLogger, method string, from, to *time.Time) bool {
+	for param, dst := range map[string]*time.Time{"from": from, "to": to} {
+		v := c.Query(param)
+		if v == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			writeEventError(c, logger, method, invalidParam(param, "validation.rfc3339"))
+			return false
+		}
+		*dst = t
+	}
+	return true
 }
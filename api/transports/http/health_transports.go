@@ -0,0 +1,31 @@
+package transports
+
+import (
+	"net/http"
+	"prueba_tecnica/api/health"
+	"prueba_tecnica/api/logging"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// NewHealthRouter registra las sondas de liveness (/healthz) y readiness
+// (/readyz). Se deben registrar antes de la autenticación para que sean
+// públicas. Quedan fuera de /api/v1 y de Swagger.
+func NewHealthRouter(router *gin.Engine, checker *health.Checker, logger logrus.FieldLogger) {
+	router.GET("/healthz", func(c *gin.Context) {
+		c.JSON(http.StatusOK, health.Report{Status: health.StatusUp})
+	})
+
+	// readyz responde 503 si alguna comprobación falla o si el servidor se
+	// está deteniendo.
+	router.GET("/readyz", func(c *gin.Context) {
+		report := checker.Ready(c.Request.Context())
+		if !report.Up() {
+			logging.Layer(logger, "health_transports", "GET").WithFields(logrus.Fields{"errors": report.Errors, "checks": report.Checks}).Warn("el servicio no está listo")
+			c.JSON(http.StatusServiceUnavailable, report)
+			return
+		}
+		c.JSON(http.StatusOK, report)
+	})
+}
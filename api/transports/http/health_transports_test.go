@@ -0,0 +1,58 @@
+package transports
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"prueba_tecnica/api/health"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthRouter(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var mongoErr error
+	checker := health.NewChecker(time.Second)
+	checker.Add("mongo", func(ctx context.Context) error { return mongoErr })
+
+	router := gin.New()
+	NewHealthRouter(router, checker, logrus.New())
+	// Las sondas no pasan por la autenticación registrada después.
+	router.Use(Authenticate(stubAuthenticator{}, logrus.New()))
+
+	get := func(path string) (int, health.Report) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		var report health.Report
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &report))
+		return w.Code, report
+	}
+
+	code, report := get("/healthz")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, health.StatusUp, report.Status)
+
+	code, report = get("/readyz")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, map[string]string{"mongo": "ok"}, report.Checks)
+
+	mongoErr = errors.New("sin conexión")
+	code, report = get("/readyz")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, health.StatusDown, report.Status)
+	assert.Equal(t, "no disponible", report.Checks["mongo"])
+
+	mongoErr = nil
+	checker.Drain()
+	code, _ = get("/readyz")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	code, _ = get("/healthz")
+	assert.Equal(t, http.StatusOK, code)
+}
@@ -0,0 +1,30 @@
+package transports
+
+import (
+	"net/http"
+	"prueba_tecnica/api/i18n"
+
+	"github.com/gin-gonic/gin"
+)
+
+// localeKey guarda en el contexto de gin el idioma ya elegido.
+const localeKey = "locale"
+
+// localeOf devuelve el idioma que pide el encabezado Accept-Language; sin
+// encabezado o con un idioma no soportado los mensajes van en español.
+func localeOf(c *gin.Context) i18n.Locale {
+	if locale, ok := c.Get(localeKey); ok {
+		return locale.(i18n.Locale)
+	}
+	locale := i18n.Parse(c.GetHeader("Accept-Language"))
+	c.Set(localeKey, locale)
+	return locale
+}
+
+// writeMessage responde un mensaje de confirmación del catálogo en el idioma
+// de la petición.
+func writeMessage(c *gin.Context, key string) {
+	locale := localeOf(c)
+	c.Header("Content-Language", string(locale))
+	c.JSON(http.StatusOK, gin.H{"message": i18n.T(locale, key)})
+}
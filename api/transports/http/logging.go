@@ -0,0 +1,48 @@
+package transports
+
+import (
+	"net/http"
+	"prueba_tecnica/api/logging"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// RequestLogger toma el request ID del encabezado X-Request-ID o genera uno,
+// lo devuelve en la respuesta y guarda en el contexto un logger con él. Al
+// terminar registra la petición con su estado y duración. Va después de
+// Tracing para que el log lleve el trace ID.
+func RequestLogger(logger logrus.FieldLogger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		requestID := logging.RequestID(c.GetHeader(logging.RequestIDHeader))
+		c.Header(logging.RequestIDHeader, requestID)
+		c.Request = c.Request.WithContext(logging.Start(c.Request.Context(), logger, requestID, logrus.Fields{
+			"transport":   "http",
+			"http_method": c.Request.Method,
+			"path":        c.Request.URL.Path,
+		}))
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		status := c.Writer.Status()
+		// c.Request puede traer un logger con más campos, como el tenant.
+		entry := logging.FromContext(c.Request.Context(), logger).WithFields(logrus.Fields{
+			"route":               route,
+			"http_status":         status,
+			logging.FieldDuration: logging.Duration(time.Since(start)),
+		})
+		switch {
+		case status >= http.StatusInternalServerError:
+			entry.Error("petición atendida")
+		case untracedRoutes[route]:
+			entry.Debug("petición atendida")
+		default:
+			entry.Info("petición atendida")
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package transports
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"prueba_tecnica/api/logging"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestLogger(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger, hook := test.NewNullLogger()
+	router := gin.New()
+	router.Use(RequestLogger(logger), ResolveTenant(logger))
+	router.GET("/events/:id", func(c *gin.Context) {
+		logging.For(c.Request.Context(), logger, "event_transports", "GET").Info("evento obtenido")
+		c.String(http.StatusOK, logging.RequestIDFromContext(c.Request.Context()))
+	})
+
+	t.Run("Keeps the client request ID", func(t *testing.T) {
+		hook.Reset()
+		req := httptest.NewRequest(http.MethodGet, "/events/1", nil)
+		req.Header.Set(logging.RequestIDHeader, "req-1")
+		req.Header.Set("X-Tenant-ID", "acme")
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, "req-1", rec.Header().Get(logging.RequestIDHeader))
+		assert.Equal(t, "req-1", rec.Body.String())
+		entries := hook.AllEntries()
+		require.Len(t, entries, 2)
+		for _, entry := range entries {
+			assert.Equal(t, "req-1", entry.Data[logging.FieldRequestID])
+			assert.Equal(t, "acme", entry.Data[logging.FieldTenant])
+		}
+		assert.Equal(t, "event_transports", entries[0].Data[logging.FieldLayer])
+		assert.Equal(t, "/events/:id", entries[1].Data["route"])
+		assert.Equal(t, http.StatusOK, entries[1].Data["http_status"])
+		assert.Contains(t, entries[1].Data, logging.FieldDuration)
+	})
+
+	t.Run("Generates a request ID", func(t *testing.T) {
+		hook.Reset()
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/missing", nil))
+
+		id := rec.Header().Get(logging.RequestIDHeader)
+		assert.Len(t, id, 32)
+		entry := hook.LastEntry()
+		require.NotNil(t, entry)
+		assert.Equal(t, id, entry.Data[logging.FieldRequestID])
+		assert.Equal(t, unmatchedRoute, entry.Data["route"])
+		assert.Equal(t, logrus.InfoLevel, entry.Level)
+	})
+}
@@ -0,0 +1,85 @@
+package transports
+
+import (
+	"bytes"
+	"encoding/json"
+	"prueba_tecnica/api/apperr"
+	"prueba_tecnica/api/entities"
+	"time"
+)
+
+// valueKeys es el mensaje del catálogo para un valor de tipo incorrecto en
+// cada campo que no es texto.
+var valueKeys = map[string]string{
+	"needs_action": "validation.bool",
+	"date":         "validation.rfc3339",
+}
+
+// decodeMergePatch traduce un JSON Merge Patch (RFC 7396) sobre un evento a
+// un EventPatch. null solo se acepta en los campos opcionales: category y
+// needs_action. Los campos rechazados van en el detalle de errMalformed.
+func decodeMergePatch(body []byte) (entities.EventPatch, error) {
+	var patch entities.EventPatch
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil || fields == nil {
+		return patch, errMergePatch
+	}
+
+	for field, raw := range fields {
+		isNull := bytes.Equal(bytes.TrimSpace(raw), []byte("null"))
+		if isNull {
+			switch field {
+			case "name", "type", "description", "status", "date":
+				return patch, errMalformed.WithFields(apperr.Violation(field, "validation.not_nullable"))
+			}
+		}
+
+		var err error
+		switch field {
+		case "name":
+			patch.Name, err = decodeString(raw)
+		case "type":
+			patch.Type, err = decodeString(raw)
+		case "description":
+			patch.Description, err = decodeString(raw)
+		case "status":
+			var status *string
+			status, err = decodeString(raw)
+			patch.Status = (*entities.Status)(status)
+		case "category":
+			var category entities.Category
+			if !isNull {
+				err = json.Unmarshal(raw, &category)
+			}
+			patch.Category = &category
+		case "needs_action":
+			needsAction := false
+			if !isNull {
+				err = json.Unmarshal(raw, &needsAction)
+			}
+			patch.NeedsAction = &needsAction
+		case "date":
+			var date time.Time
+			err = json.Unmarshal(raw, &date)
+			patch.Date = &date
+		default:
+			return patch, errMalformed.WithFields(apperr.Violation(field, "validation.read_only"))
+		}
+		if err != nil {
+			key, ok := valueKeys[field]
+			if !ok {
+				key = "validation.string"
+			}
+			return patch, errMalformed.WithFields(apperr.Violation(field, key))
+		}
+	}
+	return patch, nil
+}
+
+func decodeString(raw json.RawMessage) (*string, error) {
+	var v string
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
@@ -0,0 +1,38 @@
+package transports
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// unmatchedRoute agrupa las peticiones que no coinciden con ninguna ruta,
+// para que las URL inventadas no creen series nuevas.
+const unmatchedRoute = "unmatched"
+
+// RequestObserver recibe cada petición HTTP atendida.
+type RequestObserver interface {
+	ObserveHTTP(method, route string, status int, elapsed time.Duration)
+}
+
+// Metrics mide cada petición con la plantilla de su ruta. Va antes que los
+// demás middlewares de la API, para incluir su tiempo y contar también las
+// peticiones que rechazan.
+func Metrics(observer RequestObserver) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		observer.ObserveHTTP(c.Request.Method, route, c.Writer.Status(), time.Since(start))
+	}
+}
+
+// MetricsHandler sirve /metrics con h.
+func MetricsHandler(router *gin.Engine, h http.Handler) {
+	router.GET("/metrics", gin.WrapH(h))
+}
@@ -0,0 +1,44 @@
+package transports
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type observedRequest struct {
+	method, route string
+	status        int
+}
+
+type recordingObserver struct {
+	requests []observedRequest
+}
+
+func (o *recordingObserver) ObserveHTTP(method, route string, status int, elapsed time.Duration) {
+	o.requests = append(o.requests, observedRequest{method: method, route: route, status: status})
+}
+
+func TestMetrics(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	observer := &recordingObserver{}
+	router := gin.New()
+	router.Use(Metrics(observer))
+	router.GET("/public/:id", func(c *gin.Context) { c.String(http.StatusOK, c.Param("id")) })
+	router.GET("/private/:id", func(c *gin.Context) { c.AbortWithStatus(http.StatusUnauthorized) })
+
+	for _, path := range []string{"/public/1", "/public/2", "/private/1", "/missing"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	assert.Equal(t, []observedRequest{
+		{method: http.MethodGet, route: "/public/:id", status: http.StatusOK},
+		{method: http.MethodGet, route: "/public/:id", status: http.StatusOK},
+		{method: http.MethodGet, route: "/private/:id", status: http.StatusUnauthorized},
+		{method: http.MethodGet, route: unmatchedRoute, status: http.StatusNotFound},
+	}, observer.requests)
+}
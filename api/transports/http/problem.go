@@ -0,0 +1,121 @@
+package transports
+
+import (
+	"errors"
+	"net/http"
+	"prueba_tecnica/api/apperr"
+	"prueba_tecnica/api/logging"
+	"prueba_tecnica/api/taxonomy"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/sirupsen/logrus"
+)
+
+// problemContentType es el Content-Type de las respuestas de error (RFC 7807).
+const problemContentType = "application/problem+json"
+
+// problemTypePrefix antecede al código del error en el campo type.
+const problemTypePrefix = "urn:prueba-tecnica:problem:"
+
+// Problem es el cuerpo de todas las respuestas de error. Code es el mismo
+// código que llega en el ErrorInfo de los errores gRPC y Key la clave estable
+// del mensaje, que no cambia con el idioma.
+type Problem struct {
+	Type     string                  `json:"type" example:"urn:prueba-tecnica:problem:not_found"`
+	Title    string                  `json:"title" example:"No encontrado"`
+	Status   int                     `json:"status" example:"404"`
+	Detail   string                  `json:"detail,omitempty" example:"evento con ese id no encontrado"`
+	Instance string                  `json:"instance,omitempty" example:"/api/v1/events/66b0c0ffee"`
+	Code     apperr.Code             `json:"code" example:"not_found"`
+	Key      string                  `json:"key" example:"event.not_found"`
+	Errors   []apperr.FieldViolation `json:"errors,omitempty"`
+}
+
+var (
+	errMalformed    = apperr.New(apperr.InvalidArgument, "request.malformed")
+	errInvalidParam = apperr.New(apperr.InvalidArgument, "request.invalid_params")
+	errIfMatch      = apperr.New(apperr.InvalidArgument, "request.if_match")
+	errMediaType    = apperr.New(apperr.UnsupportedMediaType, "request.media_type")
+	errMergePatch   = apperr.New(apperr.InvalidArgument, "request.merge_patch")
+	errBatch        = apperr.New(apperr.InvalidArgument, "request.batch")
+	errBatchTrail   = apperr.New(apperr.InvalidArgument, "request.batch_trailing")
+)
+
+func init() {
+	// Los errores de binding nombran los campos como en el JSON.
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(func(field reflect.StructField) string {
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if name == "-" {
+				return ""
+			}
+			return name
+		})
+	}
+	// La taxonomía por defecto rige hasta que el servidor cargue la suya.
+	if err := UseTaxonomy(taxonomy.Default()); err != nil {
+		panic(err)
+	}
+}
+
+// newProblem describe err en el idioma de la petición. El detalle de los
+// errores internos solo va al log.
+func newProblem(c *gin.Context, err error) Problem {
+	e := apperr.From(err)
+	locale := localeOf(c)
+	status := e.Code.HTTPStatus()
+	detail := e.LocalizeMessage(locale)
+	if status < 500 {
+		detail = e.Localize(locale)
+	}
+	return Problem{
+		Type:     problemTypePrefix + string(e.Code),
+		Title:    e.Code.Title(locale),
+		Status:   status,
+		Detail:   detail,
+		Instance: c.Request.URL.Path,
+		Code:     e.Code,
+		Key:      e.Key,
+		Errors:   e.LocalizeFields(locale),
+	}
+}
+
+// writeProblem responde err como application/problem+json y corta la cadena
+// de handlers.
+func writeProblem(c *gin.Context, err error) {
+	problem := newProblem(c, err)
+	c.Header("Content-Type", problemContentType)
+	c.Header("Content-Language", string(localeOf(c)))
+	c.AbortWithStatusJSON(problem.Status, problem)
+}
+
+// logProblem deja err en el log: como error si es una falla del servidor y
+// como advertencia si es un error del cliente.
+func logProblem(c *gin.Context, logger logrus.FieldLogger, layer, method string, err error) {
+	entry := logging.For(c.Request.Context(), logger, layer, method).WithError(err)
+	if apperr.CodeOf(err).HTTPStatus() >= http.StatusInternalServerError {
+		entry.Error("operación fallida")
+		return
+	}
+	entry.Warn("operación rechazada")
+}
+
+// malformed reporta un cuerpo que no se pudo leer. Los errores de binding
+// llevan el detalle de cada campo; los de dominio quedan igual.
+func malformed(err error) error {
+	var appErr *apperr.Error
+	if errors.As(err, &appErr) {
+		return err
+	}
+	return apperr.Invalid(errMalformed, err)
+}
+
+// invalidParam reporta un parámetro de la URL inválido; key es la clave de
+// la descripción en el catálogo.
+func invalidParam(name, key string) error {
+	return errInvalidParam.WithFields(apperr.Violation(name, key))
+}
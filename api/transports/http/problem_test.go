@@ -0,0 +1,147 @@
+package transports
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"prueba_tecnica/api/apperr"
+	"prueba_tecnica/api/i18n"
+	"prueba_tecnica/api/service"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteProblem(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	testCases := []struct {
+		name           string
+		err            error
+		expectedStatus int
+		expectedCode   apperr.Code
+		expectedDetail string
+		expectedFields []apperr.FieldViolation
+	}{
+		{
+			name:           "Not found",
+			err:            service.ErrEventNotfound,
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   apperr.NotFound,
+			expectedDetail: service.ErrEventNotfound.Message,
+		},
+		{
+			name:           "Field details",
+			err:            service.ErrValidation.WithFields(apperr.FieldViolation{Field: "name", Description: "es requerido"}),
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   apperr.InvalidArgument,
+			expectedDetail: service.ErrValidation.Message,
+			expectedFields: []apperr.FieldViolation{{Field: "name", Description: "es requerido"}},
+		},
+		{
+			name:           "Version conflict",
+			err:            service.ErrVersionConflict,
+			expectedStatus: http.StatusConflict,
+			expectedCode:   apperr.Conflict,
+			expectedDetail: service.ErrVersionConflict.Message,
+		},
+		{
+			name:           "Forbidden",
+			err:            service.ErrForbidden,
+			expectedStatus: http.StatusForbidden,
+			expectedCode:   apperr.PermissionDenied,
+			expectedDetail: service.ErrForbidden.Message,
+		},
+		{
+			name:           "Database outage is not a 404",
+			err:            errors.New("server selection error: context deadline exceeded, mongo:27017"),
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   apperr.Internal,
+			expectedDetail: "error interno del servidor",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			router := gin.New()
+			router.GET("/api/v1/events/:id", func(c *gin.Context) { writeProblem(c, tc.err) })
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/events/1", nil))
+
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			assert.Equal(t, problemContentType, rec.Header().Get("Content-Type"))
+			var problem Problem
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+			assert.Equal(t, tc.expectedStatus, problem.Status)
+			assert.Equal(t, tc.expectedCode, problem.Code)
+			assert.Equal(t, problemTypePrefix+string(tc.expectedCode), problem.Type)
+			assert.Equal(t, tc.expectedCode.Title(i18n.Spanish), problem.Title)
+			assert.Equal(t, "es", rec.Header().Get("Content-Language"))
+			assert.Equal(t, tc.expectedDetail, problem.Detail)
+			assert.Equal(t, "/api/v1/events/1", problem.Instance)
+			assert.Equal(t, tc.expectedFields, problem.Errors)
+		})
+	}
+}
+
+func TestMalformedReportsBindingFields(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/transitions", func(c *gin.Context) {
+		var request transitionRequest
+		err := c.ShouldBindJSON(&request)
+		require.Error(t, err)
+		writeProblem(c, malformed(err))
+	})
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/transitions", strings.NewReader(`{"actor": "ana"}`)))
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	var problem Problem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+	assert.Equal(t, []apperr.FieldViolation{{Field: "to", Description: "es requerido"}}, problem.Errors)
+}
+
+func TestWriteProblemUsesAcceptLanguage(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	testCases := []struct {
+		name             string
+		acceptLanguage   string
+		expectedLanguage string
+		expectedTitle    string
+		expectedDetail   string
+		expectedField    string
+	}{
+		{name: "English", acceptLanguage: "en-US,en;q=0.9", expectedLanguage: "en", expectedTitle: "Invalid request", expectedDetail: "invalid query parameters", expectedField: "must be true or false"},
+		{name: "Spanish preferred", acceptLanguage: "es-CO, en;q=0.5", expectedLanguage: "es", expectedTitle: "Petición inválida", expectedDetail: "parámetros de consulta inválidos", expectedField: "debe ser true o false"},
+		{name: "Unsupported language", acceptLanguage: "fr-FR", expectedLanguage: "es", expectedTitle: "Petición inválida", expectedDetail: "parámetros de consulta inválidos", expectedField: "debe ser true o false"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			router := gin.New()
+			router.GET("/events", func(c *gin.Context) { writeProblem(c, invalidParam("needs_action", "validation.bool")) })
+			req := httptest.NewRequest(http.MethodGet, "/events", nil)
+			req.Header.Set("Accept-Language", tc.acceptLanguage)
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectedLanguage, rec.Header().Get("Content-Language"))
+			var problem Problem
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+			assert.Equal(t, tc.expectedTitle, problem.Title)
+			assert.Equal(t, tc.expectedDetail, problem.Detail)
+			assert.Equal(t, "request.invalid_params", problem.Key)
+			require.Len(t, problem.Errors, 1)
+			assert.Equal(t, tc.expectedField, problem.Errors[0].Description)
+		})
+	}
+}
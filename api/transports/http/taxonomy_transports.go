@@ -0,0 +1,43 @@
+package transports
+
+import (
+	"net/http"
+	"prueba_tecnica/api/endpoints"
+	"prueba_tecnica/api/logging"
+	"prueba_tecnica/api/taxonomy"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/sirupsen/logrus"
+)
+
+// UseTaxonomy hace que las etiquetas binding status, category y event_type
+// acepten los valores de t. Hasta que se llame se usa taxonomy.Default.
+func UseTaxonomy(t *taxonomy.Taxonomy) error {
+	v, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return nil
+	}
+	return t.RegisterValidations(v)
+}
+
+func NewTaxonomyRouter(router *gin.Engine, endpoints endpoints.EventEndpoints, logger logrus.FieldLogger) {
+	//	@Summary		Consultar la taxonomía
+	//	@Description	Devuelve los estados con sus transiciones, las categorías y los tipos de evento que acepta la API
+	//	@Tags			Taxonomía
+	//	@Produce		json
+	//	@Success		200	{object}	taxonomy.Taxonomy	"Vocabulario vigente"
+	//	@Failure		403	{object}	Problem	"Sin permiso para esta operación"
+	//	@Failure		500	{object}	Problem	"Error interno del servidor"
+	//	@Router			/taxonomy [get]
+	router.GET("/api/v1/taxonomy", func(c *gin.Context) {
+		t, err := endpoints.GetTaxonomy(c.Request.Context())
+		if err != nil {
+			logging.For(c.Request.Context(), logger, "taxonomy_transports", "GET").WithError(err).Error("operación fallida")
+			writeProblem(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, t)
+	})
+}
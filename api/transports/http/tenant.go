@@ -0,0 +1,28 @@
+package transports
+
+import (
+	"prueba_tecnica/api/logging"
+	"prueba_tecnica/api/service"
+	"prueba_tecnica/api/tenant"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// ResolveTenant guarda en el contexto el tenant de la petición: el del
+// principal autenticado o el del encabezado X-Tenant-ID. Va después de
+// Authenticate. Responde 400 si el tenant no es válido y 403 si el principal
+// pide uno que no es el suyo.
+func ResolveTenant(logger logrus.FieldLogger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, err := service.ResolveTenant(c.Request.Context(), c.GetHeader(tenant.Header))
+		if err != nil {
+			logging.For(c.Request.Context(), logger, "tenant_transports", "ResolveTenant").WithField("path", c.Request.URL.Path).WithError(err).Warn("tenant rechazado")
+			writeProblem(c, err)
+			return
+		}
+		ctx = logging.With(ctx, logger, logrus.Fields{logging.FieldTenant: tenant.FromContext(ctx)})
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
@@ -0,0 +1,23 @@
+package transports
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+)
+
+// untracedRoutes son las rutas que consultan los orquestadores y Prometheus
+// cada pocos segundos; trazarlas solo agregaría ruido.
+var untracedRoutes = map[string]bool{
+	"/healthz": true,
+	"/readyz":  true,
+	"/metrics": true,
+}
+
+// Tracing abre una span por petición, como hija del trace-context W3C que
+// llegue en traceparent. Va antes que cualquier otro middleware para que las
+// peticiones rechazadas también queden trazadas.
+func Tracing(service string) gin.HandlerFunc {
+	return otelgin.Middleware(service, otelgin.WithGinFilter(func(c *gin.Context) bool {
+		return !untracedRoutes[c.FullPath()]
+	}))
+}
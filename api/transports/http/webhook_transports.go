@@ -0,0 +1,190 @@
+package transports
+
+import (
+	"net/http"
+	"prueba_tecnica/api/endpoints"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/i18n"
+	"prueba_tecnica/api/logging"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+func NewWebhookRouter(router *gin.Engine, endpoints endpoints.WebhookEndpoints, logger logrus.FieldLogger) {
+	webhookGroup := router.Group("/api/v1/webhooks")
+
+	//	@Summary		Registrar un webhook
+	//	@Description	Registra una URL que recibe un POST JSON firmado con HMAC-SHA256 en cada trigger elegido. El secreto solo se devuelve en esta respuesta; si no se envía se genera uno
+	//	@Tags			Webhooks
+	//	@Accept			json
+	//	@Produce		json
+	//	@Param			webhook	body		entities.Webhook	true	"URL, triggers (needs_action, status_change, delete) y secreto opcional"
+	//	@Success		201		{object}	entities.Webhook	"Webhook creado, con su secreto"
+	//	@Failure		400		{object}	Problem	"Error en los datos de entrada"
+	//	@Failure		403		{object}	Problem	"Sin permiso para esta operación"
+	//	@Failure		500		{object}	Problem	"Error interno del servidor"
+	//	@Router			/webhooks [post]
+	webhookGroup.POST("/", func(c *gin.Context) {
+		var webhook entities.Webhook
+		if err := c.ShouldBindJSON(&webhook); err != nil {
+			writeWebhookError(c, logger, "Post", malformed(err))
+			return
+		}
+		created, err := endpoints.CreateWebhook(c.Request.Context(), webhook)
+		if err != nil {
+			writeWebhookError(c, logger, "Post", err)
+			return
+		}
+		logging.For(c.Request.Context(), logger, "webhook_transports", "POST").WithField("webhook_id", created.ID).Info("webhook creado")
+		c.JSON(http.StatusCreated, created)
+	})
+
+	//	@Summary		Listar webhooks
+	//	@Tags			Webhooks
+	//	@Produce		json
+	//	@Success		200	{array}		entities.Webhook	"Webhooks registrados, sin su secreto"
+	//	@Failure		403	{object}	Problem	"Sin permiso para esta operación"
+	//	@Failure		500	{object}	Problem	"Error interno del servidor"
+	//	@Router			/webhooks [get]
+	webhookGroup.GET("/", func(c *gin.Context) {
+		webhooks, err := endpoints.ListWebhooks(c.Request.Context())
+		if err != nil {
+			writeWebhookError(c, logger, "GET", err)
+			return
+		}
+		c.JSON(http.StatusOK, webhooks)
+	})
+
+	//	@Summary		Obtener un webhook
+	//	@Tags			Webhooks
+	//	@Produce		json
+	//	@Param			id	path		string				true	"ID del webhook"
+	//	@Success		200	{object}	entities.Webhook	"Webhook, sin su secreto"
+	//	@Failure		404	{object}	Problem	"Webhook no encontrado"
+	//	@Failure		403	{object}	Problem	"Sin permiso para esta operación"
+	//	@Failure		500	{object}	Problem	"Error interno del servidor"
+	//	@Router			/webhooks/{id} [get]
+	webhookGroup.GET("/:id", func(c *gin.Context) {
+		webhook, err := endpoints.GetWebhook(c.Request.Context(), c.Param("id"))
+		if err != nil {
+			writeWebhookError(c, logger, "GET", err)
+			return
+		}
+		c.JSON(http.StatusOK, webhook)
+	})
+
+	//	@Summary		Actualizar un webhook
+	//	@Description	Reemplaza la URL, los triggers y si está activo. El secreto solo cambia si se envía uno nuevo
+	//	@Tags			Webhooks
+	//	@Accept			json
+	//	@Produce		json
+	//	@Param			id		path		string				true	"ID del webhook"
+	//	@Param			webhook	body		entities.Webhook	true	"Datos del webhook"
+	//	@Success		200		{object}	entities.Webhook	"Webhook actualizado, sin su secreto"
+	//	@Failure		400		{object}	Problem	"Error en los datos de entrada"
+	//	@Failure		404		{object}	Problem	"Webhook no encontrado"
+	//	@Failure		403		{object}	Problem	"Sin permiso para esta operación"
+	//	@Failure		500		{object}	Problem	"Error interno del servidor"
+	//	@Router			/webhooks/{id} [put]
+	webhookGroup.PUT("/:id", func(c *gin.Context) {
+		var webhook entities.Webhook
+		if err := c.ShouldBindJSON(&webhook); err != nil {
+			writeWebhookError(c, logger, "PUT", malformed(err))
+			return
+		}
+		webhook.ID = c.Param("id")
+		updated, err := endpoints.UpdateWebhook(c.Request.Context(), webhook)
+		if err != nil {
+			writeWebhookError(c, logger, "PUT", err)
+			return
+		}
+		logging.For(c.Request.Context(), logger, "webhook_transports", "PUT").WithField("webhook_id", updated.ID).Info("webhook actualizado")
+		c.JSON(http.StatusOK, updated)
+	})
+
+	//	@Summary		Eliminar un webhook
+	//	@Description	Elimina el webhook y cancela sus reintentos pendientes. Su registro de envíos se conserva
+	//	@Tags			Webhooks
+	//	@Produce		json
+	//	@Param			id	path		string				true	"ID del webhook"
+	//	@Success		200	{object}	map[string]string	"Mensaje de confirmación"
+	//	@Failure		404	{object}	Problem	"Webhook no encontrado"
+	//	@Failure		403	{object}	Problem	"Sin permiso para esta operación"
+	//	@Failure		500	{object}	Problem	"Error interno del servidor"
+	//	@Router			/webhooks/{id} [delete]
+	webhookGroup.DELETE("/:id", func(c *gin.Context) {
+		if err := endpoints.DeleteWebhook(c.Request.Context(), c.Param("id")); err != nil {
+			writeWebhookError(c, logger, "DELETE", err)
+			return
+		}
+		logging.For(c.Request.Context(), logger, "webhook_transports", "DELETE").WithField("webhook_id", c.Param("id")).Info("webhook eliminado")
+		writeMessage(c, "webhook.deleted")
+	})
+
+	//	@Summary		Registro de envíos de un webhook
+	//	@Description	Lista los últimos envíos del webhook con su estado (delivered, retrying, dead o cancelled), intentos y última respuesta
+	//	@Tags			Webhooks
+	//	@Produce		json
+	//	@Param			id		path		string							true	"ID del webhook"
+	//	@Param			limit	query		int								false	"Cantidad máxima de envíos (por defecto 50, máximo 500)"
+	//	@Success		200		{array}		entities.WebhookDelivery		"Envíos, del más reciente al más antiguo"
+	//	@Failure		400		{object}	Problem				"Límite inválido"
+	//	@Failure		403		{object}	Problem				"Sin permiso para esta operación"
+	//	@Failure		500		{object}	Problem				"Error interno del servidor"
+	//	@Router			/webhooks/{id}/deliveries [get]
+	webhookGroup.GET("/:id/deliveries", func(c *gin.Context) {
+		page, ok := bindPage(c, logger)
+		if !ok {
+			return
+		}
+		deliveries, err := endpoints.ListDeliveries(c.Request.Context(), c.Param("id"), page.Limit)
+		if err != nil {
+			writeWebhookError(c, logger, "GET deliveries", err)
+			return
+		}
+		c.JSON(http.StatusOK, localizeDeliveries(c, deliveries))
+	})
+
+	//	@Summary		Envíos muertos de un webhook
+	//	@Description	Lista los envíos que agotaron los reintentos, con el cuerpo que se intentó enviar
+	//	@Tags			Webhooks
+	//	@Produce		json
+	//	@Param			id		path		string							true	"ID del webhook"
+	//	@Param			limit	query		int								false	"Cantidad máxima de envíos (por defecto 50, máximo 500)"
+	//	@Success		200		{array}		entities.WebhookDelivery		"Envíos muertos, del más reciente al más antiguo"
+	//	@Failure		400		{object}	Problem				"Límite inválido"
+	//	@Failure		403		{object}	Problem				"Sin permiso para esta operación"
+	//	@Failure		500		{object}	Problem				"Error interno del servidor"
+	//	@Router			/webhooks/{id}/dead-letters [get]
+	webhookGroup.GET("/:id/dead-letters", func(c *gin.Context) {
+		page, ok := bindPage(c, logger)
+		if !ok {
+			return
+		}
+		letters, err := endpoints.ListDeadLetters(c.Request.Context(), c.Param("id"), page.Limit)
+		if err != nil {
+			writeWebhookError(c, logger, "GET dead-letters", err)
+			return
+		}
+		c.JSON(http.StatusOK, localizeDeliveries(c, letters))
+	})
+}
+
+// localizeDeliveries traduce al idioma de la petición el error de los envíos
+// que fallaron por un motivo del catálogo.
+func localizeDeliveries(c *gin.Context, deliveries []entities.WebhookDelivery) []entities.WebhookDelivery {
+	locale := localeOf(c)
+	for i := range deliveries {
+		if deliveries[i].ErrorKey != "" {
+			deliveries[i].Error = i18n.T(locale, deliveries[i].ErrorKey)
+		}
+	}
+	return deliveries
+}
+
+// writeWebhookError deja err en el log y responde con su problem+json.
+func writeWebhookError(c *gin.Context, logger logrus.FieldLogger, method string, err error) {
+	logProblem(c, logger, "webhook_transports", method, err)
+	writeProblem(c, err)
+}
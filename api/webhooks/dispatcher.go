@@ -0,0 +1,367 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"prueba_tecnica/api/apperr"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/logging"
+	"prueba_tecnica/api/repository"
+	"prueba_tecnica/api/tenant"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Encabezados de cada envío. La firma es "sha256=" seguido del HMAC-SHA256 en
+// hexadecimal de "<timestamp>.<cuerpo>" con el secreto del webhook.
+const (
+	HeaderWebhookID = "X-Webhook-Id"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderTrigger   = "X-Webhook-Trigger"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+type Config struct {
+	// MaxAttempts es la cantidad de intentos antes de mover el envío a la
+	// cola de mensajes muertos.
+	MaxAttempts int `yaml:"max_attempts"`
+	// Backoff es la espera antes del segundo intento; se duplica en cada
+	// reintento hasta MaxBackoff.
+	Backoff    time.Duration `yaml:"backoff"`
+	MaxBackoff time.Duration `yaml:"max_backoff"`
+	// Timeout limita cada intento.
+	Timeout time.Duration `yaml:"timeout"`
+	Workers int           `yaml:"workers"`
+}
+
+// ErrUnexpectedStatus es el error de un intento cuyo destino no respondió 2xx;
+// el estado queda en StatusCode.
+var ErrUnexpectedStatus = apperr.New(apperr.Unavailable, "webhook.unexpected_status")
+
+// DefaultConfig reintenta durante unos 5 minutos antes de rendirse.
+func DefaultConfig() Config {
+	return Config{
+		MaxAttempts: 6,
+		Backoff:     10 * time.Second,
+		MaxBackoff:  5 * time.Minute,
+		Timeout:     10 * time.Second,
+		Workers:     4,
+	}
+}
+
+// Dispatcher envía los webhooks de los cambios que recibe con Notify. Los
+// cambios y los reintentos pendientes viven en memoria: si el proceso se
+// detiene, los envíos quedan en el registro con estado retrying y no se
+// vuelven a intentar.
+type Dispatcher struct {
+	repo   repository.WebhookRepository
+	client *http.Client
+	config Config
+	logger logrus.FieldLogger
+	jobs   chan entities.WebhookDelivery
+	now    func() time.Time
+
+	mu      sync.Mutex
+	pending []entities.EventChange
+	wake    chan struct{}
+}
+
+func NewDispatcher(repo repository.WebhookRepository, config Config, logger logrus.FieldLogger) *Dispatcher {
+	return &Dispatcher{
+		repo:   repo,
+		client: &http.Client{Timeout: config.Timeout},
+		config: config,
+		logger: logger,
+		jobs:   make(chan entities.WebhookDelivery, 1024),
+		now:    time.Now,
+		wake:   make(chan struct{}, 1),
+	}
+}
+
+// Notify encola change para Run. No se bloquea ni descarta cambios, a
+// diferencia de una suscripción a changes.Broker: la cola crece durante una
+// ráfaga, por ejemplo un lote de 1000 eventos, hasta que Run la consume.
+func (d *Dispatcher) Notify(change entities.EventChange) {
+	if len(Triggers(change)) == 0 {
+		return
+	}
+	d.mu.Lock()
+	d.pending = append(d.pending, change)
+	d.mu.Unlock()
+
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run envía los webhooks de los cambios encolados hasta que termina ctx.
+func (d *Dispatcher) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i := 0; i < d.config.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d.work(ctx)
+		}()
+	}
+
+	for {
+		select {
+		case <-d.wake:
+			d.dispatch(ctx, d.take())
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		}
+	}
+}
+
+// take vacía la cola de cambios.
+func (d *Dispatcher) take() []entities.EventChange {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	pending := d.pending
+	d.pending = nil
+	return pending
+}
+
+// Triggers devuelve los disparadores de webhooks que produce un cambio.
+func Triggers(change entities.EventChange) []string {
+	var triggers []string
+	prev := change.Previous
+	if change.Type == entities.ChangeDeleted {
+		return []string{entities.TriggerDelete}
+	}
+	if change.Event.NeedsAction && (prev == nil || !prev.NeedsAction) {
+		triggers = append(triggers, entities.TriggerNeedsAction)
+	}
+	if prev != nil && prev.Status != change.Event.Status {
+		triggers = append(triggers, entities.TriggerStatusChange)
+	}
+	return triggers
+}
+
+// payload es el cuerpo JSON de cada envío.
+type payload struct {
+	ID             string          `json:"id"`
+	Trigger        string          `json:"trigger"`
+	OccurredAt     time.Time       `json:"occurred_at"`
+	PreviousStatus entities.Status `json:"previous_status,omitempty"`
+	Event          entities.Event  `json:"event"`
+}
+
+// dispatch crea los envíos de changes. Lee los webhooks una vez por tanda y
+// no por cambio; si la lectura falla, los cambios vuelven a la cola.
+func (d *Dispatcher) dispatch(ctx context.Context, changes []entities.EventChange) {
+	if len(changes) == 0 {
+		return
+	}
+	hooks, err := d.repo.ListWebhooks(ctx)
+	if err != nil {
+		logging.Layer(d.logger, "webhooks", "dispatch").WithField("pending", len(changes)).WithError(err).Error("operación fallida")
+		d.requeue(ctx, changes)
+		return
+	}
+
+	for _, change := range changes {
+		if !d.enqueue(ctx, change, hooks) {
+			return
+		}
+	}
+}
+
+// requeue devuelve changes al principio de la cola y reintenta después de
+// Backoff.
+func (d *Dispatcher) requeue(ctx context.Context, changes []entities.EventChange) {
+	d.mu.Lock()
+	d.pending = append(changes, d.pending...)
+	d.mu.Unlock()
+
+	time.AfterFunc(d.config.Backoff, func() {
+		if ctx.Err() != nil {
+			return
+		}
+		select {
+		case d.wake <- struct{}{}:
+		default:
+		}
+	})
+}
+
+// enqueue manda a los workers un envío por cada webhook suscrito a los
+// disparadores de change. Devuelve false si terminó ctx.
+func (d *Dispatcher) enqueue(ctx context.Context, change entities.EventChange, hooks []entities.Webhook) bool {
+	for _, trigger := range Triggers(change) {
+		for _, hook := range hooks {
+			if !hook.Subscribed(trigger) || tenant.Of(hook.TenantID) != tenant.Of(change.Event.TenantID) {
+				continue
+			}
+			body := payload{
+				ID:         primitive.NewObjectID().Hex(),
+				Trigger:    trigger,
+				OccurredAt: change.At,
+				Event:      change.Event,
+			}
+			if trigger == entities.TriggerStatusChange {
+				body.PreviousStatus = change.Previous.Status
+			}
+			raw, err := json.Marshal(body)
+			if err != nil {
+				logging.Layer(d.logger, "webhooks", "dispatch").WithError(err).Error("operación fallida")
+				continue
+			}
+
+			delivery := entities.WebhookDelivery{
+				ID:        body.ID,
+				WebhookID: hook.ID,
+				TenantID:  hook.TenantID,
+				EventID:   change.Event.ID,
+				Trigger:   trigger,
+				Payload:   string(raw),
+				Status:    entities.DeliveryRetrying,
+				CreatedAt: d.now(),
+			}
+			select {
+			case d.jobs <- delivery:
+			case <-ctx.Done():
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (d *Dispatcher) work(ctx context.Context) {
+	for {
+		select {
+		case delivery := <-d.jobs:
+			d.attempt(ctx, delivery)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// attempt hace un intento de envío y programa el siguiente si falla.
+func (d *Dispatcher) attempt(ctx context.Context, delivery entities.WebhookDelivery) {
+	hook, err := d.repo.GetWebhook(ctx, delivery.WebhookID)
+	if errors.Is(err, repository.ErrWebhookNotFound) || (err == nil && !hook.Active) {
+		delivery.Status = entities.DeliveryCancelled
+		delivery.NextAttemptAt = nil
+		d.save(ctx, delivery)
+		return
+	}
+
+	delivery.Attempts++
+	delivery.LastAttemptAt = d.now()
+	if err == nil {
+		delivery.StatusCode, err = d.send(ctx, hook, delivery)
+	}
+	if err == nil {
+		delivery.Status = entities.DeliveryDelivered
+		delivery.Error = ""
+		delivery.ErrorKey = ""
+		delivery.NextAttemptAt = nil
+		d.save(ctx, delivery)
+		return
+	}
+
+	delivery.Error = err.Error()
+	delivery.ErrorKey = ""
+	var appErr *apperr.Error
+	if errors.As(err, &appErr) {
+		delivery.ErrorKey = appErr.Key
+	}
+	if delivery.Attempts >= d.config.MaxAttempts {
+		logging.Layer(d.logger, "webhooks", "attempt").WithFields(logrus.Fields{"webhook_id": delivery.WebhookID, "delivery_id": delivery.ID}).WithError(err).Error("el envío agotó los reintentos")
+		delivery.Status = entities.DeliveryDead
+		delivery.NextAttemptAt = nil
+		d.save(ctx, delivery)
+		if err := d.repo.AddDeadLetter(ctx, delivery); err != nil {
+			logging.Layer(d.logger, "webhooks", "attempt").WithError(err).Error("operación fallida")
+		}
+		return
+	}
+
+	wait := d.backoff(delivery.Attempts)
+	next := delivery.LastAttemptAt.Add(wait)
+	delivery.Status = entities.DeliveryRetrying
+	delivery.NextAttemptAt = &next
+	d.save(ctx, delivery)
+	time.AfterFunc(wait, func() {
+		select {
+		case d.jobs <- delivery:
+		case <-ctx.Done():
+		}
+	})
+}
+
+// backoff es la espera después del intento número attempt.
+func (d *Dispatcher) backoff(attempt int) time.Duration {
+	wait := d.config.Backoff
+	for i := 1; i < attempt && wait < d.config.MaxBackoff; i++ {
+		wait *= 2
+	}
+	if d.config.MaxBackoff > 0 && wait > d.config.MaxBackoff {
+		wait = d.config.MaxBackoff
+	}
+	return wait
+}
+
+func (d *Dispatcher) send(ctx context.Context, hook entities.Webhook, delivery entities.WebhookDelivery) (int, error) {
+	body := []byte(delivery.Payload)
+	timestamp := strconv.FormatInt(d.now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderWebhookID, hook.ID)
+	req.Header.Set(HeaderDelivery, delivery.ID)
+	req.Header.Set(HeaderTrigger, delivery.Trigger)
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, Sign(hook.Secret, timestamp, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, ErrUnexpectedStatus
+	}
+	return resp.StatusCode, nil
+}
+
+func (d *Dispatcher) save(ctx context.Context, delivery entities.WebhookDelivery) {
+	if err := d.repo.SaveDelivery(ctx, delivery); err != nil {
+		logging.Layer(d.logger, "webhooks", "save").WithField("delivery_id", delivery.ID).WithError(err).Error("operación fallida")
+	}
+}
+
+// Sign calcula la firma de un envío; los receptores pueden usarla para
+// verificar HeaderSignature.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,169 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"prueba_tecnica/api/entities"
+	"prueba_tecnica/api/repository"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTriggers(t *testing.T) {
+	reviewed := entities.Event{ID: "1", Status: entities.StatusReviewed}
+	needing := reviewed
+	needing.NeedsAction = true
+	closed := needing
+	closed.Status = entities.StatusClosed
+
+	testCases := []struct {
+		name     string
+		change   entities.EventChange
+		expected []string
+	}{
+		{name: "Created without action", change: entities.EventChange{Type: entities.ChangeCreated, Event: reviewed}},
+		{name: "Classified as needing action", change: entities.EventChange{Type: entities.ChangeClassified, Event: needing, Previous: &reviewed}, expected: []string{entities.TriggerNeedsAction}},
+		{name: "Already needed action", change: entities.EventChange{Type: entities.ChangeUpdated, Event: needing, Previous: &needing}},
+		{name: "Status change", change: entities.EventChange{Type: entities.ChangeUpdated, Event: closed, Previous: &needing}, expected: []string{entities.TriggerStatusChange}},
+		{name: "Delete", change: entities.EventChange{Type: entities.ChangeDeleted, Event: needing, Previous: &needing}, expected: []string{entities.TriggerDelete}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Triggers(tc.change))
+		})
+	}
+}
+
+func TestDispatcher(t *testing.T) {
+	var calls atomic.Int32
+	var flaky atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get(HeaderSignature) != Sign("s3cr3t", r.Header.Get(HeaderTimestamp), body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/flaky":
+			// Falla los dos primeros intentos.
+			if flaky.Add(1) <= 2 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+		case "/down":
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	repo := repository.NewMemoryWebhookRepository()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	create := func(path string, active bool, triggers ...string) entities.Webhook {
+		hook, err := repo.CreateWebhook(ctx, entities.Webhook{URL: server.URL + path, Triggers: triggers, Secret: "s3cr3t", Active: active})
+		require.NoError(t, err)
+		return hook
+	}
+	ok := create("/ok", true, entities.TriggerNeedsAction)
+	retried := create("/flaky", true, entities.TriggerNeedsAction)
+	dead := create("/down", true, entities.TriggerNeedsAction)
+	create("/ok", false, entities.TriggerNeedsAction)
+	create("/ok", true, entities.TriggerDelete)
+	_, err := repo.CreateWebhook(ctx, entities.Webhook{TenantID: "acme", URL: server.URL + "/ok", Triggers: []string{entities.TriggerNeedsAction}, Secret: "s3cr3t", Active: true})
+	require.NoError(t, err)
+
+	dispatcher := NewDispatcher(repo, Config{MaxAttempts: 3, Backoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond, Timeout: time.Second, Workers: 2}, logrus.New())
+	go dispatcher.Run(ctx)
+
+	reviewed := entities.Event{ID: "e1", Name: "VPN", Status: entities.StatusReviewed}
+	classified := reviewed
+	classified.NeedsAction = true
+	classified.Category = "Requiere gestión"
+	dispatcher.Notify(entities.EventChange{Type: entities.ChangeClassified, Event: classified, Previous: &reviewed, At: time.Now()})
+
+	lastDelivery := func(hook entities.Webhook) entities.WebhookDelivery {
+		deliveries, err := repo.ListDeliveries(ctx, hook.ID, 10)
+		require.NoError(t, err)
+		if len(deliveries) == 0 {
+			return entities.WebhookDelivery{}
+		}
+		return deliveries[0]
+	}
+	require.Eventually(t, func() bool {
+		return lastDelivery(ok).Status == entities.DeliveryDelivered &&
+			lastDelivery(retried).Status == entities.DeliveryDelivered &&
+			lastDelivery(dead).Status == entities.DeliveryDead
+	}, 5*time.Second, 5*time.Millisecond)
+
+	delivered := lastDelivery(ok)
+	assert.Equal(t, 1, delivered.Attempts)
+	assert.Equal(t, http.StatusNoContent, delivered.StatusCode)
+	assert.Equal(t, "e1", delivered.EventID)
+	var body payload
+	require.NoError(t, json.Unmarshal([]byte(delivered.Payload), &body))
+	assert.Equal(t, entities.TriggerNeedsAction, body.Trigger)
+	assert.Equal(t, delivered.ID, body.ID)
+	assert.Equal(t, entities.CategoryNeedsAction, body.Event.Category)
+
+	assert.Equal(t, 3, lastDelivery(retried).Attempts)
+
+	letters, err := repo.ListDeadLetters(ctx, dead.ID, 10)
+	require.NoError(t, err)
+	require.Len(t, letters, 1)
+	assert.Equal(t, 3, letters[0].Attempts)
+	assert.Equal(t, http.StatusInternalServerError, letters[0].StatusCode)
+	assert.Equal(t, ErrUnexpectedStatus.Message, letters[0].Error)
+	assert.Equal(t, "webhook.unexpected_status", letters[0].ErrorKey)
+
+	// ok 1 + flaky 3 + down 3; el inactivo, el de delete y el de otro tenant
+	// no reciben nada.
+	assert.Equal(t, int32(7), calls.Load())
+}
+
+func TestDispatcherBurst(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	repo := repository.NewMemoryWebhookRepository()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_, err := repo.CreateWebhook(ctx, entities.Webhook{URL: server.URL, Triggers: []string{entities.TriggerNeedsAction}, Secret: "s3cr3t", Active: true})
+	require.NoError(t, err)
+
+	// Un lote grande llega antes de que Run empiece y no se pierde ningún
+	// cambio.
+	dispatcher := NewDispatcher(repo, Config{MaxAttempts: 1, Timeout: time.Second, Workers: 4}, logrus.New())
+	for i := 0; i < 1000; i++ {
+		dispatcher.Notify(entities.EventChange{Type: entities.ChangeCreated, Event: entities.Event{ID: strconv.Itoa(i), NeedsAction: true}, At: time.Now()})
+	}
+	go dispatcher.Run(ctx)
+
+	require.Eventually(t, func() bool { return calls.Load() == 1000 }, 10*time.Second, 10*time.Millisecond)
+}
+
+func TestDispatcherBackoff(t *testing.T) {
+	dispatcher := NewDispatcher(repository.NewMemoryWebhookRepository(), Config{Backoff: time.Second, MaxBackoff: 5 * time.Second}, logrus.New())
+
+	assert.Equal(t, time.Second, dispatcher.backoff(1))
+	assert.Equal(t, 2*time.Second, dispatcher.backoff(2))
+	assert.Equal(t, 4*time.Second, dispatcher.backoff(3))
+	assert.Equal(t, 5*time.Second, dispatcher.backoff(4))
+	assert.Equal(t, 5*time.Second, dispatcher.backoff(10))
+}
@@ -32,3 +32,30 @@ db.events.insertMany([
         category: "Requiere gestión"
     }
 ]);
+
+db.classification_rules.insertMany([
+    {
+        name: "requiere-gestion",
+        priority: 10,
+        types: ["Incidente", "Problema", "Emergencia", "Error", "Critico"],
+        category: "Requiere gestión",
+        needs_action: true
+    },
+    {
+        name: "sin-gestion",
+        priority: 20,
+        types: ["Reunión", "Informe", "Actualización", "Notificación", "Consulta"],
+        category: "Sin gestión",
+        needs_action: false
+    }
+]);
+
+db.event_history.createIndex({ event_id: 1, at: 1 });
+
+db.events.createIndex({ deleted_at: 1 }, { sparse: true });
+db.events.createIndex({ tenant_id: 1, date: -1 });
+
+db.webhook_deliveries.createIndex({ webhook_id: 1, created_at: -1 });
+db.webhook_dead_letters.createIndex({ webhook_id: 1, created_at: -1 });
+
+db.api_keys.createIndex({ hash: 1 }, { unique: true });